@@ -0,0 +1,37 @@
+// Local: api-gateway/accesslog.go
+
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// quietPaths são as rotas registradas só no nível debug: as sondas e a coleta de métricas
+// são chamadas a cada poucos segundos e encheriam o log sem trazer informação.
+var quietPaths = map[string]bool{"/healthz": true, "/readyz": true, "/metrics": true}
+
+// logAccess escreve a linha de log de uma requisição concluída (ver withObservation), com a
+// rota, o status, o tamanho da resposta e a duração. O nível segue o status: error para
+// 5xx, warn para 4xx e info para o resto. O request_id entra na linha pelo contexto (ver
+// requestid.go).
+func logAccess(r *http.Request, info *requestInfo, elapsed time.Duration) {
+	level := slog.LevelInfo
+	switch {
+	case quietPaths[r.URL.Path]:
+		level = slog.LevelDebug
+	case info.status >= 500:
+		level = slog.LevelError
+	case info.status >= 400:
+		level = slog.LevelWarn
+	}
+	slog.LogAttrs(r.Context(), level, "requisição HTTP concluída",
+		slog.String("method", r.Method),
+		slog.String("route", info.route),
+		slog.String("path", r.URL.Path),
+		slog.Int("status", info.status),
+		slog.Int64("bytes", info.bytes),
+		slog.Duration("duration", elapsed),
+	)
+}
@@ -0,0 +1,158 @@
+// Local: api-gateway/auth.go
+
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// O gateway é quem autentica os usuários: as rotas /me/... e as que criam ou apagam avaliações
+// exigem um token de acesso JWT assinado com HS256 (HMAC-SHA256) e a chave auth_jwt_secret
+// (AUTH_JWT_SECRET) da configuração. O usuário é o claim "sub" do token, e os serviços gRPC
+// recebem só esse ID.
+
+// Erros da validação do token. Todos respondem 401; a mensagem vai no "detail" do erro.
+var (
+	errMissingToken      = errors.New("envie o token de acesso no cabeçalho Authorization: Bearer <token>")
+	errMalformedToken    = errors.New("o token de acesso não é um JWT válido")
+	errUnsupportedAlg    = errors.New("o token de acesso precisa ser assinado com HS256")
+	errInvalidSignature  = errors.New("a assinatura do token de acesso é inválida")
+	errTokenExpired      = errors.New("o token de acesso expirou")
+	errMissingExpiration = errors.New("o token de acesso não informa a validade (claim exp)")
+	errTokenNotYetValid  = errors.New("o token de acesso ainda não é válido")
+	errMissingSubject    = errors.New("o token de acesso não informa o usuário (claim sub)")
+	errAuthDisabled      = errors.New("a autenticação não está configurada no gateway")
+)
+
+// jwtHeader e jwtClaims são as partes do token que o gateway lê. exp e nbf são datas em
+// segundos desde 1970 (NumericDate), que podem ter casas decimais. exp é obrigatório: um
+// token sem validade valeria para sempre se vazasse.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	ExpiresAt *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+}
+
+type userIDKey struct{}
+
+// authenticator valida os tokens de acesso com a chave compartilhada.
+type authenticator struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// newAuthenticator cria o autenticador. Sem chave, nenhuma requisição é autenticada e as
+// rotas protegidas sempre respondem 401.
+func newAuthenticator(secret string) *authenticator {
+	if secret == "" {
+		slog.Warn("auth_jwt_secret não foi definida; as rotas que exigem um usuário vão responder 401")
+	}
+	return &authenticator{secret: []byte(secret), now: time.Now}
+}
+
+// requireUser é o middleware das rotas que precisam de um usuário autenticado. O ID do
+// usuário fica disponível para o handler via userIDFrom.
+func (a *authenticator) requireUser(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := a.authenticate(r)
+		if err != nil {
+			// RFC 6750: o desafio indica o esquema esperado e, se um token foi enviado, por que ele foi recusado.
+			challenge := "Bearer"
+			if !errors.Is(err, errMissingToken) && !errors.Is(err, errAuthDisabled) {
+				challenge = `Bearer error="invalid_token"`
+			}
+			w.Header().Set("WWW-Authenticate", challenge)
+			writeProblem(w, r, http.StatusUnauthorized, problemUnauthenticated, err.Error(), nil)
+			return
+		}
+		next(w, r.WithContext(context.WithValue(r.Context(), userIDKey{}, userID)))
+	}
+}
+
+// authenticate lê o token do cabeçalho Authorization e retorna o usuário dele.
+func (a *authenticator) authenticate(r *http.Request) (string, error) {
+	if len(a.secret) == 0 {
+		return "", errAuthDisabled
+	}
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", errMissingToken
+	}
+	claims, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+// verify confere a assinatura e as datas do token e retorna os seus claims.
+func (a *authenticator) verify(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errMalformedToken
+	}
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, errMalformedToken
+	}
+	// O algoritmo é fixo: aceitar o "alg" do próprio token permitiria um token sem assinatura ("none").
+	if header.Alg != "HS256" {
+		return nil, errUnsupportedAlg
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errMalformedToken
+	}
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errInvalidSignature
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, errMalformedToken
+	}
+	now := float64(a.now().Unix())
+	if claims.ExpiresAt == nil {
+		return nil, errMissingExpiration
+	}
+	if now >= *claims.ExpiresAt {
+		return nil, errTokenExpired
+	}
+	if claims.NotBefore != nil && now < *claims.NotBefore {
+		return nil, errTokenNotYetValid
+	}
+	if claims.Subject == "" {
+		return nil, errMissingSubject
+	}
+	return &claims, nil
+}
+
+// decodeSegment decodifica uma parte do JWT (JSON em base64url sem padding).
+func decodeSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// userIDFrom retorna o usuário autenticado guardado no contexto por requireUser.
+func userIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(userIDKey{}).(string)
+	return id
+}
@@ -0,0 +1,128 @@
+// Local: api-gateway/auth_test.go
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testSecret = "segredo-de-teste"
+
+// testNow é o relógio fixo dos testes: 2024-01-01 00:00:00 UTC.
+var testNow = time.Unix(1704067200, 0)
+
+// signToken monta um JWT com o cabeçalho e os claims (em JSON) dados, assinado com a chave.
+func signToken(header, claims, secret string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	unsigned := encode([]byte(header)) + "." + encode([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + encode(mac.Sum(nil))
+}
+
+func newTestAuthenticator() *authenticator {
+	return &authenticator{secret: []byte(testSecret), now: func() time.Time { return testNow }}
+}
+
+// TestVerify testa a validação do token: algoritmo, assinatura, datas e usuário.
+func TestVerify(t *testing.T) {
+	const hs256 = `{"alg":"HS256","typ":"JWT"}`
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"token válido", signToken(hs256, `{"sub":"ana","exp":1704070800}`, testSecret), nil},
+		{"exp com casas decimais", signToken(hs256, `{"sub":"ana","exp":1704067200.5}`, testSecret), nil},
+		{"sem as três partes", "abc.def", errMalformedToken},
+		{"cabeçalho inválido", "%%%.e30.e30", errMalformedToken},
+		{"algoritmo none", signToken(`{"alg":"none"}`, `{"sub":"ana","exp":1704070800}`, testSecret), errUnsupportedAlg},
+		{"algoritmo HS512", signToken(`{"alg":"HS512"}`, `{"sub":"ana","exp":1704070800}`, testSecret), errUnsupportedAlg},
+		{"assinatura com outra chave", signToken(hs256, `{"sub":"ana","exp":1704070800}`, "outra-chave"), errInvalidSignature},
+		{"expirado", signToken(hs256, `{"sub":"ana","exp":1704067200}`, testSecret), errTokenExpired},
+		{"sem exp", signToken(hs256, `{"sub":"ana"}`, testSecret), errMissingExpiration},
+		{"nbf no futuro", signToken(hs256, `{"sub":"ana","exp":1704070800,"nbf":1704067260}`, testSecret), errTokenNotYetValid},
+		{"nbf no passado", signToken(hs256, `{"sub":"ana","exp":1704070800,"nbf":1704067140}`, testSecret), nil},
+		{"sem sub", signToken(hs256, `{"exp":1704070800}`, testSecret), errMissingSubject},
+		{"claims inválidos", signToken(hs256, `não é JSON`, testSecret), errMalformedToken},
+	}
+	auth := newTestAuthenticator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := auth.verify(tt.token)
+
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Esperava o erro %v, mas recebeu %v", tt.want, err)
+			}
+			if tt.want == nil && claims.Subject != "ana" {
+				t.Errorf("Esperava o usuário 'ana', mas recebeu '%s'", claims.Subject)
+			}
+		})
+	}
+}
+
+// TestVerify_ForgedSignature testa se alterar os claims depois de assinar invalida o token.
+func TestVerify_ForgedSignature(t *testing.T) {
+	valid := signToken(`{"alg":"HS256"}`, `{"sub":"ana","exp":1704070800}`, testSecret)
+	forged := signToken(`{"alg":"HS256"}`, `{"sub":"bia","exp":1704070800}`, testSecret)
+	// Os claims de bia com a assinatura de ana.
+	token := forged[:len(forged)-43] + valid[len(valid)-43:]
+
+	if _, err := newTestAuthenticator().verify(token); !errors.Is(err, errInvalidSignature) {
+		t.Errorf("Esperava errInvalidSignature, mas recebeu %v", err)
+	}
+}
+
+// TestRequireUser testa o middleware: o usuário do token chega ao handler e as falhas
+// respondem 401 com o desafio WWW-Authenticate.
+func TestRequireUser(t *testing.T) {
+	valid := signToken(`{"alg":"HS256"}`, `{"sub":"ana","exp":1704070800}`, testSecret)
+	expired := signToken(`{"alg":"HS256"}`, `{"sub":"ana","exp":1704067200}`, testSecret)
+	tests := []struct {
+		name          string
+		secret        string
+		authorization string
+		wantStatus    int
+		wantChallenge string
+	}{
+		{"token válido", testSecret, "Bearer " + valid, http.StatusOK, ""},
+		{"esquema em minúsculas", testSecret, "bearer " + valid, http.StatusOK, ""},
+		{"sem cabeçalho", testSecret, "", http.StatusUnauthorized, "Bearer"},
+		{"outro esquema", testSecret, "Basic YW5hOnNlbmhh", http.StatusUnauthorized, "Bearer"},
+		{"token expirado", testSecret, "Bearer " + expired, http.StatusUnauthorized, `Bearer error="invalid_token"`},
+		{"autenticação desligada", "", "Bearer " + valid, http.StatusUnauthorized, "Bearer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := &authenticator{secret: []byte(tt.secret), now: func() time.Time { return testNow }}
+			var gotUser string
+			handler := auth.requireUser(func(w http.ResponseWriter, r *http.Request) {
+				gotUser = userIDFrom(r.Context())
+			})
+			req := httptest.NewRequest(http.MethodGet, "/me/watchlist", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Esperava o status %d, mas recebeu %d", tt.wantStatus, rec.Code)
+			}
+			if got := rec.Header().Get("WWW-Authenticate"); got != tt.wantChallenge {
+				t.Errorf("Esperava o desafio %q, mas recebeu %q", tt.wantChallenge, got)
+			}
+			if tt.wantStatus == http.StatusOK && gotUser != "ana" {
+				t.Errorf("Esperava o usuário 'ana' no contexto, mas recebeu '%s'", gotUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Local: api-gateway/batch.go
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// BatchResult é o resultado de um item de uma operação em lote, na mesma posição do item
+// na requisição. Sem "error", o item foi processado; com ele, "error" é o mesmo Problem que
+// a rota individual (POST /movies, GET ou DELETE /movies/{id}) responderia.
+type BatchResult struct {
+	ID    string    `json:"id,omitempty"`
+	Movie *pb.Movie `json:"movie,omitempty"`
+	Error *Problem  `json:"error,omitempty"`
+}
+
+// BatchResponse é o corpo das respostas das operações em lote.
+type BatchResponse struct {
+	Results []BatchResult `json:"results"`
+}
+
+// BatchResultSwagger é uma struct apenas para documentação Swagger.
+type BatchResultSwagger struct {
+	ID    string        `json:"id,omitempty"`
+	Movie *MovieSwagger `json:"movie,omitempty"`
+	Error *Problem      `json:"error,omitempty"`
+}
+
+// BatchResponseSwagger é uma struct apenas para documentação Swagger.
+type BatchResponseSwagger struct {
+	Results []BatchResultSwagger `json:"results"`
+}
+
+// BatchCreateRequestSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.BatchCreateMoviesRequest aqui.
+type BatchCreateRequestSwagger struct {
+	Movies []CreateMovieRequestSwagger `json:"movies"`
+}
+
+// BatchDeleteRequestSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.BatchDeleteMoviesRequest aqui.
+type BatchDeleteRequestSwagger struct {
+	IDs []string `json:"ids"`
+}
+
+// @Summary      Cria vários filmes
+// @Description  Cria vários filmes com uma única requisição (até o tamanho máximo de lote do movies-service, 500 por padrão). Cada filme é criado ou rejeitado de forma independente: a resposta é 200 sempre que o lote é válido, e os resultados vêm na ordem dos filmes enviados, cada um com o filme criado ou com o erro dele.
+// @Tags         Lotes
+// @Accept       json
+// @Produce      json
+// @Param        movies  body      BatchCreateRequestSwagger  true  "Filmes a criar"
+// @Success      200     {object}  BatchResponseSwagger "Resultado de cada filme"
+// @Failure      400     {object}  Problem "Corpo inválido, lote vazio ou grande demais"
+// @Failure      500     {object}  Problem "Erro interno no servidor"
+// @Router       /movies:batchCreate [post]
+func (h *handler) batchCreateMovies(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar o JSON da requisição direto para a mensagem gRPC.
+	var req pb.BatchCreateMoviesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	// 2. Chamar o serviço gRPC
+	res, err := h.client.BatchCreateMovies(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "BatchCreateMovies", "Erro interno ao criar os filmes")
+		return
+	}
+
+	// 3. Escrever os resultados
+	writeBatchResponse(w, r, res)
+}
+
+// @Summary      Busca vários filmes por ID
+// @Description  Busca vários filmes com uma única requisição. Os resultados vêm na ordem dos IDs pedidos; um ID inexistente traz um erro 404 apenas no seu resultado.
+// @Tags         Lotes
+// @Produce      json
+// @Param        ids  query     string  true  "IDs separados por vírgula (ex: 8,10,12)"
+// @Success      200  {object}  BatchResponseSwagger "Resultado de cada ID"
+// @Failure      400  {object}  Problem "Nenhum ID ou IDs demais"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /movies:batchGet [get]
+func (h *handler) batchGetMovies(w http.ResponseWriter, r *http.Request) {
+	// 1. Os IDs podem vir separados por vírgula e/ou em parâmetros repetidos (?ids=8&ids=10).
+	req := &pb.BatchGetMoviesRequest{Ids: parseIDs(r.URL.Query()["ids"])}
+
+	// 2. Chamar o serviço gRPC
+	res, err := h.client.BatchGetMovies(r.Context(), req)
+	if err != nil {
+		writeGrpcError(w, r, err, "BatchGetMovies", "Erro interno ao buscar os filmes")
+		return
+	}
+
+	// 3. Escrever os resultados
+	writeBatchResponse(w, r, res)
+}
+
+// @Summary      Deleta vários filmes por ID
+// @Description  Deleta vários filmes com uma única requisição, da mesma forma que DELETE /movies/{id} (inclusive com a exclusão lógica, se ativada). Os resultados vêm na ordem dos IDs enviados; um ID inexistente traz um erro 404 apenas no seu resultado.
+// @Tags         Lotes
+// @Accept       json
+// @Produce      json
+// @Param        ids  body      BatchDeleteRequestSwagger  true  "IDs dos filmes a deletar"
+// @Success      200  {object}  BatchResponseSwagger "Resultado de cada ID"
+// @Failure      400  {object}  Problem "Corpo inválido, lote vazio ou grande demais"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /movies:batchDelete [post]
+func (h *handler) batchDeleteMovies(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar o JSON da requisição
+	var req pb.BatchDeleteMoviesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	// 2. Chamar o serviço gRPC
+	res, err := h.client.BatchDeleteMovies(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "BatchDeleteMovies", "Erro interno ao deletar os filmes")
+		return
+	}
+
+	// 3. Escrever os resultados
+	writeBatchResponse(w, r, res)
+}
+
+// parseIDs junta os valores do parâmetro ids, separando os que vêm com vírgulas.
+func parseIDs(values []string) []string {
+	var ids []string
+	for _, value := range values {
+		for _, id := range strings.Split(value, ",") {
+			ids = append(ids, strings.TrimSpace(id))
+		}
+	}
+	return ids
+}
+
+// writeBatchResponse traduz a resposta gRPC de um lote, trocando o erro de cada item
+// pelo Problem equivalente.
+func writeBatchResponse(w http.ResponseWriter, r *http.Request, res *pb.BatchMoviesResponse) {
+	response := BatchResponse{Results: make([]BatchResult, 0, len(res.GetResults()))}
+	for _, result := range res.GetResults() {
+		response.Results = append(response.Results, BatchResult{
+			ID:    result.GetId(),
+			Movie: result.GetMovie(),
+			Error: itemProblem(r, result.GetError()),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		slog.ErrorContext(r.Context(), "falha ao codificar a resposta JSON", "error", err)
+	}
+}
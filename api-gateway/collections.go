@@ -0,0 +1,239 @@
+// Local: api-gateway/collections.go
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// CollectionSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.Collection aqui.
+type CollectionSwagger struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	MovieIDs    []string `json:"movie_ids,omitempty"`
+}
+
+// CreateCollectionRequestSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.CreateCollectionRequest aqui.
+type CreateCollectionRequestSwagger struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	MovieIDs    []string `json:"movie_ids,omitempty"`
+}
+
+// UpdateCollectionRequestSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.UpdateCollectionRequest aqui (exceto o ID, que vem da URL).
+type UpdateCollectionRequestSwagger struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// AddMovieToCollectionRequestSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.AddMovieToCollectionRequest aqui (exceto o ID da coleção, que vem da URL).
+type AddMovieToCollectionRequestSwagger struct {
+	MovieID  string `json:"movie_id"`
+	Position int32  `json:"position,omitempty"`
+}
+
+// @Summary      Lista as coleções
+// @Description  Retorna todas as coleções, ordenadas por ID, cada uma com os IDs dos seus filmes na ordem da coleção.
+// @Tags         Coleções
+// @Produce      json
+// @Success      200  {array}   CollectionSwagger "Lista de coleções"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /collections [get]
+func (h *handler) listCollections(w http.ResponseWriter, r *http.Request) {
+	res, err := h.collections.ListCollections(r.Context(), &pb.ListCollectionsRequest{})
+	if err != nil {
+		writeGrpcError(w, r, err, "ListCollections", "Erro interno ao listar as coleções")
+		return
+	}
+
+	// A resposta é a lista em si, como em GET /movies, e nunca null.
+	collections := res.GetCollections()
+	if collections == nil {
+		collections = []*pb.Collection{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(collections)
+}
+
+// @Summary      Cria uma coleção
+// @Description  Cria uma coleção de filmes, opcionalmente já com filmes, na ordem enviada. Todos os filmes precisam existir e não podem se repetir.
+// @Tags         Coleções
+// @Accept       json
+// @Produce      json
+// @Param        collection  body      CreateCollectionRequestSwagger  true  "Dados da coleção"
+// @Success      201         {object}  CollectionSwagger "Coleção criada"
+// @Failure      400         {object}  Problem "Dados inválidos"
+// @Failure      404         {object}  Problem "Algum dos filmes não existe"
+// @Failure      500         {object}  Problem "Erro interno no servidor"
+// @Router       /collections [post]
+func (h *handler) createCollection(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	res, err := h.collections.CreateCollection(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "CreateCollection", "Erro interno ao criar a coleção")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Busca uma coleção por ID
+// @Tags         Coleções
+// @Produce      json
+// @Param        id   path      string  true  "ID da Coleção"
+// @Success      200  {object}  CollectionSwagger "Coleção encontrada"
+// @Failure      404  {object}  Problem "Coleção não encontrada"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /collections/{id} [get]
+func (h *handler) getCollection(w http.ResponseWriter, r *http.Request) {
+	res, err := h.collections.GetCollection(r.Context(), &pb.GetCollectionRequest{Id: mux.Vars(r)["id"]})
+	if err != nil {
+		writeGrpcError(w, r, err, "GetCollection", "Erro interno ao buscar a coleção")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Atualiza uma coleção
+// @Description  Altera o nome e a descrição da coleção. Os filmes são alterados pelas rotas /collections/{id}/movies.
+// @Tags         Coleções
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string                          true  "ID da Coleção"
+// @Param        collection  body      UpdateCollectionRequestSwagger  true  "Novos dados da coleção"
+// @Success      200         {object}  CollectionSwagger "Coleção atualizada"
+// @Failure      400         {object}  Problem "Dados inválidos"
+// @Failure      404         {object}  Problem "Coleção não encontrada"
+// @Failure      500         {object}  Problem "Erro interno no servidor"
+// @Router       /collections/{id} [put]
+func (h *handler) updateCollection(w http.ResponseWriter, r *http.Request) {
+	var req pb.UpdateCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+	// O ID vem sempre da URL, nunca do corpo.
+	req.Id = mux.Vars(r)["id"]
+
+	res, err := h.collections.UpdateCollection(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "UpdateCollection", "Erro interno ao atualizar a coleção")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Apaga uma coleção
+// @Description  Apaga a coleção. Os filmes dela não são alterados.
+// @Tags         Coleções
+// @Param        id   path  string  true  "ID da Coleção"
+// @Success      204  "Coleção apagada"
+// @Failure      404  {object}  Problem "Coleção não encontrada"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /collections/{id} [delete]
+func (h *handler) deleteCollection(w http.ResponseWriter, r *http.Request) {
+	_, err := h.collections.DeleteCollection(r.Context(), &pb.DeleteCollectionRequest{Id: mux.Vars(r)["id"]})
+	if err != nil {
+		writeGrpcError(w, r, err, "DeleteCollection", "Erro interno ao apagar a coleção")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Lista os filmes de uma coleção
+// @Description  Retorna os filmes da coleção na ordem dela. Filmes com exclusão lógica continuam na coleção, mas não aparecem aqui enquanto não forem restaurados.
+// @Tags         Coleções
+// @Produce      json
+// @Param        id   path      string  true  "ID da Coleção"
+// @Success      200  {array}   MovieSwagger "Filmes da coleção"
+// @Failure      404  {object}  Problem "Coleção não encontrada"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /collections/{id}/movies [get]
+func (h *handler) listCollectionMovies(w http.ResponseWriter, r *http.Request) {
+	res, err := h.collections.ListCollectionMovies(r.Context(), &pb.ListCollectionMoviesRequest{CollectionId: mux.Vars(r)["id"]})
+	if err != nil {
+		writeGrpcError(w, r, err, "ListCollectionMovies", "Erro interno ao listar os filmes da coleção")
+		return
+	}
+
+	movies := res.GetMovies()
+	if movies == nil {
+		movies = []*pb.Movie{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(movies)
+}
+
+// @Summary      Adiciona um filme à coleção
+// @Description  Coloca o filme na posição pedida (começando em 1). Sem posição, ou com uma posição depois do fim, o filme vai para o final. Se o filme já estiver na coleção, ele é movido para a nova posição.
+// @Tags         Coleções
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string                              true  "ID da Coleção"
+// @Param        movie  body      AddMovieToCollectionRequestSwagger  true  "Filme e posição"
+// @Success      200    {object}  CollectionSwagger "Coleção com o filme"
+// @Failure      400    {object}  Problem "Dados inválidos"
+// @Failure      404    {object}  Problem "Coleção ou filme não encontrado"
+// @Failure      500    {object}  Problem "Erro interno no servidor"
+// @Router       /collections/{id}/movies [post]
+func (h *handler) addMovieToCollection(w http.ResponseWriter, r *http.Request) {
+	var req pb.AddMovieToCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+	req.CollectionId = mux.Vars(r)["id"]
+
+	res, err := h.collections.AddMovieToCollection(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "AddMovieToCollection", "Erro interno ao adicionar o filme à coleção")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Remove um filme da coleção
+// @Description  Tira o filme da coleção, sem apagar o filme. Os demais filmes mantêm a ordem.
+// @Tags         Coleções
+// @Param        id       path  string  true  "ID da Coleção"
+// @Param        movieId  path  string  true  "ID do Filme"
+// @Success      204  "Filme removido da coleção"
+// @Failure      404  {object}  Problem "Coleção não encontrada ou filme fora da coleção"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /collections/{id}/movies/{movieId} [delete]
+func (h *handler) removeMovieFromCollection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	_, err := h.collections.RemoveMovieFromCollection(r.Context(), &pb.RemoveMovieFromCollectionRequest{
+		CollectionId: vars["id"],
+		MovieId:      vars["movieId"],
+	})
+	if err != nil {
+		writeGrpcError(w, r, err, "RemoveMovieFromCollection", "Erro interno ao remover o filme da coleção")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -24,44 +24,36 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/movies": {
+        "/collections": {
             "get": {
-                "description": "Retorna uma lista com todos os filmes cadastrados no banco de dados.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retorna todas as coleções, ordenadas por ID, cada uma com os IDs dos seus filmes na ordem da coleção.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Filmes"
+                    "Coleções"
                 ],
-                "summary": "Lista todos os filmes",
+                "summary": "Lista as coleções",
                 "responses": {
                     "200": {
-                        "description": "Lista de filmes",
+                        "description": "Lista de coleções",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/main.MovieSwagger"
+                                "$ref": "#/definitions/main.CollectionSwagger"
                             }
                         }
                     },
                     "500": {
                         "description": "Erro interno no servidor",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Adiciona um novo filme à coleção a partir dos dados enviados no corpo da requisição.",
+                "description": "Cria uma coleção de filmes, opcionalmente já com filmes, na ordem enviada. Todos os filmes precisam existir e não podem se repetir.",
                 "consumes": [
                     "application/json"
                 ],
@@ -69,55 +61,89 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Filmes"
+                    "Coleções"
                 ],
-                "summary": "Cria um novo filme",
+                "summary": "Cria uma coleção",
                 "parameters": [
                     {
-                        "description": "Dados do Filme para Criar",
-                        "name": "movie",
+                        "description": "Dados da coleção",
+                        "name": "collection",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/main.CreateMovieRequestSwagger"
+                            "$ref": "#/definitions/main.CreateCollectionRequestSwagger"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Filme criado com sucesso",
+                        "description": "Coleção criada",
                         "schema": {
-                            "$ref": "#/definitions/main.MovieSwagger"
+                            "$ref": "#/definitions/main.CollectionSwagger"
                         }
                     },
                     "400": {
-                        "description": "Requisição inválida",
+                        "description": "Dados inválidos",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Algum dos filmes não existe",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
                         }
                     },
                     "500": {
                         "description": "Erro interno no servidor",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
                         }
                     }
                 }
             }
         },
-        "/movies/{id}": {
+        "/collections/{id}": {
             "get": {
-                "description": "Retorna os detalhes de um filme específico com base no seu ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coleções"
+                ],
+                "summary": "Busca uma coleção por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Coleção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Coleção encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.CollectionSwagger"
+                        }
+                    },
+                    "404": {
+                        "description": "Coleção não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Altera o nome e a descrição da coleção. Os filmes são alterados pelas rotas /collections/{id}/movies.",
                 "consumes": [
                     "application/json"
                 ],
@@ -125,51 +151,133 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Filmes"
+                    "Coleções"
                 ],
-                "summary": "Busca um filme por ID",
+                "summary": "Atualiza uma coleção",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do Filme",
+                        "description": "ID da Coleção",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Novos dados da coleção",
+                        "name": "collection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateCollectionRequestSwagger"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Filme encontrado",
+                        "description": "Coleção atualizada",
                         "schema": {
-                            "$ref": "#/definitions/main.MovieSwagger"
+                            "$ref": "#/definitions/main.CollectionSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
                         }
                     },
                     "404": {
-                        "description": "Filme não encontrado",
+                        "description": "Coleção não encontrada",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
                         }
                     },
                     "500": {
                         "description": "Erro interno no servidor",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Remove um filme da coleção com base no seu ID.",
+                "description": "Apaga a coleção. Os filmes dela não são alterados.",
+                "tags": [
+                    "Coleções"
+                ],
+                "summary": "Apaga uma coleção",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Coleção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Coleção apagada"
+                    },
+                    "404": {
+                        "description": "Coleção não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/collections/{id}/movies": {
+            "get": {
+                "description": "Retorna os filmes da coleção na ordem dela. Filmes com exclusão lógica continuam na coleção, mas não aparecem aqui enquanto não forem restaurados.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coleções"
+                ],
+                "summary": "Lista os filmes de uma coleção",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Coleção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filmes da coleção",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.MovieSwagger"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Coleção não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Coloca o filme na posição pedida (começando em 1). Sem posição, ou com uma posição depois do fim, o filme vai para o final. Se o filme já estiver na coleção, ele é movido para a nova posição.",
                 "consumes": [
                     "application/json"
                 ],
@@ -177,79 +285,1980 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Filmes"
+                    "Coleções"
                 ],
-                "summary": "Deleta um filme por ID",
+                "summary": "Adiciona um filme à coleção",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do Filme a ser deletado",
+                        "description": "ID da Coleção",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Filme e posição",
+                        "name": "movie",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.AddMovieToCollectionRequestSwagger"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Filme deletado com sucesso (sem conteúdo de resposta)"
+                    "200": {
+                        "description": "Coleção com o filme",
+                        "schema": {
+                            "$ref": "#/definitions/main.CollectionSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
                     },
                     "404": {
-                        "description": "Filme não encontrado",
+                        "description": "Coleção ou filme não encontrado",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
                         }
                     },
                     "500": {
                         "description": "Erro interno no servidor",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "error": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/main.Problem"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "main.CreateMovieRequestSwagger": {
-            "type": "object",
-            "properties": {
-                "director": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "year": {
-                    "type": "integer"
+        },
+        "/collections/{id}/movies/{movieId}": {
+            "delete": {
+                "description": "Tira o filme da coleção, sem apagar o filme. Os demais filmes mantêm a ordem.",
+                "tags": [
+                    "Coleções"
+                ],
+                "summary": "Remove um filme da coleção",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Coleção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "movieId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Filme removido da coleção"
+                    },
+                    "404": {
+                        "description": "Coleção não encontrada ou filme fora da coleção",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
                 }
             }
         },
-        "main.MovieSwagger": {
-            "type": "object",
-            "properties": {
-                "director": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "year": {
-                    "type": "integer"
+        "/healthz": {
+            "get": {
+                "description": "Responde 200 enquanto o processo do gateway está de pé, sem consultar os microsserviços. Durante o desligamento, responde 503.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saúde"
+                ],
+                "summary": "Sonda de vida",
+                "responses": {
+                    "200": {
+                        "description": "Gateway no ar",
+                        "schema": {
+                            "$ref": "#/definitions/main.HealthStatus"
+                        }
+                    },
+                    "503": {
+                        "description": "Gateway desligando",
+                        "schema": {
+                            "$ref": "#/definitions/main.HealthStatus"
+                        }
+                    }
                 }
             }
+        },
+        "/me/watchlist": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página dos filmes que o usuário autenticado quer assistir, do incluído mais recentemente para o mais antigo. Quando existe uma próxima página, o cabeçalho Link traz a URL dela (rel=\"next\"). Filmes com exclusão lógica não aparecem, então uma página pode ter menos itens que o pedido.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lista de Interesse"
+                ],
+                "summary": "Lista a minha lista de interesse",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de filmes por página (padrão 50, máximo 1000)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token da página, obtido do cabeçalho Link da resposta anterior",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filmes da lista",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.WatchlistItemSwagger"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "URL da próxima página (rel=\\\"next\\\")"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Token de acesso ausente ou inválido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/watchlist/{movieId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Inclui o filme na lista do usuário autenticado. A operação é idempotente: incluir de novo um filme que já está na lista não muda nada e retorna o item com a data original.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lista de Interesse"
+                ],
+                "summary": "Inclui um filme na minha lista de interesse",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "movieId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filme na lista",
+                        "schema": {
+                            "$ref": "#/definitions/main.WatchlistItemSwagger"
+                        }
+                    },
+                    "401": {
+                        "description": "Token de acesso ausente ou inválido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Tira o filme da lista do usuário autenticado. Um filme que não está na lista responde 404.",
+                "tags": [
+                    "Lista de Interesse"
+                ],
+                "summary": "Tira um filme da minha lista de interesse",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "movieId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Filme retirado da lista"
+                    },
+                    "401": {
+                        "description": "Token de acesso ausente ou inválido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "O filme não está na lista",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies": {
+            "get": {
+                "description": "Retorna uma página de filmes, com filtros e ordenação opcionais. Quando existe uma próxima página, o cabeçalho Link traz a URL dela (rel=\"next\"), e o cabeçalho X-Total-Count traz o total de filmes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Lista os filmes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de filmes por página (padrão 50, máximo 1000)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token da página, obtido do cabeçalho Link da resposta anterior",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Ano mínimo (inclusivo)",
+                        "name": "year_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Ano máximo (inclusivo)",
+                        "name": "year_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trecho do título (não diferencia maiúsculas de minúsculas)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome exato do diretor",
+                        "name": "director",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campos de ordenação separados por vírgula; '-' indica ordem decrescente (ex: -year,title). Campos: id, title, director, year",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de filmes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.MovieSwagger"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "URL da próxima página (rel=\\\"next\\\")"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de filmes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros de paginação, filtro ou ordenação inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adiciona um novo filme à coleção a partir dos dados enviados no corpo da requisição.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Cria um novo filme",
+                "parameters": [
+                    {
+                        "description": "Dados do Filme para Criar",
+                        "name": "movie",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CreateMovieRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Filme criado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/main.MovieSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies/search": {
+            "get": {
+                "description": "Busca textual no título dos filmes. Os resultados vêm do mais relevante para o menos relevante, com a pontuação de cada um. A paginação funciona como na listagem (cabeçalhos Link e X-Total-Count).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Busca filmes pelo título",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Termos da busca",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de resultados por página (padrão 50, máximo 1000)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token da página, obtido do cabeçalho Link da resposta anterior",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultados da busca",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.MovieSearchResultSwagger"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "URL da próxima página (rel=\\\"next\\\")"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total de resultados"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Termo de busca ou paginação inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies/{id}": {
+            "get": {
+                "description": "Retorna os detalhes de um filme específico com base no seu ID, com a média e a quantidade das avaliações em \"rating\" (omitido se o filme não tem avaliações ou se o reviews-service não responder).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Busca um filme por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filme encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.MovieSwagger"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Substitui os dados de um filme existente, mantendo o seu ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Atualiza um filme por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme a ser atualizado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novos dados do Filme",
+                        "name": "movie",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.UpdateMovieRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filme atualizado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/main.MovieSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove um filme da coleção com base no seu ID. Com a exclusão lógica ativada no movies-service, o filme apenas deixa de aparecer nas consultas e pode ser restaurado com POST /movies/{id}:restore.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Deleta um filme por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme a ser deletado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Filme deletado com sucesso (sem conteúdo de resposta)"
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Altera apenas os campos enviados no corpo, seguindo JSON Merge Patch (RFC 7396). Um campo com valor null volta ao seu valor vazio. Os campos somente leitura (id e rating) são ignorados, então o corpo pode ser o próprio filme retornado por GET /movies/{id}, com as alterações.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Atualiza parcialmente um filme por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme a ser atualizado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a serem alterados",
+                        "name": "movie",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.PatchMovieRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filme atualizado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/main.MovieSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Requisição inválida ou campo desconhecido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies/{id}/reviews": {
+            "get": {
+                "description": "Retorna uma página das avaliações do filme, da mais nova para a mais antiga. Quando existe uma próxima página, o cabeçalho Link traz a URL dela (rel=\"next\"). A média das notas aparece em \"rating\" no GET /movies/{id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Avaliações"
+                ],
+                "summary": "Lista as avaliações de um filme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de avaliações por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token da página, obtido do cabeçalho Link da resposta anterior",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Avaliações do filme",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.Review"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "URL da próxima página (rel=\\\"next\\\")"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Paginação inválida",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "reviews-service indisponível",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria a avaliação do usuário autenticado para o filme, com uma nota de 1 a 5. Cada usuário avalia um filme no máximo uma vez; uma segunda avaliação responde 409.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Avaliações"
+                ],
+                "summary": "Avalia um filme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da avaliação",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CreateReviewRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Avaliação criada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Review"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Token de acesso ausente ou inválido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "O usuário já avaliou o filme",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "reviews-service indisponível",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies/{id}/reviews/{reviewId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apaga uma avaliação do filme feita pelo usuário autenticado. Uma avaliação de outro filme responde 404; a de outro usuário responde 403.",
+                "tags": [
+                    "Avaliações"
+                ],
+                "summary": "Apaga uma avaliação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da Avaliação",
+                        "name": "reviewId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Avaliação apagada"
+                    },
+                    "401": {
+                        "description": "Token de acesso ausente ou inválido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "A avaliação é de outro usuário",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme ou avaliação não encontrados",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "reviews-service indisponível",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies/{id}:restore": {
+            "post": {
+                "description": "Desfaz a exclusão lógica de um filme, que volta a aparecer nas consultas com os mesmos dados. Restaurar um filme que não está excluído apenas o retorna. Filmes já apagados pela limpeza não podem ser restaurados.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Restaura um filme excluído",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do Filme a ser restaurado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filme restaurado",
+                        "schema": {
+                            "$ref": "#/definitions/main.MovieSwagger"
+                        }
+                    },
+                    "404": {
+                        "description": "Filme não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies:batchCreate": {
+            "post": {
+                "description": "Cria vários filmes com uma única requisição (até o tamanho máximo de lote do movies-service, 500 por padrão). Cada filme é criado ou rejeitado de forma independente: a resposta é 200 sempre que o lote é válido, e os resultados vêm na ordem dos filmes enviados, cada um com o filme criado ou com o erro dele.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lotes"
+                ],
+                "summary": "Cria vários filmes",
+                "parameters": [
+                    {
+                        "description": "Filmes a criar",
+                        "name": "movies",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchCreateRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultado de cada filme",
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchResponseSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Corpo inválido, lote vazio ou grande demais",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies:batchDelete": {
+            "post": {
+                "description": "Deleta vários filmes com uma única requisição, da mesma forma que DELETE /movies/{id} (inclusive com a exclusão lógica, se ativada). Os resultados vêm na ordem dos IDs enviados; um ID inexistente traz um erro 404 apenas no seu resultado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lotes"
+                ],
+                "summary": "Deleta vários filmes por ID",
+                "parameters": [
+                    {
+                        "description": "IDs dos filmes a deletar",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchDeleteRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultado de cada ID",
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchResponseSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Corpo inválido, lote vazio ou grande demais",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies:batchGet": {
+            "get": {
+                "description": "Busca vários filmes com uma única requisição. Os resultados vêm na ordem dos IDs pedidos; um ID inexistente traz um erro 404 apenas no seu resultado.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lotes"
+                ],
+                "summary": "Busca vários filmes por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IDs separados por vírgula (ex: 8,10,12)",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultado de cada ID",
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchResponseSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Nenhum ID ou IDs demais",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/movies:stream": {
+            "get": {
+                "description": "Envia os filmes um por linha (NDJSON), à medida que chegam do movies-service. A memória usada não depende da quantidade de filmes, por isso é a rota indicada para exportações completas. Aceita os mesmos filtros da listagem.",
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Filmes"
+                ],
+                "summary": "Exporta todos os filmes em streaming",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ano mínimo (inclusivo)",
+                        "name": "year_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Ano máximo (inclusivo)",
+                        "name": "year_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trecho do título (não diferencia maiúsculas de minúsculas)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome exato do diretor",
+                        "name": "director",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Um filme por linha",
+                        "schema": {
+                            "$ref": "#/definitions/main.MovieSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Filtro inválido",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/people": {
+            "get": {
+                "description": "Retorna todas as pessoas (diretores, atores...), ordenadas por nome.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pessoas"
+                ],
+                "summary": "Lista as pessoas",
+                "responses": {
+                    "200": {
+                        "description": "Lista de pessoas",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.PersonSwagger"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria uma pessoa, que pode então ser citada nos créditos dos filmes pelo ID. Duas pessoas podem ter o mesmo nome.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pessoas"
+                ],
+                "summary": "Cria uma pessoa",
+                "parameters": [
+                    {
+                        "description": "Dados da pessoa",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.PersonRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Pessoa criada",
+                        "schema": {
+                            "$ref": "#/definitions/main.PersonSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/people/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pessoas"
+                ],
+                "summary": "Busca uma pessoa por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Pessoa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pessoa encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.PersonSwagger"
+                        }
+                    },
+                    "404": {
+                        "description": "Pessoa não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Altera o nome da pessoa. Os créditos dos filmes continuam apontando para ela.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pessoas"
+                ],
+                "summary": "Atualiza uma pessoa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Pessoa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novos dados da pessoa",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.PersonRequestSwagger"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pessoa atualizada",
+                        "schema": {
+                            "$ref": "#/definitions/main.PersonSwagger"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Pessoa não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Apaga uma pessoa que não aparece nos créditos de nenhum filme. Se ainda aparecer, a resposta é 409 e os créditos precisam ser tirados antes.",
+                "tags": [
+                    "Pessoas"
+                ],
+                "summary": "Apaga uma pessoa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Pessoa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Pessoa apagada"
+                    },
+                    "404": {
+                        "description": "Pessoa não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "A pessoa ainda tem créditos",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/people/{id}/movies": {
+            "get": {
+                "description": "Retorna os filmes em que a pessoa tem algum crédito, do mais antigo para o mais novo. As funções dela em cada filme estão em \"credits\".",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pessoas"
+                ],
+                "summary": "Lista a filmografia de uma pessoa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da Pessoa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Filmes da pessoa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.MovieSwagger"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Pessoa não encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno no servidor",
+                        "schema": {
+                            "$ref": "#/definitions/main.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Consulta o grpc.health.v1 do movies-service e responde 200 só quando ele está SERVING (o banco de dados responde). Se o movies-service estiver fora do ar, com o banco inacessível ou se o gateway estiver desligando, responde 503.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saúde"
+                ],
+                "summary": "Sonda de prontidão",
+                "responses": {
+                    "200": {
+                        "description": "Gateway pronto para receber tráfego",
+                        "schema": {
+                            "$ref": "#/definitions/main.HealthStatus"
+                        }
+                    },
+                    "503": {
+                        "description": "Alguma dependência não está pronta",
+                        "schema": {
+                            "$ref": "#/definitions/main.HealthStatus"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "main.AddMovieToCollectionRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "movie_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.BatchCreateRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "movies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CreateMovieRequestSwagger"
+                    }
+                }
+            }
+        },
+        "main.BatchDeleteRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "main.BatchResponseSwagger": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.BatchResultSwagger"
+                    }
+                }
+            }
+        },
+        "main.BatchResultSwagger": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/main.Problem"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "movie": {
+                    "$ref": "#/definitions/main.MovieSwagger"
+                }
+            }
+        },
+        "main.CastMemberSwagger": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CollectionSwagger": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "movie_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CreateCollectionRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "movie_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.CreateMovieRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "age_rating": {
+                    "type": "string",
+                    "enum": [
+                        "G",
+                        "PG",
+                        "PG-13",
+                        "R",
+                        "NC-17",
+                        "NR"
+                    ]
+                },
+                "cast": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CastMemberSwagger"
+                    }
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CreditSwagger"
+                    }
+                },
+                "director": {
+                    "type": "string"
+                },
+                "external_ids": {
+                    "$ref": "#/definitions/main.ExternalIdsSwagger"
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "original_language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "runtime_minutes": {
+                    "type": "integer"
+                },
+                "synopsis": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.CreateReviewRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "main.CreditSwagger": {
+            "type": "object",
+            "properties": {
+                "character": {
+                    "type": "string"
+                },
+                "person_id": {
+                    "type": "string",
+                    "example": "01HZX3K8Q4W5N7M9P2R6T8V0YB"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "director",
+                        "writer",
+                        "producer",
+                        "composer",
+                        "actor"
+                    ],
+                    "example": "director"
+                }
+            }
+        },
+        "main.ExternalIdsSwagger": {
+            "type": "object",
+            "properties": {
+                "imdb_id": {
+                    "type": "string",
+                    "example": "tt0133093"
+                },
+                "tmdb_id": {
+                    "type": "integer",
+                    "example": 603
+                }
+            }
+        },
+        "main.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "title"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "o título do filme não pode ser vazio"
+                }
+            }
+        },
+        "main.HealthStatus": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ok",
+                        "unavailable"
+                    ]
+                }
+            }
+        },
+        "main.MovieSearchResultSwagger": {
+            "type": "object",
+            "properties": {
+                "movie": {
+                    "$ref": "#/definitions/main.MovieSwagger"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "main.MovieSwagger": {
+            "type": "object",
+            "properties": {
+                "age_rating": {
+                    "type": "string",
+                    "enum": [
+                        "G",
+                        "PG",
+                        "PG-13",
+                        "R",
+                        "NC-17",
+                        "NR"
+                    ]
+                },
+                "cast": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CastMemberSwagger"
+                    }
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CreditSwagger"
+                    }
+                },
+                "director": {
+                    "type": "string"
+                },
+                "external_ids": {
+                    "$ref": "#/definitions/main.ExternalIdsSwagger"
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "original_language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "rating": {
+                    "$ref": "#/definitions/main.RatingSwagger"
+                },
+                "runtime_minutes": {
+                    "type": "integer"
+                },
+                "synopsis": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.PatchMovieRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "age_rating": {
+                    "type": "string",
+                    "enum": [
+                        "G",
+                        "PG",
+                        "PG-13",
+                        "R",
+                        "NC-17",
+                        "NR"
+                    ]
+                },
+                "cast": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CastMemberSwagger"
+                    }
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CreditSwagger"
+                    }
+                },
+                "director": {
+                    "type": "string"
+                },
+                "external_ids": {
+                    "$ref": "#/definitions/main.ExternalIdsSwagger"
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "original_language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "runtime_minutes": {
+                    "type": "integer"
+                },
+                "synopsis": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.PersonRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.PersonSwagger": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.Problem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Explicação desta ocorrência do erro.",
+                    "type": "string",
+                    "example": "o título do filme não pode ser vazio"
+                },
+                "errors": {
+                    "description": "Campos inválidos, presentes apenas nos erros de validação.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.FieldError"
+                    }
+                },
+                "instance": {
+                    "description": "Caminho da requisição que causou o erro.",
+                    "type": "string",
+                    "example": "/movies"
+                },
+                "request_id": {
+                    "description": "ID da requisição, o mesmo do cabeçalho X-Request-ID. Informe-o ao reportar um problema.",
+                    "type": "string",
+                    "example": "0192f0c4-7b3a-7000-8000-000000000000"
+                },
+                "status": {
+                    "description": "Status HTTP da resposta, repetido no corpo.",
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "description": "Resumo da categoria do erro; é o mesmo para todos os erros do mesmo Type.",
+                    "type": "string",
+                    "example": "Requisição inválida"
+                },
+                "type": {
+                    "description": "URI que identifica a categoria do erro (ex: /problems/invalid-argument).",
+                    "type": "string",
+                    "example": "/problems/invalid-argument"
+                }
+            }
+        },
+        "main.RatingSwagger": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 4.5
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "main.Review": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "01HZX3K8Q4W5N7M9P2R6T8V0YB"
+                },
+                "movie_id": {
+                    "type": "string",
+                    "example": "10"
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "ana"
+                }
+            }
+        },
+        "main.UpdateCollectionRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.UpdateMovieRequestSwagger": {
+            "type": "object",
+            "properties": {
+                "age_rating": {
+                    "type": "string",
+                    "enum": [
+                        "G",
+                        "PG",
+                        "PG-13",
+                        "R",
+                        "NC-17",
+                        "NR"
+                    ]
+                },
+                "cast": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CastMemberSwagger"
+                    }
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.CreditSwagger"
+                    }
+                },
+                "director": {
+                    "type": "string"
+                },
+                "external_ids": {
+                    "$ref": "#/definitions/main.ExternalIdsSwagger"
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "original_language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "runtime_minutes": {
+                    "type": "integer"
+                },
+                "synopsis": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "main.WatchlistItemSwagger": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "movie": {
+                    "$ref": "#/definitions/main.MovieSwagger"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Token de acesso JWT (HS256), no formato \"Bearer \u003ctoken\u003e\". O usuário é o claim \"sub\".",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
@@ -261,7 +2270,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{},
 	Title:            "API de Gerenciamento de Filmes",
-	Description:      "API REST para um sistema de microsserviços que gerencia filmes.",
+	Description:      "API REST para um sistema de microsserviços que gerencia filmes.\nTodas as respostas de erro usam o formato Problem Details da RFC 7807 (Content-Type: application/problem+json), descrito pelo schema Problem. Erros de validação trazem a lista de campos inválidos em \"errors\". Toda resposta traz o cabeçalho X-Request-ID, que também aparece no campo \"request_id\" dos erros.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
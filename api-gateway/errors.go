@@ -0,0 +1,213 @@
+// Local: api-gateway/errors.go
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// Problem é o corpo de todas as respostas de erro da API, no formato "Problem Details"
+// da RFC 7807 (Content-Type: application/problem+json). Os clientes devem decidir o que
+// fazer pelo Type (ou pelo Status); Title e Detail são textos para pessoas.
+type Problem struct {
+	// URI que identifica a categoria do erro (ex: /problems/invalid-argument).
+	Type string `json:"type" example:"/problems/invalid-argument"`
+	// Resumo da categoria do erro; é o mesmo para todos os erros do mesmo Type.
+	Title string `json:"title" example:"Requisição inválida"`
+	// Status HTTP da resposta, repetido no corpo.
+	Status int `json:"status" example:"400"`
+	// Explicação desta ocorrência do erro.
+	Detail string `json:"detail,omitempty" example:"o título do filme não pode ser vazio"`
+	// Caminho da requisição que causou o erro.
+	Instance string `json:"instance,omitempty" example:"/movies"`
+	// ID da requisição, o mesmo do cabeçalho X-Request-ID. Informe-o ao reportar um problema.
+	RequestID string `json:"request_id" example:"0192f0c4-7b3a-7000-8000-000000000000"`
+	// Campos inválidos, presentes apenas nos erros de validação.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError descreve um campo (do corpo ou da query string) com valor inválido.
+type FieldError struct {
+	Field   string `json:"field" example:"title"`
+	Message string `json:"message" example:"o título do filme não pode ser vazio"`
+}
+
+// problemType é uma categoria de erro da API.
+type problemType struct {
+	uri   string
+	title string
+}
+
+var (
+	problemInvalidArgument  = problemType{"/problems/invalid-argument", "Requisição inválida"}
+	problemNotFound         = problemType{"/problems/not-found", "Recurso não encontrado"}
+	problemMethodNotAllowed = problemType{"/problems/method-not-allowed", "Método não permitido"}
+	problemConflict         = problemType{"/problems/conflict", "Conflito com o estado atual"}
+	problemUnauthenticated  = problemType{"/problems/unauthenticated", "Autenticação necessária"}
+	problemPermission       = problemType{"/problems/permission-denied", "Acesso negado"}
+	problemRateLimited      = problemType{"/problems/rate-limited", "Limite de requisições excedido"}
+	problemCanceled         = problemType{"/problems/canceled", "Requisição cancelada"}
+	problemTimeout          = problemType{"/problems/timeout", "Tempo de resposta esgotado"}
+	problemUnavailable      = problemType{"/problems/unavailable", "Serviço indisponível"}
+	problemNotImplemented   = problemType{"/problems/not-implemented", "Operação não implementada"}
+	problemInternal         = problemType{"/problems/internal", "Erro interno no servidor"}
+)
+
+// statusClientClosedRequest é o status (não padronizado, criado pelo nginx) usado quando
+// o próprio cliente desistiu da requisição antes da resposta.
+const statusClientClosedRequest = 499
+
+// problemFromCode converte um código gRPC no status HTTP e na categoria de erro equivalentes.
+// A tabela de status segue a correspondência documentada em google/rpc/code.proto.
+func problemFromCode(code codes.Code) (int, problemType) {
+	switch code {
+	case codes.Canceled:
+		return statusClientClosedRequest, problemCanceled
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest, problemInvalidArgument
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized, problemUnauthenticated
+	case codes.PermissionDenied:
+		return http.StatusForbidden, problemPermission
+	case codes.NotFound:
+		return http.StatusNotFound, problemNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict, problemConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests, problemRateLimited
+	case codes.Unimplemented:
+		return http.StatusNotImplemented, problemNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable, problemUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout, problemTimeout
+	}
+	// Unknown, Internal, DataLoss e qualquer código novo.
+	return http.StatusInternalServerError, problemInternal
+}
+
+// restFieldNames traduz os nomes de campo usados pelo movies-service (os do .proto)
+// para os nomes que o cliente da API REST enviou na query string.
+var restFieldNames = map[string]string{
+	"filter.year_min":       "year_from",
+	"filter.year_max":       "year_to",
+	"filter.title_contains": "q",
+	"filter.director":       "director",
+	"order_by":              "sort",
+	"query":                 "q",
+}
+
+// newProblem monta um Problem, completando Instance e RequestID a partir da requisição.
+func newProblem(r *http.Request, status int, kind problemType, detail string, fieldErrors []FieldError) *Problem {
+	return &Problem{
+		Type:      kind.uri,
+		Title:     kind.title,
+		Status:    status,
+		Detail:    detail,
+		Instance:  r.URL.Path,
+		RequestID: requestIDFrom(r.Context()),
+		Errors:    fieldErrors,
+	}
+}
+
+// writeProblem escreve a resposta de erro.
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, kind problemType, detail string, fieldErrors []FieldError) {
+	problem := newProblem(r, status, kind, detail, fieldErrors)
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		slog.ErrorContext(r.Context(), "falha ao codificar a resposta de erro", "error", err)
+	}
+}
+
+// writeGrpcError escreve a resposta de erro para uma chamada gRPC que falhou.
+// Erros do cliente (4xx) levam a mensagem do movies-service, que explica o que corrigir, e os
+// campos inválidos que vierem em errdetails.BadRequest; erros do servidor (5xx) são
+// registrados no log e respondidos com internalMessage, para não expor detalhes da infraestrutura.
+func writeGrpcError(w http.ResponseWriter, r *http.Request, err error, method, internalMessage string) {
+	st := status.Convert(err)
+	code, kind := problemFromCode(st.Code())
+	if code >= http.StatusInternalServerError {
+		slog.ErrorContext(r.Context(), "falha na chamada gRPC", "grpc_method", method, "error", err)
+		writeProblem(w, r, code, kind, internalMessage, nil)
+		return
+	}
+
+	var fieldErrors []FieldError
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			fieldErrors = append(fieldErrors, newFieldError(violation.GetField(), violation.GetDescription()))
+		}
+	}
+	writeProblem(w, r, code, kind, st.Message(), fieldErrors)
+}
+
+// itemProblem converte o erro de um item de um lote no mesmo Problem que a rota individual
+// responderia para ele. Os erros internos já chegam sem detalhes do movies-service.
+func itemProblem(r *http.Request, itemErr *pb.ItemError) *Problem {
+	if itemErr == nil {
+		return nil
+	}
+	code, kind := problemFromCode(codes.Code(itemErr.GetCode()))
+	var fieldErrors []FieldError
+	for _, violation := range itemErr.GetFieldViolations() {
+		fieldErrors = append(fieldErrors, newFieldError(violation.GetField(), violation.GetDescription()))
+	}
+	return newProblem(r, code, kind, itemErr.GetMessage(), fieldErrors)
+}
+
+// newFieldError cria o FieldError de um campo do movies-service, usando o nome do campo na API REST.
+func newFieldError(field, message string) FieldError {
+	if restName, ok := restFieldNames[field]; ok {
+		field = restName
+	}
+	return FieldError{Field: field, Message: message}
+}
+
+// invalidParamError é um parâmetro da query string que o próprio gateway rejeitou
+// (ex: um page_size que não é um número), antes de chamar o movies-service.
+type invalidParamError struct {
+	name   string
+	value  string
+	reason string
+}
+
+func (e *invalidParamError) Error() string {
+	return fmt.Sprintf("parâmetro %s inválido: '%s' %s", e.name, e.value, e.reason)
+}
+
+// writeBadRequest escreve um erro 400 detectado no gateway. Se o erro for de um
+// parâmetro, ele também aparece na lista de campos inválidos.
+func writeBadRequest(w http.ResponseWriter, r *http.Request, err error) {
+	var fieldErrors []FieldError
+	var paramErr *invalidParamError
+	if errors.As(err, &paramErr) {
+		fieldErrors = []FieldError{{Field: paramErr.name, Message: paramErr.reason}}
+	}
+	writeProblem(w, r, http.StatusBadRequest, problemInvalidArgument, err.Error(), fieldErrors)
+}
+
+// notFound e methodNotAllowed substituem as respostas em texto puro do roteador.
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, http.StatusNotFound, problemNotFound, "a rota "+r.URL.Path+" não existe", nil)
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, http.StatusMethodNotAllowed, problemMethodNotAllowed,
+		"o método "+r.Method+" não é aceito em "+r.URL.Path, nil)
+}
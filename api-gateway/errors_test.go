@@ -0,0 +1,113 @@
+// Local: api-gateway/errors_test.go
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestProblemFromCode testa a tabela de códigos gRPC para status HTTP e categorias de erro.
+func TestProblemFromCode(t *testing.T) {
+	tests := []struct {
+		code       codes.Code
+		wantStatus int
+		wantKind   problemType
+	}{
+		{codes.Canceled, statusClientClosedRequest, problemCanceled},
+		{codes.InvalidArgument, http.StatusBadRequest, problemInvalidArgument},
+		{codes.FailedPrecondition, http.StatusBadRequest, problemInvalidArgument},
+		{codes.OutOfRange, http.StatusBadRequest, problemInvalidArgument},
+		{codes.Unauthenticated, http.StatusUnauthorized, problemUnauthenticated},
+		{codes.PermissionDenied, http.StatusForbidden, problemPermission},
+		{codes.NotFound, http.StatusNotFound, problemNotFound},
+		{codes.AlreadyExists, http.StatusConflict, problemConflict},
+		{codes.Aborted, http.StatusConflict, problemConflict},
+		{codes.ResourceExhausted, http.StatusTooManyRequests, problemRateLimited},
+		{codes.Unimplemented, http.StatusNotImplemented, problemNotImplemented},
+		{codes.Unavailable, http.StatusServiceUnavailable, problemUnavailable},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout, problemTimeout},
+		{codes.Unknown, http.StatusInternalServerError, problemInternal},
+		{codes.Internal, http.StatusInternalServerError, problemInternal},
+		{codes.DataLoss, http.StatusInternalServerError, problemInternal},
+		{codes.Code(99), http.StatusInternalServerError, problemInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			gotStatus, gotKind := problemFromCode(tt.code)
+
+			if gotStatus != tt.wantStatus || gotKind != tt.wantKind {
+				t.Errorf("Esperava (%d, %s), mas recebeu (%d, %s)", tt.wantStatus, tt.wantKind.uri, gotStatus, gotKind.uri)
+			}
+		})
+	}
+}
+
+// TestWriteGrpcError testa a resposta problem+json de uma chamada gRPC que falhou: os erros
+// do cliente levam a mensagem e os campos do serviço, e os do servidor escondem os detalhes.
+func TestWriteGrpcError(t *testing.T) {
+	invalid, err := status.New(codes.InvalidArgument, "ano inicial inválido").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "filter.year_min", Description: "o ano deve ser positivo"},
+			{Field: "title", Description: "o título do filme não pode ser vazio"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao montar o status: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantType   string
+		wantDetail string
+		wantErrors []FieldError
+	}{
+		{"campos inválidos", invalid.Err(), http.StatusBadRequest, "/problems/invalid-argument", "ano inicial inválido",
+			[]FieldError{{Field: "year_from", Message: "o ano deve ser positivo"}, {Field: "title", Message: "o título do filme não pode ser vazio"}}},
+		{"não encontrado", status.Error(codes.NotFound, "filme com o ID '7' não encontrado"), http.StatusNotFound, "/problems/not-found",
+			"filme com o ID '7' não encontrado", nil},
+		{"acesso negado", status.Error(codes.PermissionDenied, "a avaliação é de outro usuário"), http.StatusForbidden, "/problems/permission-denied",
+			"a avaliação é de outro usuário", nil},
+		{"erro interno", status.Error(codes.Internal, "senha do banco: 1234"), http.StatusInternalServerError, "/problems/internal",
+			"Erro interno ao buscar o filme", nil},
+		{"serviço indisponível", status.Error(codes.Unavailable, "connection refused"), http.StatusServiceUnavailable, "/problems/unavailable",
+			"Erro interno ao buscar o filme", nil},
+		{"erro que não é gRPC", errors.New("falha qualquer"), http.StatusInternalServerError, "/problems/internal",
+			"Erro interno ao buscar o filme", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/movies/7", nil)
+			rec := httptest.NewRecorder()
+
+			writeGrpcError(rec, req, tt.err, "GetMovie", "Erro interno ao buscar o filme")
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Esperava o status %d, mas recebeu %d", tt.wantStatus, rec.Code)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/problem+json" {
+				t.Errorf("Esperava o Content-Type application/problem+json, mas recebeu %q", got)
+			}
+			var problem Problem
+			if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+				t.Fatalf("Erro inesperado ao decodificar o corpo: %v", err)
+			}
+			if problem.Type != tt.wantType || problem.Status != tt.wantStatus || problem.Detail != tt.wantDetail || problem.Instance != "/movies/7" {
+				t.Errorf("Esperava (%s, %d, %q, /movies/7), mas recebeu %+v", tt.wantType, tt.wantStatus, tt.wantDetail, problem)
+			}
+			if fmt.Sprint(problem.Errors) != fmt.Sprint(tt.wantErrors) {
+				t.Errorf("Esperava os campos %v, mas recebeu %v", tt.wantErrors, problem.Errors)
+			}
+		})
+	}
+}
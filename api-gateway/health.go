@@ -0,0 +1,98 @@
+// Local: api-gateway/health.go
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// readinessTimeout é o prazo da consulta ao grpc.health.v1 do movies-service em /readyz.
+// Um orquestrador costuma consultar a cada poucos segundos, então a resposta precisa ser rápida.
+const readinessTimeout = 2 * time.Second
+
+// HealthStatus é a resposta de /healthz e /readyz. Em /readyz, checks traz o estado de
+// cada dependência consultada (SERVING, NOT_SERVING, UNKNOWN ou UNREACHABLE).
+type HealthStatus struct {
+	Status string            `json:"status" enums:"ok,unavailable"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// healthChecker responde às sondas de vida (/healthz) e de prontidão (/readyz) do gateway.
+// Ele fica fora de handler porque guarda estado (shuttingDown), que não pode ser copiado.
+type healthChecker struct {
+	movies       healthpb.HealthClient
+	shuttingDown atomic.Bool
+}
+
+// newHealthChecker cria as sondas a partir da conexão com o movies-service. O reviews-service
+// não entra na prontidão: sem ele, só as rotas de avaliações falham, e o resto da API continua no ar.
+func newHealthChecker(moviesConn grpc.ClientConnInterface) *healthChecker {
+	return &healthChecker{movies: healthpb.NewHealthClient(moviesConn)}
+}
+
+// shutdown é chamado no início do desligamento gracioso: a partir daí as duas sondas respondem
+// 503, para que o balanceador pare de mandar tráfego enquanto as requisições em andamento terminam.
+func (c *healthChecker) shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// @Summary      Sonda de vida
+// @Description  Responde 200 enquanto o processo do gateway está de pé, sem consultar os microsserviços. Durante o desligamento, responde 503.
+// @Tags         Saúde
+// @Produce      json
+// @Success      200  {object}  HealthStatus "Gateway no ar"
+// @Failure      503  {object}  HealthStatus "Gateway desligando"
+// @Router       /healthz [get]
+func (c *healthChecker) liveness(w http.ResponseWriter, r *http.Request) {
+	if c.shuttingDown.Load() {
+		writeHealth(w, http.StatusServiceUnavailable, HealthStatus{Status: "unavailable"})
+		return
+	}
+	writeHealth(w, http.StatusOK, HealthStatus{Status: "ok"})
+}
+
+// @Summary      Sonda de prontidão
+// @Description  Consulta o grpc.health.v1 do movies-service e responde 200 só quando ele está SERVING (o banco de dados responde). Se o movies-service estiver fora do ar, com o banco inacessível ou se o gateway estiver desligando, responde 503.
+// @Tags         Saúde
+// @Produce      json
+// @Success      200  {object}  HealthStatus "Gateway pronto para receber tráfego"
+// @Failure      503  {object}  HealthStatus "Alguma dependência não está pronta"
+// @Router       /readyz [get]
+func (c *healthChecker) readiness(w http.ResponseWriter, r *http.Request) {
+	if c.shuttingDown.Load() {
+		writeHealth(w, http.StatusServiceUnavailable, HealthStatus{Status: "unavailable"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+	// O serviço vazio representa o servidor inteiro, cujo estado acompanha o ping no banco.
+	state := "UNREACHABLE"
+	if res, err := c.movies.Check(ctx, &healthpb.HealthCheckRequest{}); err == nil {
+		state = res.GetStatus().String()
+	}
+
+	body := HealthStatus{Status: "ok", Checks: map[string]string{"movies-service": state}}
+	if state != healthpb.HealthCheckResponse_SERVING.String() {
+		body.Status = "unavailable"
+		writeHealth(w, http.StatusServiceUnavailable, body)
+		return
+	}
+	writeHealth(w, http.StatusOK, body)
+}
+
+// writeHealth escreve a resposta das sondas. Elas não geram log: são chamadas a cada poucos
+// segundos e encheriam o log sem trazer informação.
+func writeHealth(w http.ResponseWriter, status int, body HealthStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
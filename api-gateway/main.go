@@ -5,22 +5,30 @@ package main
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
-	"time"
 
 	"github.com/gorilla/mux"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	httpSwagger "github.com/swaggo/http-swagger" // IMPORT DO SWAGGER
 
 	_ "github.com/alenrique/Movies-microservices/api-gateway/docs"
+	"github.com/alenrique/Movies-microservices/config"
+	"github.com/alenrique/Movies-microservices/logging"
+	"github.com/alenrique/Movies-microservices/metrics"
+	"github.com/alenrique/Movies-microservices/tracing"
 
 	pb "github.com/alenrique/Movies-microservices/proto" // Importamos nosso pacote proto
 )
@@ -29,29 +37,122 @@ import (
 // Usar uma struct para os handlers é uma boa prática para injetar dependências,
 // como o cliente gRPC, de forma organizada.
 type handler struct {
-	client pb.MovieServiceClient
+	client      pb.MovieServiceClient
+	collections pb.CollectionServiceClient
+	people      pb.PeopleServiceClient
+	reviews     pb.ReviewServiceClient
+	watchlist   pb.WatchlistServiceClient
 }
 
 // MovieSwagger é uma struct apenas para documentação Swagger.
 // Copie os campos do seu pb.Movie aqui.
+// Os campos a partir de genres são opcionais e não aparecem na resposta quando estão vazios.
 type MovieSwagger struct {
-	Id       string `json:"id"`
-	Title    string `json:"title"`
-	Director string `json:"director"`
-	Year     int32  `json:"year"`
+	Id               string              `json:"id"`
+	Title            string              `json:"title"`
+	Director         string              `json:"director"`
+	Year             int32               `json:"year"`
+	Genres           []string            `json:"genres,omitempty"`
+	RuntimeMinutes   int32               `json:"runtime_minutes,omitempty"`
+	Synopsis         string              `json:"synopsis,omitempty"`
+	Cast             []CastMemberSwagger `json:"cast,omitempty"`
+	OriginalLanguage string              `json:"original_language,omitempty" example:"en"`
+	AgeRating        string              `json:"age_rating,omitempty" enums:"G,PG,PG-13,R,NC-17,NR"`
+	ExternalIds      *ExternalIdsSwagger `json:"external_ids,omitempty"`
+	Credits          []CreditSwagger     `json:"credits,omitempty"`
+	Rating           *RatingSwagger      `json:"rating,omitempty"`
+}
+
+// RatingSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.RatingSummary aqui. Só aparece em GET /movies/{id}.
+type RatingSwagger struct {
+	Average float64 `json:"average" example:"4.5"`
+	Count   int64   `json:"count" example:"12"`
+}
+
+// CastMemberSwagger é uma struct apenas para documentação Swagger.
+// Representa uma pessoa do elenco e o papel (personagem) que ela interpreta.
+type CastMemberSwagger struct {
+	Name string `json:"name"`
+	Role string `json:"role,omitempty"`
+}
+
+// CreditSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.Credit aqui.
+type CreditSwagger struct {
+	PersonID  string `json:"person_id" example:"01HZX3K8Q4W5N7M9P2R6T8V0YB"`
+	Role      string `json:"role" example:"director" enums:"director,writer,producer,composer,actor"`
+	Character string `json:"character,omitempty"`
+}
+
+// ExternalIdsSwagger é uma struct apenas para documentação Swagger.
+// Representa os IDs do filme no IMDb e no TMDB.
+type ExternalIdsSwagger struct {
+	ImdbId string `json:"imdb_id,omitempty" example:"tt0133093"`
+	TmdbId int64  `json:"tmdb_id,omitempty" example:"603"`
+}
+
+// MovieSearchResultSwagger é uma struct apenas para documentação Swagger.
+// Representa um resultado da busca: o filme e a pontuação de relevância.
+type MovieSearchResultSwagger struct {
+	Movie MovieSwagger `json:"movie"`
+	Score float64      `json:"score"`
 }
 
 // CreateMovieRequestSwagger é uma struct apenas para documentação Swagger.
 // Copie os campos do seu pb.CreateMovieRequest aqui.
 type CreateMovieRequestSwagger struct {
-	Title    string `json:"title"`
-	Director string `json:"director"`
-	Year     int32  `json:"year"`
+	Title            string              `json:"title"`
+	Director         string              `json:"director"`
+	Year             int32               `json:"year"`
+	Genres           []string            `json:"genres,omitempty"`
+	RuntimeMinutes   int32               `json:"runtime_minutes,omitempty"`
+	Synopsis         string              `json:"synopsis,omitempty"`
+	Cast             []CastMemberSwagger `json:"cast,omitempty"`
+	OriginalLanguage string              `json:"original_language,omitempty" example:"en"`
+	AgeRating        string              `json:"age_rating,omitempty" enums:"G,PG,PG-13,R,NC-17,NR"`
+	ExternalIds      *ExternalIdsSwagger `json:"external_ids,omitempty"`
+	Credits          []CreditSwagger     `json:"credits,omitempty"`
+}
+
+// UpdateMovieRequestSwagger é uma struct apenas para documentação Swagger.
+// O ID vem da URL, por isso não faz parte do corpo.
+type UpdateMovieRequestSwagger struct {
+	Title            string              `json:"title"`
+	Director         string              `json:"director"`
+	Year             int32               `json:"year"`
+	Genres           []string            `json:"genres,omitempty"`
+	RuntimeMinutes   int32               `json:"runtime_minutes,omitempty"`
+	Synopsis         string              `json:"synopsis,omitempty"`
+	Cast             []CastMemberSwagger `json:"cast,omitempty"`
+	OriginalLanguage string              `json:"original_language,omitempty" example:"en"`
+	AgeRating        string              `json:"age_rating,omitempty" enums:"G,PG,PG-13,R,NC-17,NR"`
+	ExternalIds      *ExternalIdsSwagger `json:"external_ids,omitempty"`
+	Credits          []CreditSwagger     `json:"credits,omitempty"`
+}
+
+// PatchMovieRequestSwagger é uma struct apenas para documentação Swagger.
+// Segue a semântica de JSON Merge Patch (RFC 7396): apenas os campos enviados são alterados
+// e um campo com valor null volta ao seu valor vazio. Listas (genres, cast) são substituídas
+// inteiras, e em external_ids apenas os IDs enviados são alterados.
+type PatchMovieRequestSwagger struct {
+	Title            string              `json:"title,omitempty"`
+	Director         string              `json:"director,omitempty"`
+	Year             int32               `json:"year,omitempty"`
+	Genres           []string            `json:"genres,omitempty"`
+	RuntimeMinutes   int32               `json:"runtime_minutes,omitempty"`
+	Synopsis         string              `json:"synopsis,omitempty"`
+	Cast             []CastMemberSwagger `json:"cast,omitempty"`
+	OriginalLanguage string              `json:"original_language,omitempty" example:"en"`
+	AgeRating        string              `json:"age_rating,omitempty" enums:"G,PG,PG-13,R,NC-17,NR"`
+	ExternalIds      *ExternalIdsSwagger `json:"external_ids,omitempty"`
+	Credits          []CreditSwagger     `json:"credits,omitempty"`
 }
 
 // @title           API de Gerenciamento de Filmes
 // @version         1.0
 // @description     API REST para um sistema de microsserviços que gerencia filmes.
+// @description     Todas as respostas de erro usam o formato Problem Details da RFC 7807 (Content-Type: application/problem+json), descrito pelo schema Problem. Erros de validação trazem a lista de campos inválidos em "errors". Toda resposta traz o cabeçalho X-Request-ID, que também aparece no campo "request_id" dos erros.
 // @termsOfService  http://swagger.io/terms/
 
 // @contact.name   Henrique Alencar
@@ -63,16 +164,62 @@ type CreateMovieRequestSwagger struct {
 
 // @host      localhost:8080
 // @BasePath  /
+
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Token de acesso JWT (HS256), no formato "Bearer <token>". O usuário é o claim "sub".
 func main() {
-	// --- Conexão gRPC (sem alterações) ---
-	log.Println("Iniciando cliente gRPC para o Movie Service...")
-	conn, err := grpc.NewClient("movies-service:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// --- Configuração ---
+	// Valores padrão, arquivo YAML, variáveis de ambiente e flags, nessa ordem (ver o pacote config).
+	cfg := config.DefaultGateway()
+	config.MustLoad("api-gateway", cfg)
+
+	// --- Logs (log/slog) ---
+	// Uma linha JSON por registro, a partir do nível logging.level (LOG_LEVEL).
+	logging.Setup("api-gateway", cfg.Logging)
+
+	// --- Rastreamento (OpenTelemetry) ---
+	// O exportador é escolhido por tracing.exporter (TRACING_EXPORTER): none, stdout ou otlp.
+	shutdownTracing, err := tracing.Setup(context.Background(), "api-gateway", cfg.Tracing)
 	if err != nil {
-		log.Fatalf("Não foi possível conectar ao servidor gRPC: %v", err)
+		logging.Fatal("falha ao configurar o rastreamento", "error", err)
+	}
+
+	// --- Conexão gRPC ---
+	slog.Info("iniciando os clientes gRPC", "movies_addr", cfg.MoviesAddr, "reviews_addr", cfg.ReviewsAddr)
+	// Os interceptors do pacote metrics medem cada chamada gRPC feita pelo gateway, o pacote
+	// tracing cria um span para cada uma e envia o traceparent nos metadados, e o pacote
+	// logging envia o ID da requisição (X-Request-ID) no metadado x-request-id.
+	dialOptions := append(metrics.DialOptions(), tracing.DialOptions()...)
+	dialOptions = append(dialOptions, logging.DialOptions()...)
+	dialOptions = append(dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(cfg.MoviesAddr, dialOptions...)
+	if err != nil {
+		logging.Fatal("não foi possível conectar ao movies-service", "error", err)
 	}
 	defer conn.Close()
 	client := pb.NewMovieServiceClient(conn)
-	h := handler{client: client}
+	// As avaliações ficam em outro microsserviço, o reviews-service, com a sua própria conexão.
+	reviewsConn, err := grpc.NewClient(cfg.ReviewsAddr, dialOptions...)
+	if err != nil {
+		logging.Fatal("não foi possível conectar ao reviews-service", "error", err)
+	}
+	defer reviewsConn.Close()
+	// Os serviços de coleções, de pessoas e das listas de interesse rodam no mesmo processo do
+	// movies-service, então usam a mesma conexão.
+	h := handler{
+		client:      client,
+		collections: pb.NewCollectionServiceClient(conn),
+		people:      pb.NewPeopleServiceClient(conn),
+		reviews:     pb.NewReviewServiceClient(reviewsConn),
+		watchlist:   pb.NewWatchlistServiceClient(conn),
+	}
+	// As rotas /me/... e a escrita das avaliações exigem um token JWT assinado com a chave de
+	// auth_jwt_secret (ver auth.go).
+	auth := newAuthenticator(cfg.AuthJWTSecret)
+	// As sondas de vida e de prontidão usam o grpc.health.v1 do movies-service (ver health.go).
+	health := newHealthChecker(conn)
 
 	// --- Configuração do Servidor HTTP (sem alterações) ---
 	router := mux.NewRouter()
@@ -80,20 +227,67 @@ func main() {
 	// --- CORREÇÃO 2: Adicionando a rota do Swagger ---
 	router.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
 
+	router.HandleFunc("/healthz", health.liveness).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", health.readiness).Methods(http.MethodGet)
+	// Métricas Prometheus do gateway: as requisições HTTP (ver metrics.go) e as chamadas gRPC.
+	router.Handle("/metrics", metrics.Handler()).Methods(http.MethodGet)
+
 	router.HandleFunc("/movies", h.listMovies).Methods(http.MethodGet)
 	router.HandleFunc("/movies", h.createMovie).Methods(http.MethodPost)
+	router.HandleFunc("/movies:stream", h.streamMovies).Methods(http.MethodGet)
+	router.HandleFunc("/movies:batchCreate", h.batchCreateMovies).Methods(http.MethodPost)
+	router.HandleFunc("/movies:batchGet", h.batchGetMovies).Methods(http.MethodGet)
+	router.HandleFunc("/movies:batchDelete", h.batchDeleteMovies).Methods(http.MethodPost)
+	// A rota de busca precisa vir antes de /movies/{id}, senão "search" seria tratado como um ID.
+	router.HandleFunc("/movies/search", h.searchMovies).Methods(http.MethodGet)
+	// Ações sobre um filme usam o sufixo ":acao" (como em /movies:stream), que não se confunde com um ID.
+	router.HandleFunc("/movies/{id}:restore", h.restoreMovie).Methods(http.MethodPost)
 	router.HandleFunc("/movies/{id}", h.getMovie).Methods(http.MethodGet)
+	router.HandleFunc("/movies/{id}", h.updateMovie).Methods(http.MethodPut)
+	router.HandleFunc("/movies/{id}", h.patchMovie).Methods(http.MethodPatch)
 	router.HandleFunc("/movies/{id}", h.deleteMovie).Methods(http.MethodDelete)
+	router.HandleFunc("/movies/{id}/reviews", h.listMovieReviews).Methods(http.MethodGet)
+	router.HandleFunc("/movies/{id}/reviews", auth.requireUser(h.createMovieReview)).Methods(http.MethodPost)
+	router.HandleFunc("/movies/{id}/reviews/{reviewId}", auth.requireUser(h.deleteMovieReview)).Methods(http.MethodDelete)
+
+	router.HandleFunc("/collections", h.listCollections).Methods(http.MethodGet)
+	router.HandleFunc("/collections", h.createCollection).Methods(http.MethodPost)
+	router.HandleFunc("/collections/{id}", h.getCollection).Methods(http.MethodGet)
+	router.HandleFunc("/collections/{id}", h.updateCollection).Methods(http.MethodPut)
+	router.HandleFunc("/collections/{id}", h.deleteCollection).Methods(http.MethodDelete)
+	router.HandleFunc("/collections/{id}/movies", h.listCollectionMovies).Methods(http.MethodGet)
+	router.HandleFunc("/collections/{id}/movies", h.addMovieToCollection).Methods(http.MethodPost)
+	router.HandleFunc("/collections/{id}/movies/{movieId}", h.removeMovieFromCollection).Methods(http.MethodDelete)
+
+	router.HandleFunc("/people", h.listPeople).Methods(http.MethodGet)
+	router.HandleFunc("/people", h.createPerson).Methods(http.MethodPost)
+	router.HandleFunc("/people/{id}", h.getPerson).Methods(http.MethodGet)
+	router.HandleFunc("/people/{id}", h.updatePerson).Methods(http.MethodPut)
+	router.HandleFunc("/people/{id}", h.deletePerson).Methods(http.MethodDelete)
+	router.HandleFunc("/people/{id}/movies", h.listPersonMovies).Methods(http.MethodGet)
+
+	router.HandleFunc("/me/watchlist", auth.requireUser(h.listWatchlist)).Methods(http.MethodGet)
+	router.HandleFunc("/me/watchlist/{movieId}", auth.requireUser(h.addToWatchlist)).Methods(http.MethodPut)
+	router.HandleFunc("/me/watchlist/{movieId}", auth.requireUser(h.removeFromWatchlist)).Methods(http.MethodDelete)
+
+	// Rotas e métodos inexistentes também respondem no formato de erro da API (ver errors.go).
+	router.NotFoundHandler = http.HandlerFunc(notFound)
+	router.MethodNotAllowedHandler = http.HandlerFunc(methodNotAllowed)
+	// Depois que o roteador encontra a rota, o modelo dela vai para as métricas, o log de
+	// acesso (ver route.go) e o nome do span (ver tracing.go).
+	router.Use(recordRoute, nameSpan)
 
 	// --- NOVO: Lógica de Desligamento Gracioso (Graceful Shutdown) ---
-	server := &http.Server{Addr: ":8080", Handler: router}
+	// Os middlewares envolvem o roteador inteiro, inclusive as respostas 404 e 405: o request
+	// ID, as métricas e o log de acesso (ver observe.go) e o span da requisição.
+	server := &http.Server{Addr: cfg.HTTPAddr, Handler: withRequestID(withObservation(withTracing(router)))}
 
 	// Canal para escutar por erros do servidor
 	errChan := make(chan error, 1)
 
 	// Inicia o servidor HTTP em uma goroutine separada
 	go func() {
-		log.Println("Servidor HTTP do API Gateway escutando na porta 8080")
+		slog.Info("servidor HTTP do API Gateway escutando", "addr", cfg.HTTPAddr)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			errChan <- err
 		}
@@ -106,53 +300,270 @@ func main() {
 	// Bloqueia a execução aqui até que um erro ou sinal seja recebido
 	select {
 	case err := <-errChan:
-		log.Fatalf("Erro fatal no servidor HTTP: %v", err)
+		logging.Fatal("erro fatal no servidor HTTP", "error", err)
 	case s := <-signalChan:
-		log.Printf("Sinal '%v' recebido, iniciando desligamento gracioso...", s)
+		slog.Info("sinal recebido, iniciando desligamento gracioso", "signal", s.String())
+
+		// Antes de tudo, as sondas passam a responder 503, para que o balanceador tire o
+		// gateway da rotação enquanto as requisições em andamento terminam.
+		health.shutdown()
 
 		// Cria um contexto com tempo limite para o desligamento
-		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 		defer shutdownCancel()
 
 		// Tenta desligar o servidor de forma ordenada
 		if err := server.Shutdown(shutdownCtx); err != nil {
-			log.Fatalf("Erro durante o desligamento gracioso: %v", err)
+			logging.Fatal("erro durante o desligamento gracioso", "error", err)
+		}
+		// Envia os spans que ainda estão no buffer do exportador.
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			slog.Error("falha ao enviar os últimos spans", "error", err)
 		}
 	}
 }
 
-// @Summary      Lista todos os filmes
-// @Description  Retorna uma lista com todos os filmes cadastrados no banco de dados.
+// @Summary      Lista os filmes
+// @Description  Retorna uma página de filmes, com filtros e ordenação opcionais. Quando existe uma próxima página, o cabeçalho Link traz a URL dela (rel="next"), e o cabeçalho X-Total-Count traz o total de filmes.
 // @Tags         Filmes
 // @Accept       json
 // @Produce      json
+// @Param        page_size   query     int     false  "Quantidade de filmes por página (padrão 50, máximo 1000)"
+// @Param        page_token  query     string  false  "Token da página, obtido do cabeçalho Link da resposta anterior"
+// @Param        year_from   query     int     false  "Ano mínimo (inclusivo)"
+// @Param        year_to     query     int     false  "Ano máximo (inclusivo)"
+// @Param        q           query     string  false  "Trecho do título (não diferencia maiúsculas de minúsculas)"
+// @Param        director    query     string  false  "Nome exato do diretor"
+// @Param        sort        query     string  false  "Campos de ordenação separados por vírgula; '-' indica ordem decrescente (ex: -year,title). Campos: id, title, director, year"
 // @Success      200  {array}   MovieSwagger "Lista de filmes"
-// @Failure      500  {object}  object{error=string} "Erro interno no servidor"
+// @Header       200  {string}  Link "URL da próxima página (rel=\"next\")"
+// @Header       200  {integer} X-Total-Count "Total de filmes"
+// @Failure      400  {object}  Problem "Parâmetros de paginação, filtro ou ordenação inválidos"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
 // @Router       /movies [get]
 func (h *handler) listMovies(w http.ResponseWriter, r *http.Request) {
-	log.Println("Requisição recebida: GET /movies")
+	// 1. Ler os parâmetros de paginação, filtro e ordenação da query string
+	query := r.URL.Query()
+	req, err := parseListMoviesQuery(query)
+	if err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
 
-	// 1. Chamar o serviço gRPC
+	// 2. Chamar o serviço gRPC
 	// Usamos o contexto da requisição HTTP (r.Context()), que é uma boa prática
 	// para propagar timeouts ou cancelamentos.
-	res, err := h.client.ListMovies(r.Context(), &pb.ListMoviesRequest{})
+	res, err := h.client.ListMovies(r.Context(), req)
 	if err != nil {
-		// Se a chamada gRPC falhar, retornamos um erro 500 (Internal Server Error).
-		log.Printf("Erro ao chamar ListMovies via gRPC: %v", err)
-		http.Error(w, "Erro interno ao buscar filmes", http.StatusInternalServerError)
+		// O código gRPC decide o status HTTP (ex: InvalidArgument vira 400, ver errors.go).
+		writeGrpcError(w, r, err, "ListMovies", "Erro interno ao buscar filmes")
 		return
 	}
 
-	// 2. Escrever a resposta como JSON
+	// 3. Cabeçalhos de paginação: o total e, se houver, o link para a próxima página.
+	writePaginationHeaders(w, r, res.GetTotalSize(), res.GetNextPageToken())
+
+	// 4. Escrever a resposta como JSON
 	// Definimos o cabeçalho para indicar que a resposta é do tipo JSON.
 	w.Header().Set("Content-Type", "application/json")
 
-	// Codificamos a resposta (res.GetMovies()) diretamente no corpo da resposta HTTP (w).
+	// Codificamos a página (res.GetMovies()) diretamente no corpo da resposta HTTP (w).
 	// As structs geradas pelo .proto já vêm com as tags `json:"..."`, então a conversão é automática.
-	err = json.NewEncoder(w).Encode(res.GetMovies())
+	movies := res.GetMovies()
+	if movies == nil {
+		// Uma página vazia é serializada como [] e não como null.
+		movies = []*pb.Movie{}
+	}
+	err = json.NewEncoder(w).Encode(movies)
+	if err != nil {
+		// O status 200 já foi enviado, então só nos resta registrar o erro.
+		slog.ErrorContext(r.Context(), "falha ao codificar a resposta JSON", "error", err)
+	}
+}
+
+// writePaginationHeaders escreve o total (X-Total-Count) e, se houver uma próxima página,
+// o cabeçalho Link com rel="next" (ver writeNextPageLink).
+func writePaginationHeaders(w http.ResponseWriter, r *http.Request, total int64, nextPageToken string) {
+	w.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+	writeNextPageLink(w, r, nextPageToken)
+}
+
+// writeNextPageLink escreve o cabeçalho Link com rel="next", se houver uma próxima página.
+// O link mantém os outros parâmetros da query string e troca apenas o page_token.
+func writeNextPageLink(w http.ResponseWriter, r *http.Request, nextPageToken string) {
+	if nextPageToken == "" {
+		return
+	}
+	query := r.URL.Query()
+	query.Set("page_token", nextPageToken)
+	nextURL := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", nextURL.String()))
+}
+
+// parseListMoviesQuery traduz a query string de GET /movies para a requisição gRPC.
+// O parâmetro sort usa o formato "-year,title", que vira o order_by "year desc,title".
+func parseListMoviesQuery(query url.Values) (*pb.ListMoviesRequest, error) {
+	filter, err := parseMovieFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.ListMoviesRequest{
+		PageToken: query.Get("page_token"),
+		Filter:    filter,
+	}
+	if raw := query.Get("page_size"); raw != "" {
+		size, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return nil, &invalidParamError{name: "page_size", value: raw, reason: "deve ser um número inteiro"}
+		}
+		req.PageSize = int32(size)
+	}
+
+	// A validação dos campos de ordenação fica no serviço; aqui só traduzimos o formato.
+	if sortParam := query.Get("sort"); sortParam != "" {
+		fields := strings.Split(sortParam, ",")
+		for i, field := range fields {
+			if strings.HasPrefix(field, "-") {
+				fields[i] = strings.TrimPrefix(field, "-") + " desc"
+			}
+		}
+		req.OrderBy = strings.Join(fields, ",")
+	}
+	return req, nil
+}
+
+// parseMovieFilter lê os parâmetros de filtro (year_from, year_to, q e director),
+// usados tanto pela listagem quanto pelo streaming.
+func parseMovieFilter(query url.Values) (*pb.MovieFilter, error) {
+	filter := &pb.MovieFilter{
+		TitleContains: query.Get("q"),
+		Director:      query.Get("director"),
+	}
+
+	// Os anos são opcionais, mas precisam ser inteiros se forem enviados.
+	years := []struct {
+		name  string
+		value *int32
+	}{
+		{"year_from", &filter.YearMin},
+		{"year_to", &filter.YearMax},
+	}
+	for _, year := range years {
+		raw := query.Get(year.name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return nil, &invalidParamError{name: year.name, value: raw, reason: "deve ser um número inteiro"}
+		}
+		*year.value = int32(value)
+	}
+	return filter, nil
+}
+
+// @Summary      Exporta todos os filmes em streaming
+// @Description  Envia os filmes um por linha (NDJSON), à medida que chegam do movies-service. A memória usada não depende da quantidade de filmes, por isso é a rota indicada para exportações completas. Aceita os mesmos filtros da listagem.
+// @Tags         Filmes
+// @Produce      application/x-ndjson
+// @Param        year_from   query     int     false  "Ano mínimo (inclusivo)"
+// @Param        year_to     query     int     false  "Ano máximo (inclusivo)"
+// @Param        q           query     string  false  "Trecho do título (não diferencia maiúsculas de minúsculas)"
+// @Param        director    query     string  false  "Nome exato do diretor"
+// @Success      200  {object}  MovieSwagger "Um filme por linha"
+// @Failure      400  {object}  Problem "Filtro inválido"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /movies:stream [get]
+func (h *handler) streamMovies(w http.ResponseWriter, r *http.Request) {
+	// 1. Ler os filtros e abrir o stream gRPC
+	filter, err := parseMovieFilter(r.URL.Query())
+	if err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	stream, err := h.client.StreamMovies(r.Context(), &pb.StreamMoviesRequest{Filter: filter})
+	if err != nil {
+		writeGrpcError(w, r, err, "StreamMovies", "Erro interno ao buscar filmes")
+		return
+	}
+
+	// 2. Receber o primeiro filme antes de escrever o cabeçalho, para que erros de
+	// validação ainda possam virar um 400.
+	movie, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		writeGrpcError(w, r, err, "StreamMovies", "Erro interno ao buscar filmes")
+		return
+	}
+
+	// 3. Escrever cada filme em uma linha e enviar (flush) imediatamente ao cliente.
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	for err == nil {
+		if err := encoder.Encode(movie); err != nil {
+			// O cliente desconectou; o contexto cancelado também encerra o stream gRPC.
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		movie, err = stream.Recv()
+	}
+
+	// 4. Um erro no meio do stream não pode mais virar um status HTTP. Abortamos a resposta
+	// para que o cliente perceba que ela está incompleta, em vez de recebê-la como terminada.
+	if err != io.EOF {
+		slog.ErrorContext(r.Context(), "falha ao receber filmes via gRPC", "error", err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// @Summary      Busca filmes pelo título
+// @Description  Busca textual no título dos filmes. Os resultados vêm do mais relevante para o menos relevante, com a pontuação de cada um. A paginação funciona como na listagem (cabeçalhos Link e X-Total-Count).
+// @Tags         Filmes
+// @Accept       json
+// @Produce      json
+// @Param        q           query     string  true   "Termos da busca"
+// @Param        page_size   query     int     false  "Quantidade de resultados por página (padrão 50, máximo 1000)"
+// @Param        page_token  query     string  false  "Token da página, obtido do cabeçalho Link da resposta anterior"
+// @Success      200  {array}   MovieSearchResultSwagger "Resultados da busca"
+// @Header       200  {string}  Link "URL da próxima página (rel=\"next\")"
+// @Header       200  {integer} X-Total-Count "Total de resultados"
+// @Failure      400  {object}  Problem "Termo de busca ou paginação inválidos"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /movies/search [get]
+func (h *handler) searchMovies(w http.ResponseWriter, r *http.Request) {
+	// 1. Ler os parâmetros da query string
+	query := r.URL.Query()
+	req := &pb.SearchMoviesRequest{
+		Query:     query.Get("q"),
+		PageToken: query.Get("page_token"),
+	}
+	if raw := query.Get("page_size"); raw != "" {
+		size, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			writeBadRequest(w, r, &invalidParamError{name: "page_size", value: raw, reason: "deve ser um número inteiro"})
+			return
+		}
+		req.PageSize = int32(size)
+	}
+
+	// 2. Chamar o serviço gRPC
+	res, err := h.client.SearchMovies(r.Context(), req)
 	if err != nil {
-		log.Printf("Erro ao codificar resposta JSON: %v", err)
-		http.Error(w, "Erro interno ao preparar resposta", http.StatusInternalServerError)
+		writeGrpcError(w, r, err, "SearchMovies", "Erro interno ao buscar filmes")
+		return
+	}
+
+	// 3. Escrever os cabeçalhos de paginação e a resposta como JSON
+	writePaginationHeaders(w, r, res.GetTotalSize(), res.GetNextPageToken())
+	w.Header().Set("Content-Type", "application/json")
+	results := res.GetResults()
+	if results == nil {
+		results = []*pb.MovieSearchResult{}
+	}
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		slog.ErrorContext(r.Context(), "falha ao codificar a resposta JSON", "error", err)
 	}
 }
 
@@ -163,12 +574,10 @@ func (h *handler) listMovies(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        movie  body      CreateMovieRequestSwagger  true  "Dados do Filme para Criar"
 // @Success      201    {object}  MovieSwagger "Filme criado com sucesso"
-// @Failure      400    {object}  object{error=string} "Requisição inválida"
-// @Failure      500    {object}  object{error=string} "Erro interno no servidor"
+// @Failure      400    {object}  Problem "Requisição inválida"
+// @Failure      500    {object}  Problem "Erro interno no servidor"
 // @Router       /movies [post]
 func (h *handler) createMovie(w http.ResponseWriter, r *http.Request) {
-	log.Println("Requisição recebida: POST /movies")
-
 	// 1. Decodificar o JSON da requisição
 	// Criamos uma variável do tipo que esperamos receber do cliente.
 	var req pb.CreateMovieRequest
@@ -176,7 +585,7 @@ func (h *handler) createMovie(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		// Se houver um erro na decodificação (ex: JSON mal formatado),
 		// retornamos um erro 400 (Bad Request).
-		http.Error(w, "Corpo da requisição inválido: "+err.Error(), http.StatusBadRequest)
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
 		return
 	}
 
@@ -184,8 +593,8 @@ func (h *handler) createMovie(w http.ResponseWriter, r *http.Request) {
 	// Passamos o objeto 'req' que acabamos de preencher com os dados do JSON.
 	res, err := h.client.CreateMovie(r.Context(), &req)
 	if err != nil {
-		log.Printf("Erro ao chamar CreateMovie via gRPC: %v", err)
-		http.Error(w, "Erro interno ao criar o filme", http.StatusInternalServerError)
+		// Erros de validação (ex: título vazio) viram 400, e não 500.
+		writeGrpcError(w, r, err, "CreateMovie", "Erro interno ao criar o filme")
 		return
 	}
 
@@ -198,18 +607,16 @@ func (h *handler) createMovie(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary      Busca um filme por ID
-// @Description  Retorna os detalhes de um filme específico com base no seu ID.
+// @Description  Retorna os detalhes de um filme específico com base no seu ID, com a média e a quantidade das avaliações em "rating" (omitido se o filme não tem avaliações ou se o reviews-service não responder).
 // @Tags         Filmes
 // @Accept       json
 // @Produce      json
 // @Param        id   path      string  true  "ID do Filme"
 // @Success      200  {object}  MovieSwagger "Filme encontrado"
-// @Failure      404  {object}  object{error=string} "Filme não encontrado"
-// @Failure      500  {object}  object{error=string} "Erro interno no servidor"
+// @Failure      404  {object}  Problem "Filme não encontrado"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
 // @Router       /movies/{id} [get]
 func (h *handler) getMovie(w http.ResponseWriter, r *http.Request) {
-	log.Println("Requisição recebida: GET /movies/{id}")
-
 	// 1. Extrair o ID da URL
 	// O gorilla/mux nos permite pegar variáveis da URL, como o {id}.
 	vars := mux.Vars(r)
@@ -220,16 +627,8 @@ func (h *handler) getMovie(w http.ResponseWriter, r *http.Request) {
 	res, err := h.client.GetMovie(r.Context(), req)
 	if err != nil {
 		// 3. Traduzir o erro do gRPC para um erro HTTP
-		// Verificamos se o erro retornado pelo gRPC é do tipo 'NotFound'.
-		st, ok := status.FromError(err)
-		if ok && st.Code() == codes.NotFound {
-			// Se for, retornamos um erro HTTP 404 Not Found.
-			http.Error(w, st.Message(), http.StatusNotFound)
-		} else {
-			// Para qualquer outro erro, retornamos um 500 genérico.
-			log.Printf("Erro ao chamar GetMovie via gRPC: %v", err)
-			http.Error(w, "Erro interno ao buscar o filme", http.StatusInternalServerError)
-		}
+		// Um 'NotFound' do gRPC vira um 404; erros internos viram um 500 genérico.
+		writeGrpcError(w, r, err, "GetMovie", "Erro interno ao buscar o filme")
 		return
 	}
 
@@ -238,19 +637,139 @@ func (h *handler) getMovie(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+// @Summary      Atualiza um filme por ID
+// @Description  Substitui os dados de um filme existente, mantendo o seu ID.
+// @Tags         Filmes
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string                     true  "ID do Filme a ser atualizado"
+// @Param        movie  body      UpdateMovieRequestSwagger  true  "Novos dados do Filme"
+// @Success      200    {object}  MovieSwagger "Filme atualizado com sucesso"
+// @Failure      400    {object}  Problem "Requisição inválida"
+// @Failure      404    {object}  Problem "Filme não encontrado"
+// @Failure      500    {object}  Problem "Erro interno no servidor"
+// @Router       /movies/{id} [put]
+func (h *handler) updateMovie(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar o JSON da requisição
+	var req pb.UpdateMovieRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	// 2. O ID vem sempre da URL, nunca do corpo.
+	req.Id = mux.Vars(r)["id"]
+
+	// 3. Chamar o serviço gRPC
+	res, err := h.client.UpdateMovie(r.Context(), &req)
+	if err != nil {
+		// 4. Traduzir o erro do gRPC para um erro HTTP
+		writeGrpcError(w, r, err, "UpdateMovie", "Erro interno ao atualizar o filme")
+		return
+	}
+
+	// 5. Escrever a resposta de sucesso
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Atualiza parcialmente um filme por ID
+// @Description  Altera apenas os campos enviados no corpo, seguindo JSON Merge Patch (RFC 7396). Um campo com valor null volta ao seu valor vazio. Os campos somente leitura (id e rating) são ignorados, então o corpo pode ser o próprio filme retornado por GET /movies/{id}, com as alterações.
+// @Tags         Filmes
+// @Accept       json,application/merge-patch+json
+// @Produce      json
+// @Param        id     path      string                    true  "ID do Filme a ser atualizado"
+// @Param        movie  body      PatchMovieRequestSwagger  true  "Campos a serem alterados"
+// @Success      200    {object}  MovieSwagger "Filme atualizado com sucesso"
+// @Failure      400    {object}  Problem "Requisição inválida ou campo desconhecido"
+// @Failure      404    {object}  Problem "Filme não encontrado"
+// @Failure      500    {object}  Problem "Erro interno no servidor"
+// @Router       /movies/{id} [patch]
+func (h *handler) patchMovie(w http.ResponseWriter, r *http.Request) {
+	// 1. Ler o corpo, pois ele será decodificado duas vezes.
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	// 2. As chaves presentes no JSON formam a máscara de campos (update_mask).
+	// Chaves desconhecidas também entram na máscara para que o serviço as rejeite; as
+	// somente leitura ficam de fora (ver readOnlyFields).
+	paths, err := mergePatchPaths(body)
+	if err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	// 3. Os valores vão para um pb.Movie. Um valor null deixa o campo com o valor vazio.
+	var movie pb.Movie
+	if err := json.Unmarshal(body, &movie); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	// 4. Chamar o serviço gRPC
+	req := &pb.PatchMovieRequest{
+		Id:         mux.Vars(r)["id"],
+		Movie:      &movie,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	}
+	res, err := h.client.PatchMovie(r.Context(), req)
+	if err != nil {
+		// 5. Traduzir o erro do gRPC para um erro HTTP
+		writeGrpcError(w, r, err, "PatchMovie", "Erro interno ao atualizar o filme")
+		return
+	}
+
+	// 6. Escrever a resposta de sucesso
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// readOnlyFields são os campos do filme que aparecem nas respostas, mas não podem ser
+// alterados: o ID vem da URL e a média das avaliações vem do reviews-service. Eles são
+// ignorados no PATCH, para que um cliente possa reenviar o filme que recebeu do GET.
+var readOnlyFields = map[string]bool{"id": true, "rating": true}
+
+// mergePatchPaths monta a máscara de campos de um JSON Merge Patch. Um objeto aninhado
+// (como external_ids) é mesclado campo a campo, como manda a RFC 7396: cada chave dele vira
+// um caminho próprio ("external_ids.imdb_id"). Já um null no objeto inteiro o apaga.
+func mergePatchPaths(body []byte) ([]string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(fields))
+	for field, value := range fields {
+		if readOnlyFields[field] {
+			continue
+		}
+		var nested map[string]json.RawMessage
+		if field == "external_ids" && json.Unmarshal(value, &nested) == nil && nested != nil {
+			for subfield := range nested {
+				paths = append(paths, field+"."+subfield)
+			}
+			continue
+		}
+		paths = append(paths, field)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
 // @Summary      Deleta um filme por ID
-// @Description  Remove um filme da coleção com base no seu ID.
+// @Description  Remove um filme da coleção com base no seu ID. Com a exclusão lógica ativada no movies-service, o filme apenas deixa de aparecer nas consultas e pode ser restaurado com POST /movies/{id}:restore.
 // @Tags         Filmes
 // @Accept       json
 // @Produce      json
 // @Param        id   path      string  true  "ID do Filme a ser deletado"
 // @Success      204  "Filme deletado com sucesso (sem conteúdo de resposta)"
-// @Failure      404  {object}  object{error=string} "Filme não encontrado"
-// @Failure      500  {object}  object{error=string} "Erro interno no servidor"
+// @Failure      404  {object}  Problem "Filme não encontrado"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
 // @Router       /movies/{id} [delete]
 func (h *handler) deleteMovie(w http.ResponseWriter, r *http.Request) {
-	log.Println("Requisição recebida: DELETE /movies/{id}")
-
 	// 1. Extrair o ID da URL
 	vars := mux.Vars(r)
 	id := vars["id"]
@@ -260,14 +779,7 @@ func (h *handler) deleteMovie(w http.ResponseWriter, r *http.Request) {
 	_, err := h.client.DeleteMovie(r.Context(), req) // A resposta de sucesso é vazia, por isso usamos '_'
 	if err != nil {
 		// 3. Traduzir o erro do gRPC para um erro HTTP
-		st, ok := status.FromError(err)
-		if ok && st.Code() == codes.NotFound {
-			// Se o filme a ser deletado não for encontrado, retornamos 404
-			http.Error(w, st.Message(), http.StatusNotFound)
-		} else {
-			log.Printf("Erro ao chamar DeleteMovie via gRPC: %v", err)
-			http.Error(w, "Erro interno ao deletar o filme", http.StatusInternalServerError)
-		}
+		writeGrpcError(w, r, err, "DeleteMovie", "Erro interno ao deletar o filme")
 		return
 	}
 
@@ -276,3 +788,28 @@ func (h *handler) deleteMovie(w http.ResponseWriter, r *http.Request) {
 	// que significa "Eu fiz o que você pediu, mas não tenho nada para te mostrar em resposta".
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// @Summary      Restaura um filme excluído
+// @Description  Desfaz a exclusão lógica de um filme, que volta a aparecer nas consultas com os mesmos dados. Restaurar um filme que não está excluído apenas o retorna. Filmes já apagados pela limpeza não podem ser restaurados.
+// @Tags         Filmes
+// @Produce      json
+// @Param        id   path      string  true  "ID do Filme a ser restaurado"
+// @Success      200  {object}  MovieSwagger "Filme restaurado"
+// @Failure      404  {object}  Problem "Filme não encontrado"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /movies/{id}:restore [post]
+func (h *handler) restoreMovie(w http.ResponseWriter, r *http.Request) {
+	// 1. Extrair o ID da URL
+	id := mux.Vars(r)["id"]
+
+	// 2. Chamar o serviço gRPC
+	res, err := h.client.RestoreMovie(r.Context(), &pb.RestoreMovieRequest{Id: id})
+	if err != nil {
+		writeGrpcError(w, r, err, "RestoreMovie", "Erro interno ao restaurar o filme")
+		return
+	}
+
+	// 3. Escrever a resposta de sucesso
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
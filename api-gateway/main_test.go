@@ -0,0 +1,44 @@
+// Local: api-gateway/main_test.go
+
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestMergePatchPaths testa a máscara de campos montada a partir de um JSON Merge Patch.
+func TestMergePatchPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []string
+		wantErr bool
+	}{
+		{"corpo vazio", `{}`, []string{}, false},
+		{"campos simples, em ordem", `{"year": 1999, "title": "Matrix"}`, []string{"title", "year"}, false},
+		{"null apaga o campo", `{"synopsis": null}`, []string{"synopsis"}, false},
+		{"ignora id e rating", `{"id": "7", "rating": {"average": 5}, "title": "Matrix"}`, []string{"title"}, false},
+		{"só campos somente leitura", `{"id": "7", "rating": null}`, []string{}, false},
+		{"external_ids campo a campo", `{"external_ids": {"tmdb_id": 603, "imdb_id": null}}`,
+			[]string{"external_ids.imdb_id", "external_ids.tmdb_id"}, false},
+		{"external_ids vazio não altera nada", `{"external_ids": {}}`, []string{}, false},
+		{"external_ids null apaga o objeto", `{"external_ids": null}`, []string{"external_ids"}, false},
+		{"external_ids com outro tipo", `{"external_ids": "tt0133093"}`, []string{"external_ids"}, false},
+		{"outros objetos não são mesclados", `{"cast": [{"name": "Keanu"}], "genres": ["Ação"]}`, []string{"cast", "genres"}, false},
+		{"não é um objeto", `["title"]`, nil, true},
+		{"JSON inválido", `{"title":`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergePatchPaths([]byte(tt.body))
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Esperava erro = %v, mas recebeu %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Esperava os caminhos %v, mas recebeu %v", tt.want, got)
+			}
+		})
+	}
+}
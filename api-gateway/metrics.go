@@ -0,0 +1,40 @@
+// Local: api-gateway/metrics.go
+
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Métricas RED das requisições HTTP do gateway. O rótulo route é o modelo da rota (ver
+// route.go); requisições que não encontram rota ficam com route="unmatched".
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Quantidade de requisições HTTP respondidas, por rota, método e status.",
+	}, []string{"route", "method", "status"})
+	httpErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_request_errors_total",
+		Help: "Quantidade de requisições HTTP respondidas com um status 5xx, por rota, método e status.",
+	}, []string{"route", "method", "status"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duração das requisições HTTP, em segundos, por rota, método e status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+)
+
+// observeRequest registra nas métricas uma requisição concluída (ver withObservation).
+func observeRequest(r *http.Request, info *requestInfo, elapsed time.Duration) {
+	status := strconv.Itoa(info.status)
+	httpRequests.WithLabelValues(info.route, r.Method, status).Inc()
+	if info.status >= 500 {
+		httpErrors.WithLabelValues(info.route, r.Method, status).Inc()
+	}
+	httpDuration.WithLabelValues(info.route, r.Method, status).Observe(elapsed.Seconds())
+}
@@ -0,0 +1,80 @@
+// Local: api-gateway/observe.go
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// requestInfo reúne o que as métricas e o log de acesso registram de cada requisição: a
+// rota (ver route.go), o status e a quantidade de bytes do corpo da resposta. Ele é criado
+// por withObservation e preenchido pelas camadas de dentro, pelo contexto da requisição.
+type requestInfo struct {
+	route  string
+	status int
+	bytes  int64
+}
+
+type requestInfoKey struct{}
+
+// requestInfoFrom retorna o requestInfo guardado no contexto por withObservation, ou nil.
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(*requestInfo)
+	return info
+}
+
+// withObservation é o middleware que mede todas as requisições, inclusive as respostas 404
+// e 405 (que não passam pelos middlewares de router.Use). O ResponseWriter é envolvido uma
+// única vez, aqui; no fim da requisição, o mesmo requestInfo alimenta as métricas (ver
+// metrics.go) e o log de acesso (ver accesslog.go).
+func withObservation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		info := &requestInfo{route: unmatchedRoute, status: http.StatusOK}
+		r = r.WithContext(context.WithValue(r.Context(), requestInfoKey{}, info))
+		// O defer também registra os streams interrompidos com panic(http.ErrAbortHandler).
+		defer func() {
+			elapsed := time.Since(start)
+			observeRequest(r, info, elapsed)
+			logAccess(r, info, elapsed)
+		}()
+		next.ServeHTTP(&statusRecorder{ResponseWriter: w, info: info}, r)
+	})
+}
+
+// statusRecorder guarda no requestInfo o status HTTP e os bytes escritos pelo handler. Sem
+// WriteHeader explícito, o status é 200, como no net/http.
+type statusRecorder struct {
+	http.ResponseWriter
+	info        *requestInfo
+	wroteHeader bool
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if !s.wroteHeader {
+		s.info.status = status
+		s.wroteHeader = true
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	s.wroteHeader = true
+	n, err := s.ResponseWriter.Write(b)
+	s.info.bytes += int64(n)
+	return n, err
+}
+
+// Flush mantém o streaming de /movies:stream, que envia cada filme assim que ele chega.
+func (s *statusRecorder) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap permite que http.ResponseController chegue ao ResponseWriter original.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
@@ -0,0 +1,169 @@
+// Local: api-gateway/people.go
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// PersonSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu pb.Person aqui.
+type PersonSwagger struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// PersonRequestSwagger é uma struct apenas para documentação Swagger.
+// É o corpo da criação e da atualização (o ID vem da URL).
+type PersonRequestSwagger struct {
+	Name string `json:"name"`
+}
+
+// @Summary      Lista as pessoas
+// @Description  Retorna todas as pessoas (diretores, atores...), ordenadas por nome.
+// @Tags         Pessoas
+// @Produce      json
+// @Success      200  {array}   PersonSwagger "Lista de pessoas"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /people [get]
+func (h *handler) listPeople(w http.ResponseWriter, r *http.Request) {
+	res, err := h.people.ListPeople(r.Context(), &pb.ListPeopleRequest{})
+	if err != nil {
+		writeGrpcError(w, r, err, "ListPeople", "Erro interno ao listar as pessoas")
+		return
+	}
+
+	// A resposta é a lista em si, como em GET /movies, e nunca null.
+	people := res.GetPeople()
+	if people == nil {
+		people = []*pb.Person{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(people)
+}
+
+// @Summary      Cria uma pessoa
+// @Description  Cria uma pessoa, que pode então ser citada nos créditos dos filmes pelo ID. Duas pessoas podem ter o mesmo nome.
+// @Tags         Pessoas
+// @Accept       json
+// @Produce      json
+// @Param        person  body      PersonRequestSwagger  true  "Dados da pessoa"
+// @Success      201     {object}  PersonSwagger "Pessoa criada"
+// @Failure      400     {object}  Problem "Dados inválidos"
+// @Failure      500     {object}  Problem "Erro interno no servidor"
+// @Router       /people [post]
+func (h *handler) createPerson(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreatePersonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+
+	res, err := h.people.CreatePerson(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "CreatePerson", "Erro interno ao criar a pessoa")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Busca uma pessoa por ID
+// @Tags         Pessoas
+// @Produce      json
+// @Param        id   path      string  true  "ID da Pessoa"
+// @Success      200  {object}  PersonSwagger "Pessoa encontrada"
+// @Failure      404  {object}  Problem "Pessoa não encontrada"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /people/{id} [get]
+func (h *handler) getPerson(w http.ResponseWriter, r *http.Request) {
+	res, err := h.people.GetPerson(r.Context(), &pb.GetPersonRequest{Id: mux.Vars(r)["id"]})
+	if err != nil {
+		writeGrpcError(w, r, err, "GetPerson", "Erro interno ao buscar a pessoa")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Atualiza uma pessoa
+// @Description  Altera o nome da pessoa. Os créditos dos filmes continuam apontando para ela.
+// @Tags         Pessoas
+// @Accept       json
+// @Produce      json
+// @Param        id      path      string                true  "ID da Pessoa"
+// @Param        person  body      PersonRequestSwagger  true  "Novos dados da pessoa"
+// @Success      200     {object}  PersonSwagger "Pessoa atualizada"
+// @Failure      400     {object}  Problem "Dados inválidos"
+// @Failure      404     {object}  Problem "Pessoa não encontrada"
+// @Failure      500     {object}  Problem "Erro interno no servidor"
+// @Router       /people/{id} [put]
+func (h *handler) updatePerson(w http.ResponseWriter, r *http.Request) {
+	var req pb.UpdatePersonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+	// O ID vem sempre da URL, nunca do corpo.
+	req.Id = mux.Vars(r)["id"]
+
+	res, err := h.people.UpdatePerson(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "UpdatePerson", "Erro interno ao atualizar a pessoa")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// @Summary      Apaga uma pessoa
+// @Description  Apaga uma pessoa que não aparece nos créditos de nenhum filme. Se ainda aparecer, a resposta é 409 e os créditos precisam ser tirados antes.
+// @Tags         Pessoas
+// @Param        id   path  string  true  "ID da Pessoa"
+// @Success      204  "Pessoa apagada"
+// @Failure      404  {object}  Problem "Pessoa não encontrada"
+// @Failure      409  {object}  Problem "A pessoa ainda tem créditos"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /people/{id} [delete]
+func (h *handler) deletePerson(w http.ResponseWriter, r *http.Request) {
+	_, err := h.people.DeletePerson(r.Context(), &pb.DeletePersonRequest{Id: mux.Vars(r)["id"]})
+	if err != nil {
+		writeGrpcError(w, r, err, "DeletePerson", "Erro interno ao apagar a pessoa")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Lista a filmografia de uma pessoa
+// @Description  Retorna os filmes em que a pessoa tem algum crédito, do mais antigo para o mais novo. As funções dela em cada filme estão em "credits".
+// @Tags         Pessoas
+// @Produce      json
+// @Param        id   path      string  true  "ID da Pessoa"
+// @Success      200  {array}   MovieSwagger "Filmes da pessoa"
+// @Failure      404  {object}  Problem "Pessoa não encontrada"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /people/{id}/movies [get]
+func (h *handler) listPersonMovies(w http.ResponseWriter, r *http.Request) {
+	res, err := h.people.ListPersonMovies(r.Context(), &pb.ListPersonMoviesRequest{PersonId: mux.Vars(r)["id"]})
+	if err != nil {
+		writeGrpcError(w, r, err, "ListPersonMovies", "Erro interno ao listar os filmes da pessoa")
+		return
+	}
+
+	movies := res.GetMovies()
+	if movies == nil {
+		movies = []*pb.Movie{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(movies)
+}
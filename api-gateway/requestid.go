@@ -0,0 +1,53 @@
+// Local: api-gateway/requestid.go
+
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/alenrique/Movies-microservices/logging"
+)
+
+// requestIDHeader é o cabeçalho que identifica cada requisição. Se o cliente (ou um proxy
+// na frente do gateway) já enviar um ID, ele é mantido; senão, o gateway gera um.
+const requestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength limita o tamanho de um ID recebido, já que ele vai para logs e respostas.
+const maxRequestIDLength = 128
+
+// withRequestID é o middleware que garante que toda requisição tenha um ID, devolvido
+// no cabeçalho X-Request-ID da resposta e disponível para os handlers via requestIDFrom.
+// O ID fica no contexto do pacote logging, então aparece em todas as linhas de log da
+// requisição e segue para os microsserviços nos metadados das chamadas gRPC.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logging.WithRequestID(r.Context(), id)))
+	})
+}
+
+// validRequestID aceita IDs não vazios, de até maxRequestIDLength caracteres ASCII
+// imprimíveis, que é o que os metadados gRPC aceitam.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x20 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// requestIDFrom retorna o ID da requisição guardado no contexto por withRequestID.
+func requestIDFrom(ctx context.Context) string {
+	return logging.RequestIDFrom(ctx)
+}
@@ -0,0 +1,168 @@
+// Local: api-gateway/reviews.go
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// Review é o formato JSON de uma avaliação nas respostas da API. Diferente dos filmes, a
+// avaliação não é codificada direto do pb.Review, pois o create_time (um Timestamp) sairia
+// como {"seconds": ..., "nanos": ...} em vez de uma data legível.
+type Review struct {
+	ID        string    `json:"id" example:"01HZX3K8Q4W5N7M9P2R6T8V0YB"`
+	MovieID   string    `json:"movie_id" example:"10"`
+	UserID    string    `json:"user_id" example:"ana"`
+	Rating    int32     `json:"rating" example:"5" minimum:"1" maximum:"5"`
+	Comment   string    `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateReviewRequestSwagger é uma struct apenas para documentação Swagger.
+// O ID do filme vem da URL e o usuário vem do token de acesso, por isso não fazem parte do corpo.
+type CreateReviewRequestSwagger struct {
+	Rating  int32  `json:"rating" example:"5" minimum:"1" maximum:"5"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// toReview converte a mensagem gRPC para o formato JSON da API.
+func toReview(review *pb.Review) Review {
+	return Review{
+		ID:        review.GetId(),
+		MovieID:   review.GetMovieId(),
+		UserID:    review.GetUserId(),
+		Rating:    review.GetRating(),
+		Comment:   review.GetComment(),
+		CreatedAt: review.GetCreateTime().AsTime(),
+	}
+}
+
+// requireMovie confere no movies-service se o filme existe, já que o reviews-service não
+// conhece o catálogo. Se o filme não existir (ou a consulta falhar), a resposta de erro já
+// foi escrita e o retorno é false.
+func (h *handler) requireMovie(w http.ResponseWriter, r *http.Request, movieID string) bool {
+	if _, err := h.client.GetMovie(r.Context(), &pb.GetMovieRequest{Id: movieID}); err != nil {
+		writeGrpcError(w, r, err, "GetMovie", "Erro interno ao buscar o filme")
+		return false
+	}
+	return true
+}
+
+// @Summary      Lista as avaliações de um filme
+// @Description  Retorna uma página das avaliações do filme, da mais nova para a mais antiga. Quando existe uma próxima página, o cabeçalho Link traz a URL dela (rel="next"). A média das notas aparece em "rating" no GET /movies/{id}.
+// @Tags         Avaliações
+// @Produce      json
+// @Param        id          path      string  true   "ID do Filme"
+// @Param        page_size   query     int     false  "Quantidade de avaliações por página (padrão 20, máximo 100)"
+// @Param        page_token  query     string  false  "Token da página, obtido do cabeçalho Link da resposta anterior"
+// @Success      200  {array}   Review "Avaliações do filme"
+// @Header       200  {string}  Link "URL da próxima página (rel=\"next\")"
+// @Failure      400  {object}  Problem "Paginação inválida"
+// @Failure      404  {object}  Problem "Filme não encontrado"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Failure      503  {object}  Problem "reviews-service indisponível"
+// @Router       /movies/{id}/reviews [get]
+func (h *handler) listMovieReviews(w http.ResponseWriter, r *http.Request) {
+	movieID := mux.Vars(r)["id"]
+	query := r.URL.Query()
+	req := &pb.ListReviewsForMovieRequest{MovieId: movieID, PageToken: query.Get("page_token")}
+	if raw := query.Get("page_size"); raw != "" {
+		size, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			writeBadRequest(w, r, &invalidParamError{name: "page_size", value: raw, reason: "deve ser um número inteiro"})
+			return
+		}
+		req.PageSize = int32(size)
+	}
+	if !h.requireMovie(w, r, movieID) {
+		return
+	}
+
+	res, err := h.reviews.ListReviewsForMovie(r.Context(), req)
+	if err != nil {
+		writeGrpcError(w, r, err, "ListReviewsForMovie", "Erro interno ao listar as avaliações")
+		return
+	}
+
+	writeNextPageLink(w, r, res.GetNextPageToken())
+	reviews := make([]Review, 0, len(res.GetReviews()))
+	for _, review := range res.GetReviews() {
+		reviews = append(reviews, toReview(review))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reviews)
+}
+
+// @Summary      Avalia um filme
+// @Description  Cria a avaliação do usuário autenticado para o filme, com uma nota de 1 a 5. Cada usuário avalia um filme no máximo uma vez; uma segunda avaliação responde 409.
+// @Tags         Avaliações
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                      true  "ID do Filme"
+// @Param        review  body      CreateReviewRequestSwagger  true  "Dados da avaliação"
+// @Success      201     {object}  Review "Avaliação criada"
+// @Failure      400     {object}  Problem "Dados inválidos"
+// @Failure      401     {object}  Problem "Token de acesso ausente ou inválido"
+// @Failure      404     {object}  Problem "Filme não encontrado"
+// @Failure      409     {object}  Problem "O usuário já avaliou o filme"
+// @Failure      500     {object}  Problem "Erro interno no servidor"
+// @Failure      503     {object}  Problem "reviews-service indisponível"
+// @Router       /movies/{id}/reviews [post]
+func (h *handler) createMovieReview(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, r, fmt.Errorf("corpo da requisição inválido: %w", err))
+		return
+	}
+	// O ID do filme vem sempre da URL e o usuário do token, nunca do corpo.
+	req.MovieId = mux.Vars(r)["id"]
+	req.UserId = userIDFrom(r.Context())
+	if !h.requireMovie(w, r, req.MovieId) {
+		return
+	}
+
+	res, err := h.reviews.CreateReview(r.Context(), &req)
+	if err != nil {
+		writeGrpcError(w, r, err, "CreateReview", "Erro interno ao criar a avaliação")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toReview(res))
+}
+
+// @Summary      Apaga uma avaliação
+// @Description  Apaga uma avaliação do filme feita pelo usuário autenticado. Uma avaliação de outro filme responde 404; a de outro usuário responde 403.
+// @Tags         Avaliações
+// @Security     BearerAuth
+// @Param        id        path  string  true  "ID do Filme"
+// @Param        reviewId  path  string  true  "ID da Avaliação"
+// @Success      204  "Avaliação apagada"
+// @Failure      401  {object}  Problem "Token de acesso ausente ou inválido"
+// @Failure      403  {object}  Problem "A avaliação é de outro usuário"
+// @Failure      404  {object}  Problem "Filme ou avaliação não encontrados"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Failure      503  {object}  Problem "reviews-service indisponível"
+// @Router       /movies/{id}/reviews/{reviewId} [delete]
+func (h *handler) deleteMovieReview(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if !h.requireMovie(w, r, vars["id"]) {
+		return
+	}
+	_, err := h.reviews.DeleteReview(r.Context(), &pb.DeleteReviewRequest{Id: vars["reviewId"], MovieId: vars["id"], UserId: userIDFrom(r.Context())})
+	if err != nil {
+		writeGrpcError(w, r, err, "DeleteReview", "Erro interno ao apagar a avaliação")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
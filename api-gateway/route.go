@@ -0,0 +1,39 @@
+// Local: api-gateway/route.go
+
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// unmatchedRoute identifica as requisições que não encontram rota (respostas 404 e 405).
+const unmatchedRoute = "unmatched"
+
+// recordRoute é o middleware, registrado com router.Use, que guarda no requestInfo o modelo
+// da rota que o roteador encontrou. O modelo (ex: /movies/{id}) é usado nas métricas, no log
+// de acesso e no nome dos spans no lugar do caminho recebido, para que cada filme não vire
+// uma série ou um nome novo. Os middlewares de router.Use só rodam quando alguma rota é
+// encontrada, então as respostas 404 e 405 continuam com unmatchedRoute.
+func recordRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if info := requestInfoFrom(r.Context()); info != nil {
+			if route := mux.CurrentRoute(r); route != nil {
+				if template, err := route.GetPathTemplate(); err == nil {
+					info.route = template
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeFrom retorna o modelo da rota guardado por recordRoute.
+func routeFrom(ctx context.Context) string {
+	if info := requestInfoFrom(ctx); info != nil {
+		return info.route
+	}
+	return unmatchedRoute
+}
@@ -0,0 +1,45 @@
+// Local: api-gateway/tracing.go
+
+package main
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// untracedPaths são as rotas que não geram spans: as sondas e a coleta de métricas são
+// chamadas a cada poucos segundos e encheriam o rastreamento sem trazer informação.
+var untracedPaths = map[string]bool{"/healthz": true, "/readyz": true, "/metrics": true}
+
+// withTracing é o middleware que abre o span de servidor de cada requisição HTTP. Se o
+// cliente enviar o cabeçalho traceparent, o span continua o trace dele. As chamadas gRPC
+// feitas pelos handlers viram spans filhos deste, e o traceparent segue para os
+// microsserviços nos metadados gRPC.
+//
+// A rota ainda não é conhecida quando o span começa, então ele nasce com o nome
+// "MÉTODO unmatched" e é renomeado por nameSpan quando o roteador encontra a rota.
+func withTracing(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + routeFrom(r.Context())
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !untracedPaths[r.URL.Path]
+		}),
+	)
+}
+
+// nameSpan é o middleware, registrado com router.Use depois de recordRoute, que dá ao span
+// o nome "MÉTODO rota" (ex: GET /movies/{id}) e o atributo http.route com o mesmo modelo.
+func nameSpan(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := routeFrom(r.Context())
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(semconv.HTTPRoute(route))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,120 @@
+// Local: api-gateway/watchlist.go
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// WatchlistItem é o formato JSON de um filme da lista de interesse. Como em Review, a data
+// é convertida para ficar legível; o filme sai no mesmo formato de GET /movies/{id}.
+type WatchlistItem struct {
+	Movie   *pb.Movie `json:"movie"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+// WatchlistItemSwagger é uma struct apenas para documentação Swagger.
+// Copie os campos do seu WatchlistItem aqui.
+type WatchlistItemSwagger struct {
+	Movie   MovieSwagger `json:"movie"`
+	AddedAt time.Time    `json:"added_at"`
+}
+
+// toWatchlistItem converte a mensagem gRPC para o formato JSON da API.
+func toWatchlistItem(item *pb.WatchlistItem) WatchlistItem {
+	return WatchlistItem{Movie: item.GetMovie(), AddedAt: item.GetAddTime().AsTime()}
+}
+
+// @Summary      Lista a minha lista de interesse
+// @Description  Retorna uma página dos filmes que o usuário autenticado quer assistir, do incluído mais recentemente para o mais antigo. Quando existe uma próxima página, o cabeçalho Link traz a URL dela (rel="next"). Filmes com exclusão lógica não aparecem, então uma página pode ter menos itens que o pedido.
+// @Tags         Lista de Interesse
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page_size   query     int     false  "Quantidade de filmes por página (padrão 50, máximo 1000)"
+// @Param        page_token  query     string  false  "Token da página, obtido do cabeçalho Link da resposta anterior"
+// @Success      200  {array}   WatchlistItemSwagger "Filmes da lista"
+// @Header       200  {string}  Link "URL da próxima página (rel=\"next\")"
+// @Failure      400  {object}  Problem "Paginação inválida"
+// @Failure      401  {object}  Problem "Token de acesso ausente ou inválido"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /me/watchlist [get]
+func (h *handler) listWatchlist(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &pb.ListWatchlistRequest{UserId: userIDFrom(r.Context()), PageToken: query.Get("page_token")}
+	if raw := query.Get("page_size"); raw != "" {
+		size, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			writeBadRequest(w, r, &invalidParamError{name: "page_size", value: raw, reason: "deve ser um número inteiro"})
+			return
+		}
+		req.PageSize = int32(size)
+	}
+
+	res, err := h.watchlist.ListWatchlist(r.Context(), req)
+	if err != nil {
+		writeGrpcError(w, r, err, "ListWatchlist", "Erro interno ao listar a lista de interesse")
+		return
+	}
+
+	writeNextPageLink(w, r, res.GetNextPageToken())
+	items := make([]WatchlistItem, 0, len(res.GetItems()))
+	for _, item := range res.GetItems() {
+		items = append(items, toWatchlistItem(item))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(items)
+}
+
+// @Summary      Inclui um filme na minha lista de interesse
+// @Description  Inclui o filme na lista do usuário autenticado. A operação é idempotente: incluir de novo um filme que já está na lista não muda nada e retorna o item com a data original.
+// @Tags         Lista de Interesse
+// @Produce      json
+// @Security     BearerAuth
+// @Param        movieId  path      string  true  "ID do Filme"
+// @Success      200      {object}  WatchlistItemSwagger "Filme na lista"
+// @Failure      401      {object}  Problem "Token de acesso ausente ou inválido"
+// @Failure      404      {object}  Problem "Filme não encontrado"
+// @Failure      500      {object}  Problem "Erro interno no servidor"
+// @Router       /me/watchlist/{movieId} [put]
+func (h *handler) addToWatchlist(w http.ResponseWriter, r *http.Request) {
+	res, err := h.watchlist.AddToWatchlist(r.Context(), &pb.AddToWatchlistRequest{
+		UserId:  userIDFrom(r.Context()),
+		MovieId: mux.Vars(r)["movieId"],
+	})
+	if err != nil {
+		writeGrpcError(w, r, err, "AddToWatchlist", "Erro interno ao incluir o filme na lista de interesse")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(toWatchlistItem(res))
+}
+
+// @Summary      Tira um filme da minha lista de interesse
+// @Description  Tira o filme da lista do usuário autenticado. Um filme que não está na lista responde 404.
+// @Tags         Lista de Interesse
+// @Security     BearerAuth
+// @Param        movieId  path  string  true  "ID do Filme"
+// @Success      204  "Filme retirado da lista"
+// @Failure      401  {object}  Problem "Token de acesso ausente ou inválido"
+// @Failure      404  {object}  Problem "O filme não está na lista"
+// @Failure      500  {object}  Problem "Erro interno no servidor"
+// @Router       /me/watchlist/{movieId} [delete]
+func (h *handler) removeFromWatchlist(w http.ResponseWriter, r *http.Request) {
+	_, err := h.watchlist.RemoveFromWatchlist(r.Context(), &pb.RemoveFromWatchlistRequest{
+		UserId:  userIDFrom(r.Context()),
+		MovieId: mux.Vars(r)["movieId"],
+	})
+	if err != nil {
+		writeGrpcError(w, r, err, "RemoveFromWatchlist", "Erro interno ao tirar o filme da lista de interesse")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
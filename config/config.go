@@ -0,0 +1,245 @@
+// Local: config/config.go
+
+// Package config carrega a configuração dos serviços. Cada serviço tem a sua struct
+// (ver services.go), e cada campo dela pode vir, em ordem crescente de prioridade:
+//
+//  1. do valor padrão, definido pela função Default... do serviço;
+//  2. de um arquivo YAML, indicado pela flag --config ou pela variável CONFIG_FILE;
+//  3. de uma variável de ambiente;
+//  4. de uma flag da linha de comando.
+//
+// Os nomes da variável e da flag de cada campo ficam nas tags `env` e `flag` da struct,
+// e a chave do YAML na tag `yaml`. Com --print-config, o serviço imprime a configuração
+// final (com os segredos mascarados) e termina sem subir.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Validator é implementado pelas structs de configuração dos serviços. Validate é chamado
+// depois que todas as fontes foram lidas e deve informar todos os problemas de uma vez.
+type Validator interface {
+	Validate() error
+}
+
+// Source são as entradas da configuração. Elas são separadas de os.Args e os.LookupEnv
+// para que os testes não dependam do processo.
+type Source struct {
+	Args      []string                    // Argumentos da linha de comando, sem o nome do programa.
+	LookupEnv func(string) (string, bool) // Consulta uma variável de ambiente.
+	Output    io.Writer                   // Onde a ajuda (--help) e os erros das flags são escritos.
+}
+
+// configFileEnv é a variável de ambiente com o caminho do arquivo YAML, usada quando a
+// flag --config não é informada.
+const configFileEnv = "CONFIG_FILE"
+
+// secretMask substitui os segredos preenchidos na saída de --print-config.
+const secretMask = "******"
+
+// field é um campo configurável (uma "folha" da struct), com os nomes das suas fontes.
+type field struct {
+	value  reflect.Value
+	env    string
+	flag   string
+	help   string
+	secret bool
+}
+
+// MustLoad é o caminho usado pelos main.go: carrega cfg a partir de os.Args e do ambiente.
+// Com --help ou --print-config, escreve a saída pedida e termina o processo com sucesso;
+// uma configuração inválida termina o processo com a lista dos problemas.
+func MustLoad(program string, cfg Validator) {
+	printConfig, err := Load(program, cfg, Source{Args: os.Args[1:], LookupEnv: os.LookupEnv, Output: os.Stderr})
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: configuração inválida:\n%v\n", program, err)
+		os.Exit(2)
+	}
+	if printConfig {
+		if err := Print(os.Stdout, cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: falha ao imprimir a configuração: %v\n", program, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+}
+
+// Load preenche cfg, que já deve vir com os valores padrão, a partir do arquivo YAML, das
+// variáveis de ambiente e das flags, nessa ordem, e então valida o resultado. O retorno
+// printConfig indica que a flag --print-config foi usada.
+func Load(program string, cfg Validator, src Source) (printConfig bool, err error) {
+	fields := collectFields(reflect.ValueOf(cfg).Elem())
+
+	// As flags são lidas primeiro (o arquivo pode vir de --config), mas só são aplicadas no
+	// final, para que tenham a maior prioridade.
+	fs := flag.NewFlagSet(program, flag.ContinueOnError)
+	fs.SetOutput(src.Output)
+	configFile := fs.String("config", "", "arquivo de configuração YAML (env "+configFileEnv+")")
+	fs.BoolVar(&printConfig, "print-config", false, "imprime a configuração final e termina, sem subir o serviço")
+	fromFlags := make(map[string]string)
+	for _, f := range fields {
+		name := f.flag
+		usage := fmt.Sprintf("%s (env %s, padrão %q)", f.help, f.env, formatValue(f))
+		record := func(value string) error {
+			fromFlags[name] = value
+			return nil
+		}
+		// Uma flag booleana sem valor (ex: --soft-delete) vale true.
+		if f.value.Kind() == reflect.Bool {
+			fs.BoolFunc(name, usage, record)
+		} else {
+			fs.Func(name, usage, record)
+		}
+	}
+	if err := fs.Parse(src.Args); err != nil {
+		return false, err
+	}
+	if fs.NArg() > 0 {
+		return false, fmt.Errorf("argumento inesperado: %q", fs.Arg(0))
+	}
+
+	if *configFile == "" {
+		*configFile, _ = src.LookupEnv(configFileEnv)
+	}
+	if *configFile != "" {
+		if err := loadFile(*configFile, cfg); err != nil {
+			return false, err
+		}
+	}
+
+	var errs []error
+	for _, f := range fields {
+		if value, ok := src.LookupEnv(f.env); ok {
+			if err := setValue(f.value, value); err != nil {
+				errs = append(errs, fmt.Errorf("variável de ambiente %s: %w", f.env, err))
+			}
+		}
+	}
+	for _, f := range fields {
+		if value, ok := fromFlags[f.flag]; ok {
+			if err := setValue(f.value, value); err != nil {
+				errs = append(errs, fmt.Errorf("flag --%s: %w", f.flag, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return false, errors.Join(errs...)
+	}
+	return printConfig, cfg.Validate()
+}
+
+// Print escreve a configuração em YAML, no mesmo formato aceito por --config. Os campos
+// marcados com a tag `secret:"true"` aparecem mascarados.
+func Print(w io.Writer, cfg Validator) error {
+	masked := reflect.New(reflect.TypeOf(cfg).Elem())
+	masked.Elem().Set(reflect.ValueOf(cfg).Elem())
+	for _, f := range collectFields(masked.Elem()) {
+		if f.secret && f.value.String() != "" {
+			f.value.SetString(secretMask)
+		}
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(masked.Interface()); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// loadFile lê o arquivo YAML por cima dos valores atuais. Chaves desconhecidas são um erro,
+// para que um nome digitado errado não seja ignorado em silêncio.
+func loadFile(path string, cfg Validator) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("falha ao ler o arquivo de configuração: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && err != io.EOF {
+		return fmt.Errorf("arquivo de configuração %s: %w", path, err)
+	}
+	return nil
+}
+
+// collectFields percorre a struct (e as structs dentro dela) e retorna os campos com a tag `env`.
+func collectFields(v reflect.Value) []field {
+	var fields []field
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		value := v.Field(i)
+		if value.Kind() == reflect.Struct {
+			fields = append(fields, collectFields(value)...)
+			continue
+		}
+		env, ok := structField.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		fields = append(fields, field{
+			value:  value,
+			env:    env,
+			flag:   structField.Tag.Get("flag"),
+			help:   structField.Tag.Get("help"),
+			secret: structField.Tag.Get("secret") == "true",
+		})
+	}
+	return fields
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setValue converte o texto recebido de uma variável de ambiente ou de uma flag para o tipo do campo.
+func setValue(v reflect.Value, raw string) error {
+	if v.Type() == durationType {
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("valor inválido %q (use uma duração, ex: 30s, 720h)", raw)
+		}
+		v.SetInt(int64(duration))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("valor inválido %q (use true ou false)", raw)
+		}
+		v.SetBool(enabled)
+	case reflect.Int:
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("valor inválido %q (use um número inteiro)", raw)
+		}
+		v.SetInt(int64(number))
+	default:
+		return fmt.Errorf("tipo de campo não suportado: %s", v.Type())
+	}
+	return nil
+}
+
+// formatValue mostra o valor padrão de um campo na ajuda das flags.
+func formatValue(f field) string {
+	if f.secret {
+		return ""
+	}
+	if f.value.Type() == durationType {
+		return time.Duration(f.value.Int()).String()
+	}
+	return fmt.Sprint(f.value.Interface())
+}
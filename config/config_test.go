@@ -0,0 +1,151 @@
+// Local: config/config_test.go
+
+package config_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/config"
+)
+
+// env cria uma função de consulta a variáveis de ambiente a partir de um mapa.
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := vars[name]
+		return value, ok
+	}
+}
+
+// writeFile grava um arquivo de configuração temporário e retorna o caminho dele.
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Falha ao gravar o arquivo de configuração: %v", err)
+	}
+	return path
+}
+
+// TestLoad_Precedence testa a ordem das fontes: padrão < arquivo < ambiente < flags.
+func TestLoad_Precedence(t *testing.T) {
+	// Arrange: o arquivo muda três campos; o ambiente sobrescreve dois deles, e a flag um.
+	path := writeFile(t, `
+grpc_addr: ":6000"
+max_batch_size: 100
+database:
+  mongo:
+    database: arquivo
+`)
+	cfg := config.DefaultMovies()
+	src := config.Source{
+		Args:      []string{"--config", path, "--grpc-addr", ":7000", "--soft-delete"},
+		LookupEnv: env(map[string]string{"GRPC_ADDR": ":6500", "MONGO_DATABASE": "ambiente", "SOFT_DELETE_RETENTION": "1h"}),
+		Output:    io.Discard,
+	}
+
+	// Act
+	printConfig, err := config.Load("movies-service", cfg, src)
+
+	// Assert
+	if err != nil || printConfig {
+		t.Fatalf("Esperava carregar a configuração sem erro, mas obteve (%v, %v)", printConfig, err)
+	}
+	if cfg.GRPCAddr != ":7000" {
+		t.Errorf("Esperava o endereço da flag, mas obteve %q", cfg.GRPCAddr)
+	}
+	if cfg.Database.Mongo.Database != "ambiente" || cfg.MaxBatchSize != 100 {
+		t.Errorf("Esperava o banco do ambiente e o lote do arquivo, mas obteve %+v", cfg)
+	}
+	if !cfg.SoftDelete || cfg.SoftDeleteRetention != time.Hour {
+		t.Errorf("Esperava a exclusão lógica ativada com retenção de 1h, mas obteve %+v", cfg)
+	}
+	if cfg.Database.Mongo.URI != "mongodb://mongodb:27017" || cfg.ShutdownTimeout != 5*time.Second {
+		t.Errorf("Esperava os valores padrão nos campos não informados, mas obteve %+v", cfg)
+	}
+}
+
+// TestLoad_Errors testa se os problemas de cada fonte são informados com o nome do campo.
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		env   map[string]string
+		file  string
+		wants []string
+	}{
+		{
+			name:  "valores que não podem ser convertidos",
+			env:   map[string]string{"MAX_BATCH_SIZE": "muitos", "SOFT_DELETE": "talvez"},
+			args:  []string{"--shutdown-timeout", "5"},
+			wants: []string{"MAX_BATCH_SIZE", "SOFT_DELETE", "--shutdown-timeout"},
+		},
+		{
+			name:  "validação informa todos os campos inválidos",
+			env:   map[string]string{"DB_DRIVER": "postgres", "GRPC_ADDR": "50051", "MAX_BATCH_SIZE": "0"},
+			wants: []string{"database.driver", "grpc_addr", "max_batch_size"},
+		},
+		{
+			name:  "chave desconhecida no arquivo",
+			file:  "grpc_adress: \":6000\"\n",
+			wants: []string{"grpc_adress"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			if tt.file != "" {
+				args = append(args, "--config", writeFile(t, tt.file))
+			}
+			_, err := config.Load("movies-service", config.DefaultMovies(), config.Source{Args: args, LookupEnv: env(tt.env), Output: io.Discard})
+			if err == nil {
+				t.Fatal("Esperava um erro, mas a configuração foi aceita")
+			}
+			for _, want := range tt.wants {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Esperava que o erro citasse %q, mas obteve: %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+// TestPrint_MasksSecrets testa se --print-config esconde os segredos e se a saída pode ser
+// usada de volta como arquivo de configuração.
+func TestPrint_MasksSecrets(t *testing.T) {
+	cfg := config.DefaultGateway()
+	src := config.Source{
+		Args:      []string{"--print-config", "--http-addr", ":9090"},
+		LookupEnv: env(map[string]string{"AUTH_JWT_SECRET": "segredo"}),
+		Output:    io.Discard,
+	}
+	printConfig, err := config.Load("api-gateway", cfg, src)
+	if err != nil || !printConfig {
+		t.Fatalf("Esperava o pedido de --print-config, mas obteve (%v, %v)", printConfig, err)
+	}
+
+	var out bytes.Buffer
+	if err := config.Print(&out, cfg); err != nil {
+		t.Fatalf("Erro inesperado ao imprimir: %v", err)
+	}
+	if strings.Contains(out.String(), "segredo") || !strings.Contains(out.String(), "auth_jwt_secret: '******'") {
+		t.Errorf("Esperava o segredo mascarado, mas obteve:\n%s", out.String())
+	}
+	if cfg.AuthJWTSecret != "segredo" {
+		t.Errorf("Imprimir não deveria alterar a configuração, mas o segredo virou %q", cfg.AuthJWTSecret)
+	}
+
+	reloaded := config.DefaultGateway()
+	path := writeFile(t, out.String())
+	if _, err := config.Load("api-gateway", reloaded, config.Source{Args: []string{"--config", path}, LookupEnv: env(nil), Output: io.Discard}); err != nil {
+		t.Fatalf("Esperava que a saída fosse um arquivo de configuração válido, mas obteve %v", err)
+	}
+	if reloaded.HTTPAddr != ":9090" || reloaded.ShutdownTimeout != 5*time.Second {
+		t.Errorf("Esperava os valores impressos de volta, mas obteve %+v", reloaded)
+	}
+}
@@ -0,0 +1,248 @@
+// Local: config/services.go
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Mongo é a conexão com o MongoDB. Cada serviço usa o seu próprio banco.
+type Mongo struct {
+	URI      string `yaml:"uri" env:"MONGO_URI" flag:"mongo-uri" help:"endereço de conexão do MongoDB"`
+	Database string `yaml:"database" env:"MONGO_DATABASE" flag:"mongo-database" help:"nome do banco no MongoDB"`
+}
+
+// Tracing configura o rastreamento distribuído (OpenTelemetry) de um serviço. O exportador
+// "stdout" escreve os spans em JSON (no terminal ou em um arquivo) e não precisa de coletor.
+type Tracing struct {
+	Exporter     string `yaml:"exporter" env:"TRACING_EXPORTER" flag:"tracing-exporter" help:"destino dos spans: none, stdout ou otlp"`
+	File         string `yaml:"file" env:"TRACING_FILE" flag:"tracing-file" help:"arquivo dos spans do exportador stdout (vazio: a saída padrão)"`
+	OTLPEndpoint string `yaml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT" flag:"tracing-otlp-endpoint" help:"endereço gRPC do coletor OTLP do exportador otlp"`
+}
+
+// defaultTracing deixa o rastreamento desligado; o coletor padrão é o da porta OTLP/gRPC 4317.
+func defaultTracing() Tracing {
+	return Tracing{Exporter: "none", OTLPEndpoint: "localhost:4317"}
+}
+
+// Logging configura os logs estruturados (log/slog) de um serviço.
+type Logging struct {
+	Level  string `yaml:"level" env:"LOG_LEVEL" flag:"log-level" help:"nível mínimo dos logs: debug, info, warn ou error"`
+	Format string `yaml:"format" env:"LOG_FORMAT" flag:"log-format" help:"formato dos logs: json (uma linha por registro) ou text"`
+}
+
+// defaultLogging escreve em JSON a partir do nível info.
+func defaultLogging() Logging {
+	return Logging{Level: "info", Format: "json"}
+}
+
+// MoviesDatabase escolhe e configura o adaptador de banco do movies-service.
+type MoviesDatabase struct {
+	Driver     string `yaml:"driver" env:"DB_DRIVER" flag:"db-driver" help:"adaptador de banco: mongo, memory ou sqlite"`
+	Mongo      Mongo  `yaml:"mongo"`
+	SQLitePath string `yaml:"sqlite_path" env:"SQLITE_PATH" flag:"sqlite-path" help:"arquivo do banco quando o driver é sqlite"`
+}
+
+// Movies é a configuração do movies-service.
+type Movies struct {
+	GRPCAddr            string         `yaml:"grpc_addr" env:"GRPC_ADDR" flag:"grpc-addr" help:"endereço em que o servidor gRPC escuta"`
+	Database            MoviesDatabase `yaml:"database"`
+	IDStrategy          string         `yaml:"id_strategy" env:"ID_STRATEGY" flag:"id-strategy" help:"estratégia de IDs dos filmes: sequence, uuidv7 ou ulid"`
+	SoftDelete          bool           `yaml:"soft_delete" env:"SOFT_DELETE" flag:"soft-delete" help:"ativa a exclusão lógica dos filmes"`
+	SoftDeleteRetention time.Duration  `yaml:"soft_delete_retention" env:"SOFT_DELETE_RETENTION" flag:"soft-delete-retention" help:"tempo mínimo que um filme excluído é mantido antes da limpeza"`
+	MaxBatchSize        int            `yaml:"max_batch_size" env:"MAX_BATCH_SIZE" flag:"max-batch-size" help:"quantidade máxima de itens das operações em lote"`
+	ReviewsAddr         string         `yaml:"reviews_addr" env:"REVIEWS_SERVICE_ADDR" flag:"reviews-addr" help:"endereço do reviews-service (vazio: filmes sem a média das avaliações)"`
+	ShutdownTimeout     time.Duration  `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" help:"prazo para terminar as requisições em andamento no desligamento"`
+	HealthInterval      time.Duration  `yaml:"health_check_interval" env:"HEALTH_CHECK_INTERVAL" flag:"health-check-interval" help:"intervalo do ping no banco que define o estado do grpc.health.v1"`
+	AdminAddr           string         `yaml:"admin_addr" env:"ADMIN_ADDR" flag:"admin-addr" help:"endereço do servidor HTTP de administração (métricas em /metrics)"`
+	Tracing             Tracing        `yaml:"tracing"`
+	Logging             Logging        `yaml:"logging"`
+}
+
+// DefaultMovies retorna a configuração padrão do movies-service, a mesma usada no
+// docker-compose.yml.
+func DefaultMovies() *Movies {
+	return &Movies{
+		GRPCAddr: ":50051",
+		Database: MoviesDatabase{
+			Driver:     "mongo",
+			Mongo:      Mongo{URI: "mongodb://mongodb:27017", Database: "moviedb"},
+			SQLitePath: "movies.db",
+		},
+		IDStrategy:          "sequence",
+		SoftDeleteRetention: 30 * 24 * time.Hour,
+		MaxBatchSize:        500,
+		ShutdownTimeout:     5 * time.Second,
+		HealthInterval:      5 * time.Second,
+		AdminAddr:           ":9090",
+		Tracing:             defaultTracing(),
+		Logging:             defaultLogging(),
+	}
+}
+
+// Validate confere a configuração do movies-service.
+func (c *Movies) Validate() error {
+	var v problems
+	v.addr("grpc_addr", c.GRPCAddr)
+	v.oneOf("database.driver", c.Database.Driver, "mongo", "memory", "sqlite")
+	switch c.Database.Driver {
+	case "mongo":
+		v.mongo("database.mongo", c.Database.Mongo)
+	case "sqlite":
+		v.required("database.sqlite_path", c.Database.SQLitePath)
+	}
+	v.oneOf("id_strategy", c.IDStrategy, "sequence", "uuidv7", "ulid")
+	v.positiveDuration("soft_delete_retention", c.SoftDeleteRetention)
+	if c.MaxBatchSize < 1 {
+		v.add("max_batch_size", "deve ser um número inteiro positivo, mas é %d", c.MaxBatchSize)
+	}
+	if c.ReviewsAddr != "" {
+		v.addr("reviews_addr", c.ReviewsAddr)
+	}
+	v.positiveDuration("shutdown_timeout", c.ShutdownTimeout)
+	v.positiveDuration("health_check_interval", c.HealthInterval)
+	v.addr("admin_addr", c.AdminAddr)
+	v.tracing("tracing", c.Tracing)
+	v.logging("logging", c.Logging)
+	return v.err()
+}
+
+// ReviewsDatabase escolhe e configura o adaptador de banco do reviews-service.
+type ReviewsDatabase struct {
+	Driver string `yaml:"driver" env:"DB_DRIVER" flag:"db-driver" help:"adaptador de banco: mongo ou memory"`
+	Mongo  Mongo  `yaml:"mongo"`
+}
+
+// Reviews é a configuração do reviews-service.
+type Reviews struct {
+	GRPCAddr        string          `yaml:"grpc_addr" env:"GRPC_ADDR" flag:"grpc-addr" help:"endereço em que o servidor gRPC escuta"`
+	Database        ReviewsDatabase `yaml:"database"`
+	ShutdownTimeout time.Duration   `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" help:"prazo para terminar as requisições em andamento no desligamento"`
+	Logging         Logging         `yaml:"logging"`
+}
+
+// DefaultReviews retorna a configuração padrão do reviews-service.
+func DefaultReviews() *Reviews {
+	return &Reviews{
+		GRPCAddr: ":50052",
+		Database: ReviewsDatabase{
+			Driver: "mongo",
+			Mongo:  Mongo{URI: "mongodb://mongodb:27017", Database: "reviewdb"},
+		},
+		ShutdownTimeout: 5 * time.Second,
+		Logging:         defaultLogging(),
+	}
+}
+
+// Validate confere a configuração do reviews-service.
+func (c *Reviews) Validate() error {
+	var v problems
+	v.addr("grpc_addr", c.GRPCAddr)
+	v.oneOf("database.driver", c.Database.Driver, "mongo", "memory")
+	if c.Database.Driver == "mongo" {
+		v.mongo("database.mongo", c.Database.Mongo)
+	}
+	v.positiveDuration("shutdown_timeout", c.ShutdownTimeout)
+	v.logging("logging", c.Logging)
+	return v.err()
+}
+
+// Gateway é a configuração do api-gateway.
+type Gateway struct {
+	HTTPAddr        string        `yaml:"http_addr" env:"HTTP_ADDR" flag:"http-addr" help:"endereço em que o servidor HTTP escuta"`
+	MoviesAddr      string        `yaml:"movies_addr" env:"MOVIES_SERVICE_ADDR" flag:"movies-addr" help:"endereço gRPC do movies-service"`
+	ReviewsAddr     string        `yaml:"reviews_addr" env:"REVIEWS_SERVICE_ADDR" flag:"reviews-addr" help:"endereço gRPC do reviews-service"`
+	AuthJWTSecret   string        `yaml:"auth_jwt_secret" env:"AUTH_JWT_SECRET" flag:"auth-jwt-secret" help:"chave dos tokens JWT (HS256) das rotas /me/..." secret:"true"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" help:"prazo para terminar as requisições em andamento no desligamento"`
+	Tracing         Tracing       `yaml:"tracing"`
+	Logging         Logging       `yaml:"logging"`
+}
+
+// DefaultGateway retorna a configuração padrão do api-gateway. Sem AuthJWTSecret, as rotas
+// autenticadas sempre respondem 401.
+func DefaultGateway() *Gateway {
+	return &Gateway{
+		HTTPAddr:        ":8080",
+		MoviesAddr:      "movies-service:50051",
+		ReviewsAddr:     "reviews-service:50052",
+		ShutdownTimeout: 5 * time.Second,
+		Tracing:         defaultTracing(),
+		Logging:         defaultLogging(),
+	}
+}
+
+// Validate confere a configuração do api-gateway.
+func (c *Gateway) Validate() error {
+	var v problems
+	v.addr("http_addr", c.HTTPAddr)
+	v.addr("movies_addr", c.MoviesAddr)
+	v.addr("reviews_addr", c.ReviewsAddr)
+	v.positiveDuration("shutdown_timeout", c.ShutdownTimeout)
+	v.tracing("tracing", c.Tracing)
+	v.logging("logging", c.Logging)
+	return v.err()
+}
+
+// problems acumula os problemas de uma configuração, para que todos sejam informados de
+// uma vez (como o acumulador de violações do movies-service). Os campos são identificados
+// pela chave do YAML.
+type problems []error
+
+func (p *problems) add(key, format string, args ...interface{}) {
+	*p = append(*p, fmt.Errorf("%s: "+format, append([]interface{}{key}, args...)...))
+}
+
+func (p *problems) err() error {
+	return errors.Join(*p...)
+}
+
+func (p *problems) required(key, value string) {
+	if value == "" {
+		p.add(key, "não pode ser vazio")
+	}
+}
+
+// addr confere um endereço no formato "host:porta" (o host pode ficar vazio, como em ":8080").
+func (p *problems) addr(key, value string) {
+	if _, _, err := net.SplitHostPort(value); err != nil {
+		p.add(key, "endereço inválido %q (use host:porta, ex: :8080)", value)
+	}
+}
+
+func (p *problems) oneOf(key, value string, allowed ...string) {
+	for _, option := range allowed {
+		if value == option {
+			return
+		}
+	}
+	p.add(key, "valor desconhecido %q (use %s)", value, strings.Join(allowed, ", "))
+}
+
+func (p *problems) positiveDuration(key string, value time.Duration) {
+	if value <= 0 {
+		p.add(key, "deve ser uma duração positiva, mas é %s", value)
+	}
+}
+
+func (p *problems) mongo(key string, mongo Mongo) {
+	if !strings.HasPrefix(mongo.URI, "mongodb://") && !strings.HasPrefix(mongo.URI, "mongodb+srv://") {
+		p.add(key+".uri", "endereço inválido %q (use mongodb://host:porta)", mongo.URI)
+	}
+	p.required(key+".database", mongo.Database)
+}
+
+func (p *problems) tracing(key string, tracing Tracing) {
+	p.oneOf(key+".exporter", tracing.Exporter, "none", "stdout", "otlp")
+	if tracing.Exporter == "otlp" {
+		p.addr(key+".otlp_endpoint", tracing.OTLPEndpoint)
+	}
+}
+
+func (p *problems) logging(key string, logging Logging) {
+	p.oneOf(key+".level", logging.Level, "debug", "info", "warn", "error")
+	p.oneOf(key+".format", logging.Format, "json", "text")
+}
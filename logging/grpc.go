@@ -0,0 +1,148 @@
+// Local: logging/grpc.go
+
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// healthCheckPrefix identifica as chamadas do grpc.health.v1, registradas só no nível debug
+// porque as sondas rodam a cada poucos segundos.
+const healthCheckPrefix = "/grpc.health.v1.Health/"
+
+// incomingContext guarda no contexto o ID da requisição recebido nos metadados. Chamadas
+// que chegam sem um ID (ex: feitas direto com um cliente gRPC) ganham um novo, para que as
+// linhas de log delas também possam ser agrupadas.
+func incomingContext(ctx context.Context) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = uuid.NewString()
+	}
+	return WithRequestID(ctx, id)
+}
+
+// outgoingContext acrescenta o ID da requisição de ctx aos metadados da chamada feita.
+func outgoingContext(ctx context.Context) context.Context {
+	id := RequestIDFrom(ctx)
+	if id == "" {
+		return ctx
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(MetadataKey)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+}
+
+// logCall escreve uma linha para cada chamada recebida. O nível segue o código da resposta:
+// error para as falhas do servidor (Internal, Unavailable...), warn para os erros causados
+// pela requisição (InvalidArgument, NotFound...) e info para as chamadas bem-sucedidas.
+func logCall(ctx context.Context, fullMethod string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch {
+	case strings.HasPrefix(fullMethod, healthCheckPrefix):
+		level = slog.LevelDebug
+	case isServerError(code):
+		level = slog.LevelError
+	case code != codes.OK:
+		level = slog.LevelWarn
+	}
+	attrs := []slog.Attr{
+		slog.String("grpc_method", fullMethod),
+		slog.String("grpc_code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+	slog.LogAttrs(ctx, level, "chamada gRPC concluída", attrs...)
+}
+
+// isServerError indica se o código representa uma falha do servidor, e não um problema na
+// requisição. São os mesmos códigos que o gateway traduz para um status HTTP 5xx.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// UnaryServerInterceptor guarda o ID da requisição no contexto das chamadas unárias
+// recebidas e registra cada uma delas.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = incomingContext(ctx)
+		start := time.Now()
+		res, err := handler(ctx, req)
+		logCall(ctx, info.FullMethod, start, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor faz o mesmo que UnaryServerInterceptor para as chamadas com stream.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := incomingContext(stream.Context())
+		start := time.Now()
+		err := handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+		logCall(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// contextServerStream troca o contexto do stream pelo que tem o ID da requisição.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientInterceptor envia o ID da requisição nos metadados das chamadas unárias feitas.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor envia o ID da requisição nos metadados das chamadas com stream feitas.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// ServerOptions retorna as opções que registram as chamadas recebidas por um servidor gRPC,
+// para usar em grpc.NewServer.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor()),
+	}
+}
+
+// DialOptions retorna as opções que repassam o ID da requisição nas chamadas feitas por um
+// cliente gRPC, para usar em grpc.NewClient.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(StreamClientInterceptor()),
+	}
+}
@@ -0,0 +1,89 @@
+// Local: logging/grpc_test.go
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// captureLogs troca o logger padrão por um que escreve em JSON no buffer retornado.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(contextHandler{slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})}))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+// TestRequestIDPropagation testa se o ID enviado pelo cliente chega ao contexto do servidor
+// e aparece nas linhas de log escritas durante a chamada.
+func TestRequestIDPropagation(t *testing.T) {
+	// Arrange: o "invoker" do cliente entrega os metadados enviados direto ao servidor.
+	buf := captureLogs(t)
+	var handlerID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerID = RequestIDFrom(ctx)
+		slog.ErrorContext(ctx, "falha no repositório")
+		return nil, status.Error(codes.Internal, "erro interno")
+	}
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		serverCtx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := UnaryServerInterceptor()(serverCtx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	// Act
+	ctx := WithRequestID(context.Background(), "req-123")
+	err := UnaryClientInterceptor()(ctx, "/movies.MovieService/GetMovie", nil, nil, nil, invoker)
+
+	// Assert
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("Esperava o erro do handler, mas obteve %v", err)
+	}
+	if handlerID != "req-123" {
+		t.Errorf("Esperava o ID req-123 no contexto do servidor, mas obteve %q", handlerID)
+	}
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("Esperava 2 linhas de log (a do handler e a da chamada), mas obteve %d: %s", len(lines), buf)
+	}
+	for _, line := range lines {
+		var record map[string]any
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Fatalf("Linha de log inválida: %s", line)
+		}
+		if record["request_id"] != "req-123" || record["level"] != "ERROR" {
+			t.Errorf("Esperava uma linha ERROR com request_id req-123, mas obteve %s", line)
+		}
+	}
+}
+
+// TestUnaryServerInterceptor_GeneratesID testa se uma chamada sem o metadado x-request-id
+// ganha um ID novo.
+func TestUnaryServerInterceptor_GeneratesID(t *testing.T) {
+	// Arrange
+	captureLogs(t)
+	var handlerID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerID = RequestIDFrom(ctx)
+		return nil, nil
+	}
+
+	// Act
+	UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/movies.MovieService/GetMovie"}, handler)
+
+	// Assert
+	if handlerID == "" {
+		t.Error("Esperava um ID gerado para a chamada sem x-request-id")
+	}
+}
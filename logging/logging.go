@@ -0,0 +1,79 @@
+// Local: logging/logging.go
+
+// Package logging configura os logs estruturados (log/slog) dos serviços. Cada registro é
+// uma linha JSON com o nível, a mensagem, o nome do serviço e, quando a linha foi escrita
+// durante uma requisição, o request_id dela.
+//
+// O request_id nasce no gateway (cabeçalho X-Request-ID) e segue para os microsserviços
+// nos metadados gRPC (ver grpc.go). Assim, basta filtrar os logs de todos os serviços por
+// esse ID para ver tudo o que aconteceu em uma requisição.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/alenrique/Movies-microservices/config"
+)
+
+// MetadataKey é a chave dos metadados gRPC que leva o ID da requisição entre os serviços.
+const MetadataKey = "x-request-id"
+
+type requestIDKey struct{}
+
+// WithRequestID retorna uma cópia de ctx com o ID da requisição, que passa a aparecer em
+// todas as linhas de log escritas com esse contexto (slog.InfoContext, slog.ErrorContext...).
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom retorna o ID da requisição guardado em ctx, ou "" se não houver um.
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Setup troca o logger padrão do slog pelo logger do serviço, no formato e a partir do
+// nível escolhidos em cfg. As mensagens do pacote log (ex: as das bibliotecas) também
+// passam a sair por ele, no nível info.
+func Setup(serviceName string, cfg config.Logging) {
+	// O nível já foi validado pelo pacote config; um valor vazio fica em info.
+	var level slog.Level
+	level.UnmarshalText([]byte(cfg.Level))
+
+	options := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	if cfg.Format == "text" {
+		handler = slog.NewTextHandler(os.Stderr, options)
+	} else {
+		handler = slog.NewJSONHandler(os.Stderr, options)
+	}
+	slog.SetDefault(slog.New(contextHandler{handler}).With("service", serviceName))
+}
+
+// Fatal registra a mensagem no nível error e encerra o processo, como o log.Fatalf.
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// contextHandler acrescenta o request_id do contexto a cada registro.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestIDFrom(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
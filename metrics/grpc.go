@@ -0,0 +1,163 @@
+// Local: metrics/grpc.go
+
+package metrics
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rpcMetrics são as métricas RED de um lado da chamada gRPC (servidor ou cliente). Os
+// rótulos são o serviço (ex: movies.MovieService), o método (ex: GetMovie) e o código de
+// status gRPC da resposta (ex: OK, NotFound).
+type rpcMetrics struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func newRPCMetrics(side string) rpcMetrics {
+	labels := []string{"grpc_service", "grpc_method", "grpc_code"}
+	return rpcMetrics{
+		requests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_" + side + "_requests_total",
+			Help: "Quantidade de chamadas gRPC concluídas, por serviço, método e código de status.",
+		}, labels),
+		errors: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_" + side + "_errors_total",
+			Help: "Quantidade de chamadas gRPC que terminaram com um erro do servidor (Internal, Unavailable, DeadlineExceeded...).",
+		}, labels),
+		duration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_" + side + "_request_duration_seconds",
+			Help:    "Duração das chamadas gRPC, em segundos. Nos streams, vai do início até a última mensagem.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+	}
+}
+
+var (
+	serverMetrics = newRPCMetrics("server")
+	clientMetrics = newRPCMetrics("client")
+)
+
+// observe registra uma chamada concluída. fullMethod vem no formato /pacote.Serviço/Método.
+func (m rpcMetrics) observe(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	code := status.Code(err)
+	m.requests.WithLabelValues(service, method, code.String()).Inc()
+	if isServerError(code) {
+		m.errors.WithLabelValues(service, method, code.String()).Inc()
+	}
+	m.duration.WithLabelValues(service, method, code.String()).Observe(time.Since(start).Seconds())
+}
+
+// splitMethod separa "/movies.MovieService/GetMovie" em "movies.MovieService" e "GetMovie".
+func splitMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// isServerError indica se o código representa uma falha do servidor, e não um problema na
+// requisição (InvalidArgument, NotFound...). São os mesmos códigos que o gateway traduz
+// para um status HTTP 5xx.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// UnaryServerInterceptor mede as chamadas unárias recebidas pelo servidor gRPC.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		serverMetrics.observe(info.FullMethod, start, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor mede as chamadas com stream recebidas pelo servidor gRPC.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		serverMetrics.observe(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// UnaryClientInterceptor mede as chamadas unárias feitas por um cliente gRPC.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		clientMetrics.observe(method, start, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor mede as chamadas com stream feitas por um cliente gRPC. A chamada
+// só termina quando o cliente recebe o fim do stream (io.EOF) ou um erro.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			clientMetrics.observe(method, start, err)
+			return nil, err
+		}
+		return &observedClientStream{ClientStream: stream, done: func(err error) { clientMetrics.observe(method, start, err) }}, nil
+	}
+}
+
+// observedClientStream registra a chamada na primeira vez que RecvMsg retorna um erro; io.EOF
+// é o fim normal do stream e conta como OK.
+type observedClientStream struct {
+	grpc.ClientStream
+	done func(error)
+	once sync.Once
+}
+
+func (s *observedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if err == io.EOF {
+				s.done(nil)
+			} else {
+				s.done(err)
+			}
+		})
+	}
+	return err
+}
+
+// ServerOptions retorna as opções que instrumentam um servidor gRPC, para usar em grpc.NewServer.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor()),
+	}
+}
+
+// DialOptions retorna as opções que instrumentam um cliente gRPC, para usar em grpc.NewClient.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(StreamClientInterceptor()),
+	}
+}
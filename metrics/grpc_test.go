@@ -0,0 +1,97 @@
+// Local: metrics/grpc_test.go
+
+package metrics
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestUnaryServerInterceptor testa se cada chamada é contada com o seu código e se só as
+// falhas do servidor entram na contagem de erros.
+func TestUnaryServerInterceptor(t *testing.T) {
+	// Arrange: o handler responde com o erro da vez.
+	var next error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", next }
+	info := &grpc.UnaryServerInfo{FullMethod: "/movies.MovieService/TestUnary"}
+	interceptor := UnaryServerInterceptor()
+
+	// Act
+	for _, err := range []error{nil, nil, status.Error(codes.NotFound, "filme não encontrado"), status.Error(codes.Unavailable, "banco fora do ar")} {
+		next = err
+		interceptor(context.Background(), nil, info, handler)
+	}
+
+	// Assert
+	counts := map[string]float64{
+		"OK":          testutil.ToFloat64(serverMetrics.requests.WithLabelValues("movies.MovieService", "TestUnary", "OK")),
+		"NotFound":    testutil.ToFloat64(serverMetrics.requests.WithLabelValues("movies.MovieService", "TestUnary", "NotFound")),
+		"Unavailable": testutil.ToFloat64(serverMetrics.requests.WithLabelValues("movies.MovieService", "TestUnary", "Unavailable")),
+	}
+	if counts["OK"] != 2 || counts["NotFound"] != 1 || counts["Unavailable"] != 1 {
+		t.Errorf("Esperava 2 OK, 1 NotFound e 1 Unavailable, mas obteve %v", counts)
+	}
+	if got := testutil.ToFloat64(serverMetrics.errors.WithLabelValues("movies.MovieService", "TestUnary", "NotFound")); got != 0 {
+		t.Errorf("NotFound não é uma falha do servidor, mas foi contado %v vez(es) como erro", got)
+	}
+	if got := testutil.ToFloat64(serverMetrics.errors.WithLabelValues("movies.MovieService", "TestUnary", "Unavailable")); got != 1 {
+		t.Errorf("Esperava 1 erro Unavailable, mas obteve %v", got)
+	}
+}
+
+// fakeClientStream entrega n mensagens e depois o erro final.
+type fakeClientStream struct {
+	grpc.ClientStream
+	n   int
+	end error
+}
+
+func (s *fakeClientStream) RecvMsg(m interface{}) error {
+	if s.n == 0 {
+		return s.end
+	}
+	s.n--
+	return nil
+}
+
+// TestStreamClientInterceptor testa se um stream é contado uma única vez, quando termina,
+// e se o io.EOF do fim normal conta como OK.
+func TestStreamClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		end  error
+		code string
+	}{
+		{name: "fim normal", end: io.EOF, code: "OK"},
+		{name: "cliente cancelou", end: status.Error(codes.Canceled, "context canceled"), code: "Canceled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := "/movies.MovieService/TestStream" + tt.code
+			streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return &fakeClientStream{n: 2, end: tt.end}, nil
+			}
+			stream, err := StreamClientInterceptor()(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, method, streamer)
+			if err != nil {
+				t.Fatalf("Erro inesperado ao abrir o stream: %v", err)
+			}
+
+			for stream.RecvMsg(nil) == nil {
+				if got := testutil.ToFloat64(clientMetrics.requests.WithLabelValues("movies.MovieService", "TestStream"+tt.code, tt.code)); got != 0 {
+					t.Fatalf("O stream ainda não terminou, mas já foi contado %v vez(es)", got)
+				}
+			}
+			stream.RecvMsg(nil) // Ler de novo depois do fim não conta outra chamada.
+
+			if got := testutil.ToFloat64(clientMetrics.requests.WithLabelValues("movies.MovieService", "TestStream"+tt.code, tt.code)); got != 1 {
+				t.Errorf("Esperava 1 chamada com o código %s, mas obteve %v", tt.code, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Local: metrics/metrics.go
+
+// Package metrics reúne as métricas Prometheus compartilhadas pelos serviços: os
+// interceptors gRPC (do lado do servidor e do cliente, ver grpc.go) e o handler de /metrics.
+//
+// As métricas seguem o método RED: quantidade de requisições (Rate), quantidade de erros
+// (Errors) e um histograma da duração (Duration). Todas ficam no registro padrão do
+// Prometheus, junto com as métricas do runtime do Go e do processo.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Handler responde em /metrics com todas as métricas do registro padrão, no formato de
+// texto lido pelo Prometheus.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
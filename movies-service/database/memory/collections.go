@@ -0,0 +1,140 @@
+// Local: movies-service/database/memory/collections.go
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// collectionRepository guarda as coleções em um mapa protegido por um RWMutex,
+// trabalhando sempre com cópias, como o repositório de filmes.
+type collectionRepository struct {
+	mu          sync.RWMutex
+	collections map[string]*service.Collection
+}
+
+// NewCollectionRepository cria um repositório de coleções em memória vazio.
+func NewCollectionRepository() service.CollectionRepository {
+	return &collectionRepository{collections: make(map[string]*service.Collection)}
+}
+
+// Save insere uma coleção nova com a versão 1.
+func (r *collectionRepository) Save(ctx context.Context, collection *service.Collection) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.collections[collection.ID]; exists {
+		return fmt.Errorf("%w: já existe uma coleção com o ID '%s'", service.ErrConflict, collection.ID)
+	}
+	stored := cloneCollection(collection)
+	stored.Version = 1
+	r.collections[collection.ID] = stored
+	return nil
+}
+
+// FindByID retorna uma cópia da coleção, ou (nil, nil) se ela não existir.
+func (r *collectionRepository) FindByID(ctx context.Context, id string) (*service.Collection, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	collection, ok := r.collections[id]
+	if !ok {
+		return nil, nil
+	}
+	return cloneCollection(collection), nil
+}
+
+// FindAll retorna cópias de todas as coleções, ordenadas por ID.
+func (r *collectionRepository) FindAll(ctx context.Context) ([]*service.Collection, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	collections := make([]*service.Collection, 0, len(r.collections))
+	for _, collection := range r.collections {
+		collections = append(collections, cloneCollection(collection))
+	}
+	sort.Slice(collections, func(i, j int) bool { return collections[i].ID < collections[j].ID })
+	return collections, nil
+}
+
+// Update substitui a coleção se a versão guardada for a mesma que quem chama leu.
+func (r *collectionRepository) Update(ctx context.Context, collection *service.Collection) (*service.Collection, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.collections[collection.ID]
+	if !ok {
+		return nil, nil
+	}
+	if current.Version != collection.Version {
+		return nil, fmt.Errorf("%w: a coleção '%s' foi alterada por outra requisição", service.ErrConflict, collection.ID)
+	}
+	stored := cloneCollection(collection)
+	stored.Version++
+	r.collections[collection.ID] = stored
+	return cloneCollection(stored), nil
+}
+
+// DeleteByID remove uma coleção. Retorna false se ela não existir.
+func (r *collectionRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.collections[id]
+	delete(r.collections, id)
+	return ok, nil
+}
+
+// RemoveMovies tira os filmes de todas as coleções. As coleções alteradas mudam de versão.
+func (r *collectionRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removed := make(map[string]bool, len(movieIDs))
+	for _, id := range movieIDs {
+		removed[id] = true
+	}
+	for _, collection := range r.collections {
+		kept := make([]string, 0, len(collection.MovieIDs))
+		for _, id := range collection.MovieIDs {
+			if !removed[id] {
+				kept = append(kept, id)
+			}
+		}
+		if len(kept) != len(collection.MovieIDs) {
+			collection.MovieIDs = kept
+			collection.Version++
+		}
+	}
+	return nil
+}
+
+// cloneCollection devolve uma cópia da coleção, inclusive da lista de filmes.
+func cloneCollection(collection *service.Collection) *service.Collection {
+	copied := *collection
+	copied.MovieIDs = append([]string{}, collection.MovieIDs...)
+	return &copied
+}
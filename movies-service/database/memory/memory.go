@@ -0,0 +1,532 @@
+// Local: movies-service/database/memory/memory.go
+
+// Package memory é um adaptador de saída que guarda os filmes em memória.
+// Ele implementa service.MovieRepository sem nenhuma dependência externa, então serve
+// para rodar o movies-service localmente sem MongoDB e como repositório dos testes.
+// Os dados são perdidos quando o processo termina.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// movieRepository guarda os filmes em um mapa protegido por um RWMutex.
+// Todas as leituras e escritas trabalham com cópias, para que quem chama nunca
+// altere o estado interno sem passar pelo repositório (como aconteceria com um banco real).
+// Os filmes com exclusão lógica ficam em um mapa separado, então as consultas nem os veem.
+type movieRepository struct {
+	mu      sync.RWMutex
+	movies  map[string]*service.Movie
+	deleted map[string]deletedMovie
+}
+
+// deletedMovie é um filme com exclusão lógica e a data em que foi excluído.
+type deletedMovie struct {
+	movie     *service.Movie
+	deletedAt time.Time
+}
+
+// NewMovieRepository cria um repositório em memória vazio.
+func NewMovieRepository() service.MovieRepository {
+	return &movieRepository{
+		movies:  make(map[string]*service.Movie),
+		deleted: make(map[string]deletedMovie),
+	}
+}
+
+// Save insere um filme novo. Assim como o índice único do MongoDB, um ID repetido é rejeitado.
+func (r *movieRepository) Save(ctx context.Context, movie *service.Movie) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.insert(movie)
+}
+
+// SaveMany insere vários filmes. Um ID repetido rejeita apenas o filme em que aparece.
+func (r *movieRepository) SaveMany(ctx context.Context, movies []*service.Movie) ([]error, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	errs := make([]error, len(movies))
+	for i, movie := range movies {
+		errs[i] = r.insert(movie)
+	}
+	return errs, nil
+}
+
+// insert guarda uma cópia do filme. Quem chama precisa ter o lock de escrita.
+func (r *movieRepository) insert(movie *service.Movie) error {
+	_, exists := r.movies[movie.ID]
+	_, deleted := r.deleted[movie.ID]
+	if exists || deleted {
+		return fmt.Errorf("%w: já existe um filme com o ID '%s'", service.ErrConflict, movie.ID)
+	}
+	r.movies[movie.ID] = clone(movie)
+	return nil
+}
+
+// FindByID retorna uma cópia do filme, ou (nil, nil) se ele não existir.
+func (r *movieRepository) FindByID(ctx context.Context, id string) (*service.Movie, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	movie, ok := r.movies[id]
+	if !ok {
+		return nil, nil
+	}
+	return clone(movie), nil
+}
+
+// FindByIDs retorna cópias dos filmes encontrados, ordenadas por ID.
+func (r *movieRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Movie, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	movies := make([]*service.Movie, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if movie, ok := r.movies[id]; ok && !seen[id] {
+			seen[id] = true
+			movies = append(movies, clone(movie))
+		}
+	}
+	sort.Slice(movies, func(i, j int) bool { return movies[i].ID < movies[j].ID })
+	return movies, nil
+}
+
+// FindAll retorna todos os filmes, ordenados por ID.
+func (r *movieRepository) FindAll(ctx context.Context) ([]*service.Movie, error) {
+	return r.FindPage(ctx, service.MovieQuery{
+		OrderBy: []service.SortField{{Field: "id"}},
+		Limit:   -1,
+	})
+}
+
+// FindPage aplica filtro, ordenação e cursor da mesma forma que o adaptador do MongoDB.
+// Um Limit negativo devolve todos os filmes.
+func (r *movieRepository) FindPage(ctx context.Context, query service.MovieQuery) ([]*service.Movie, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	movies := make([]*service.Movie, 0, len(r.movies))
+	for _, movie := range r.candidates(query.Filter) {
+		if !query.Filter.Matches(movie) {
+			continue
+		}
+		if query.After != nil && service.CompareMovies(movie, query.After, query.OrderBy) <= 0 {
+			continue
+		}
+		movies = append(movies, clone(movie))
+	}
+	sort.Slice(movies, func(i, j int) bool {
+		return service.CompareMovies(movies[i], movies[j], query.OrderBy) < 0
+	})
+	if query.Limit >= 0 && len(movies) > query.Limit {
+		movies = movies[:query.Limit]
+	}
+	return movies, nil
+}
+
+// Count conta os filmes que satisfazem o filtro.
+func (r *movieRepository) Count(ctx context.Context, filter service.MovieFilter) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, movie := range r.candidates(filter) {
+		if filter.Matches(movie) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// candidates retorna os filmes que o filtro pode ver: os não excluídos e, com
+// IncludeDeleted, também os da lixeira. Precisa ser chamado com o lock de leitura.
+func (r *movieRepository) candidates(filter service.MovieFilter) []*service.Movie {
+	movies := make([]*service.Movie, 0, len(r.movies))
+	for _, movie := range r.movies {
+		movies = append(movies, movie)
+	}
+	if filter.IncludeDeleted {
+		for _, entry := range r.deleted {
+			movies = append(movies, entry.movie)
+		}
+	}
+	return movies
+}
+
+// Stream entrega os filmes filtrados, em ordem de ID. A lista é copiada antes de chamar fn,
+// para que fn possa usar o repositório sem causar um deadlock.
+func (r *movieRepository) Stream(ctx context.Context, filter service.MovieFilter, fn func(*service.Movie) error) error {
+	movies, err := r.FindPage(ctx, service.MovieQuery{
+		Filter:  filter,
+		OrderBy: []service.SortField{{Field: "id"}},
+		Limit:   -1,
+	})
+	if err != nil {
+		return err
+	}
+	for _, movie := range movies {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(movie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Search é uma aproximação da busca textual do MongoDB: o título e a busca são quebrados
+// em palavras (sem diferenciar maiúsculas de minúsculas) e a pontuação é a quantidade de
+// palavras da busca encontradas no título. Não há stemming nem stop words.
+func (r *movieRepository) Search(ctx context.Context, query service.SearchQuery) ([]*service.SearchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	terms := words(query.Text)
+	results := make([]*service.SearchResult, 0)
+	for _, movie := range r.movies {
+		if score := textScore(movie.Title, terms); score > 0 {
+			results = append(results, &service.SearchResult{Movie: clone(movie), Score: score})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Movie.ID < results[j].Movie.ID
+	})
+
+	if query.Offset >= len(results) {
+		return []*service.SearchResult{}, nil
+	}
+	results = results[query.Offset:]
+	if len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
+
+// CountSearch conta os filmes encontrados pela busca textual.
+func (r *movieRepository) CountSearch(ctx context.Context, text string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	terms := words(text)
+	var count int64
+	for _, movie := range r.movies {
+		if textScore(movie.Title, terms) > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Update substitui um filme existente. Retorna (nil, nil) se ele não existir.
+func (r *movieRepository) Update(ctx context.Context, movie *service.Movie) (*service.Movie, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.movies[movie.ID]; !ok {
+		return nil, nil
+	}
+	r.movies[movie.ID] = clone(movie)
+	return clone(movie), nil
+}
+
+// Patch copia para o filme guardado apenas os campos de paths (ver service.ApplyPatch).
+func (r *movieRepository) Patch(ctx context.Context, id string, patch *service.Movie, paths []string) (*service.Movie, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	movie, ok := r.movies[id]
+	if !ok {
+		return nil, nil
+	}
+	patched := clone(movie)
+	service.ApplyPatch(patched, clone(patch), paths)
+	r.movies[id] = patched
+	return clone(patched), nil
+}
+
+// SetCredits troca os créditos do filme se eles ainda forem iguais a expected.
+func (r *movieRepository) SetCredits(ctx context.Context, id string, expected, credits []service.Credit) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	movie, ok := r.movies[id]
+	if !ok || !sameCredits(movie.Credits, expected) {
+		return false, nil
+	}
+	movie.Credits = append([]service.Credit(nil), credits...)
+	return true, nil
+}
+
+// sameCredits compara duas listas de créditos, item a item e na mesma ordem.
+func sameCredits(a, b []service.Credit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeleteByID remove um filme. Retorna false se ele não existir.
+func (r *movieRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.movies[id]; !ok {
+		return false, nil
+	}
+	delete(r.movies, id)
+	return true, nil
+}
+
+// SoftDeleteByID move o filme para o mapa dos excluídos. Retorna false se ele não existir.
+func (r *movieRepository) SoftDeleteByID(ctx context.Context, id string, deletedAt time.Time) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	movie, ok := r.movies[id]
+	if !ok {
+		return false, nil
+	}
+	delete(r.movies, id)
+	r.deleted[id] = deletedMovie{movie: movie, deletedAt: deletedAt}
+	return true, nil
+}
+
+// DeleteByIDs remove vários filmes e retorna os IDs que existiam.
+func (r *movieRepository) DeleteByIDs(ctx context.Context, ids []string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := r.movies[id]; ok {
+			delete(r.movies, id)
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
+
+// SoftDeleteByIDs move vários filmes para o mapa dos excluídos e retorna os IDs que existiam.
+func (r *movieRepository) SoftDeleteByIDs(ctx context.Context, ids []string, deletedAt time.Time) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if movie, ok := r.movies[id]; ok {
+			delete(r.movies, id)
+			r.deleted[id] = deletedMovie{movie: movie, deletedAt: deletedAt}
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
+
+// Restore devolve o filme excluído ao mapa principal. Retorna (nil, nil) se ele não estiver excluído.
+func (r *movieRepository) Restore(ctx context.Context, id string) (*service.Movie, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.deleted[id]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.deleted, id)
+	r.movies[id] = entry.movie
+	return clone(entry.movie), nil
+}
+
+// PurgeDeleted apaga de vez os filmes excluídos antes de 'before'.
+func (r *movieRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := []string{}
+	for id, entry := range r.deleted {
+		if entry.deletedAt.Before(before) {
+			delete(r.deleted, id)
+			purged = append(purged, id)
+		}
+	}
+	return purged, nil
+}
+
+// FindMaxID retorna o maior ID numérico, contando os filmes excluídos.
+// IDs que não são números são ignorados.
+func (r *movieRepository) FindMaxID(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	maxID := 0
+	consider := func(id string) {
+		if n, err := strconv.Atoi(id); err == nil && n > maxID {
+			maxID = n
+		}
+	}
+	for id := range r.movies {
+		consider(id)
+	}
+	for id := range r.deleted {
+		consider(id)
+	}
+	return maxID, nil
+}
+
+// clone devolve uma cópia do filme. As listas também são copiadas, para que quem recebe
+// o filme não altere o que está guardado no repositório (e vice-versa).
+func clone(movie *service.Movie) *service.Movie {
+	copied := *movie
+	copied.Genres = append([]string(nil), movie.Genres...)
+	copied.Cast = append([]service.CastMember(nil), movie.Cast...)
+	copied.Credits = append([]service.Credit(nil), movie.Credits...)
+	return &copied
+}
+
+// words quebra um texto em palavras minúsculas, sem repetições.
+func words(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool, len(fields))
+	unique := fields[:0]
+	for _, field := range fields {
+		if !seen[field] {
+			seen[field] = true
+			unique = append(unique, field)
+		}
+	}
+	return unique
+}
+
+// textScore conta quantas das palavras buscadas aparecem no título.
+func textScore(title string, terms []string) float64 {
+	titleWords := make(map[string]bool)
+	for _, word := range words(title) {
+		titleWords[word] = true
+	}
+	var score float64
+	for _, term := range terms {
+		if titleWords[term] {
+			score++
+		}
+	}
+	return score
+}
+
+// idAllocator gera IDs numéricos sequenciais em memória, continuando a partir do maior ID
+// que já existe no repositório quando é usado pela primeira vez.
+type idAllocator struct {
+	repo  service.MovieRepository
+	mu    sync.Mutex
+	ready bool
+	last  int
+}
+
+// NewIDAllocator cria o gerador de IDs sequenciais para o repositório em memória.
+func NewIDAllocator(repo service.MovieRepository) service.IDAllocator {
+	return &idAllocator{repo: repo}
+}
+
+// NextID é seguro para uso concorrente: o mutex garante que cada chamada recebe um ID diferente.
+func (a *idAllocator) NextID(ctx context.Context) (string, error) {
+	ids, err := a.NextIDs(ctx, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// NextIDs reserva n IDs seguidos sob o mesmo lock.
+func (a *idAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.ready {
+		maxID, err := a.repo.FindMaxID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.last, a.ready = maxID, true
+	}
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		a.last++
+		ids = append(ids, strconv.Itoa(a.last))
+	}
+	return ids, nil
+}
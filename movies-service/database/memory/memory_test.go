@@ -0,0 +1,46 @@
+// Local: movies-service/database/memory/memory_test.go
+
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/database/repotest"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// TestMovieRepository_Conformance roda o contrato comum dos repositórios contra o adaptador em memória.
+func TestMovieRepository_Conformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) service.MovieRepository {
+		return memory.NewMovieRepository()
+	})
+}
+
+// TestCollectionRepository_Conformance roda o contrato das coleções contra o adaptador em memória.
+func TestCollectionRepository_Conformance(t *testing.T) {
+	repotest.RunCollections(t, func(t *testing.T) service.CollectionRepository {
+		return memory.NewCollectionRepository()
+	})
+}
+
+// TestPersonRepository_Conformance roda o contrato das pessoas contra o adaptador em memória.
+func TestPersonRepository_Conformance(t *testing.T) {
+	repotest.RunPeople(t, func(t *testing.T) service.PersonRepository {
+		return memory.NewPersonRepository()
+	})
+}
+
+// TestWatchlistRepository_Conformance roda o contrato das listas de interesse contra o adaptador em memória.
+func TestWatchlistRepository_Conformance(t *testing.T) {
+	repotest.RunWatchlist(t, func(t *testing.T) service.WatchlistRepository {
+		return memory.NewWatchlistRepository()
+	})
+}
+
+// TestMigrationRepository_Conformance roda o contrato do registro de migrações contra o adaptador em memória.
+func TestMigrationRepository_Conformance(t *testing.T) {
+	repotest.RunMigrations(t, func(t *testing.T) service.MigrationRepository {
+		return memory.NewMigrationRepository()
+	})
+}
@@ -0,0 +1,45 @@
+// Local: movies-service/database/memory/migrations.go
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// migrationRepository guarda os nomes das migrações concluídas em um conjunto protegido
+// por um RWMutex. Como os dados, o registro some quando o processo termina.
+type migrationRepository struct {
+	mu      sync.RWMutex
+	applied map[string]bool
+}
+
+// NewMigrationRepository cria um registro de migrações em memória vazio.
+func NewMigrationRepository() service.MigrationRepository {
+	return &migrationRepository{applied: make(map[string]bool)}
+}
+
+// IsApplied indica se a migração já foi registrada.
+func (r *migrationRepository) IsApplied(ctx context.Context, name string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.applied[name], nil
+}
+
+// MarkApplied registra a migração como concluída.
+func (r *migrationRepository) MarkApplied(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.applied[name] = true
+	return nil
+}
@@ -0,0 +1,168 @@
+// Local: movies-service/database/memory/people.go
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// personRepository guarda as pessoas em um mapa protegido por um RWMutex, trabalhando
+// sempre com cópias, como os outros repositórios em memória.
+type personRepository struct {
+	mu     sync.RWMutex
+	people map[string]service.Person
+}
+
+// NewPersonRepository cria um repositório de pessoas em memória vazio.
+func NewPersonRepository() service.PersonRepository {
+	return &personRepository{people: make(map[string]service.Person)}
+}
+
+// Save insere uma pessoa nova.
+func (r *personRepository) Save(ctx context.Context, person *service.Person) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.people[person.ID]; exists {
+		return fmt.Errorf("%w: já existe uma pessoa com o ID '%s'", service.ErrConflict, person.ID)
+	}
+	r.people[person.ID] = *person
+	return nil
+}
+
+// FindByID retorna uma cópia da pessoa, ou (nil, nil) se ela não existir.
+func (r *personRepository) FindByID(ctx context.Context, id string) (*service.Person, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	person, ok := r.people[id]
+	if !ok {
+		return nil, nil
+	}
+	return &person, nil
+}
+
+// FindByIDs retorna cópias das pessoas encontradas, ordenadas por ID.
+func (r *personRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Person, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	people := make([]*service.Person, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if person, ok := r.people[id]; ok && !seen[id] {
+			seen[id] = true
+			people = append(people, &person)
+		}
+	}
+	sort.Slice(people, func(i, j int) bool { return people[i].ID < people[j].ID })
+	return people, nil
+}
+
+// FindByName retorna a pessoa de menor ID com exatamente o nome dado, ou (nil, nil).
+func (r *personRepository) FindByName(ctx context.Context, name string) (*service.Person, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.findByName(name), nil
+}
+
+// findByName faz a busca de FindByName. Quem chama precisa ter o lock.
+func (r *personRepository) findByName(name string) *service.Person {
+	var found *service.Person
+	for _, person := range r.people {
+		if person.Name == name && (found == nil || person.ID < found.ID) {
+			person := person
+			found = &person
+		}
+	}
+	return found
+}
+
+// FindOrCreateByName busca e insere com o mesmo lock de escrita, então duas chamadas
+// simultâneas com o mesmo nome nunca criam duas pessoas.
+func (r *personRepository) FindOrCreateByName(ctx context.Context, person *service.Person) (*service.Person, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if found := r.findByName(person.Name); found != nil {
+		return found, false, nil
+	}
+	if _, exists := r.people[person.ID]; exists {
+		return nil, false, fmt.Errorf("%w: já existe uma pessoa com o ID '%s'", service.ErrConflict, person.ID)
+	}
+	r.people[person.ID] = *person
+	created := *person
+	return &created, true, nil
+}
+
+// FindAll retorna cópias de todas as pessoas, ordenadas por nome e ID.
+func (r *personRepository) FindAll(ctx context.Context) ([]*service.Person, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	people := make([]*service.Person, 0, len(r.people))
+	for _, person := range r.people {
+		person := person
+		people = append(people, &person)
+	}
+	sort.Slice(people, func(i, j int) bool {
+		if people[i].Name != people[j].Name {
+			return people[i].Name < people[j].Name
+		}
+		return people[i].ID < people[j].ID
+	})
+	return people, nil
+}
+
+// Update substitui os dados da pessoa. Retorna (nil, nil) se ela não existir.
+func (r *personRepository) Update(ctx context.Context, person *service.Person) (*service.Person, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.people[person.ID]; !ok {
+		return nil, nil
+	}
+	r.people[person.ID] = *person
+	updated := *person
+	return &updated, nil
+}
+
+// DeleteByID remove uma pessoa. Retorna false se ela não existir.
+func (r *personRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.people[id]
+	delete(r.people, id)
+	return ok, nil
+}
@@ -0,0 +1,113 @@
+// Local: movies-service/database/memory/watchlist.go
+
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// watchlistRepository guarda as listas de interesse em um mapa de usuário para um mapa de
+// filme para a entrada, protegido por um RWMutex, como os outros repositórios em memória.
+type watchlistRepository struct {
+	mu    sync.RWMutex
+	lists map[string]map[string]service.WatchlistEntry
+}
+
+// NewWatchlistRepository cria um repositório de listas de interesse em memória vazio.
+func NewWatchlistRepository() service.WatchlistRepository {
+	return &watchlistRepository{lists: make(map[string]map[string]service.WatchlistEntry)}
+}
+
+// Add inclui o filme na lista, ou retorna a entrada que já existia.
+func (r *watchlistRepository) Add(ctx context.Context, entry *service.WatchlistEntry) (*service.WatchlistEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list, ok := r.lists[entry.UserID]
+	if !ok {
+		list = make(map[string]service.WatchlistEntry)
+		r.lists[entry.UserID] = list
+	}
+	stored, exists := list[entry.MovieID]
+	if !exists {
+		stored = *entry
+		list[entry.MovieID] = stored
+	}
+	return &stored, nil
+}
+
+// Remove tira o filme da lista. Retorna false se ele não estava nela.
+func (r *watchlistRepository) Remove(ctx context.Context, userID, movieID string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := r.lists[userID]
+	if _, ok := list[movieID]; !ok {
+		return false, nil
+	}
+	delete(list, movieID)
+	if len(list) == 0 {
+		delete(r.lists, userID)
+	}
+	return true, nil
+}
+
+// List ordena as entradas do usuário e devolve as que vêm depois do cursor.
+func (r *watchlistRepository) List(ctx context.Context, query service.WatchlistQuery) ([]*service.WatchlistEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]*service.WatchlistEntry, 0, len(r.lists[query.UserID]))
+	for _, entry := range r.lists[query.UserID] {
+		copied := entry
+		if query.After == nil || watchlistBefore(query.After, &copied) {
+			entries = append(entries, &copied)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return watchlistBefore(entries[i], entries[j]) })
+	if query.Limit > 0 && len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+	}
+	return entries, nil
+}
+
+// RemoveMovies tira os filmes das listas de todos os usuários.
+func (r *watchlistRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for userID, list := range r.lists {
+		for _, id := range movieIDs {
+			delete(list, id)
+		}
+		if len(list) == 0 {
+			delete(r.lists, userID)
+		}
+	}
+	return nil
+}
+
+// watchlistBefore diz se a entrada a vem antes de b na ordem da lista: a mais recente
+// primeiro e, na mesma data, o maior ID de filme primeiro.
+func watchlistBefore(a, b *service.WatchlistEntry) bool {
+	if !a.AddedAt.Equal(b.AddedAt) {
+		return a.AddedAt.After(b.AddedAt)
+	}
+	return a.MovieID > b.MovieID
+}
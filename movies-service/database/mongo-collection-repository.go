@@ -0,0 +1,128 @@
+// Local: movies-service/database/mongo-collection-repository.go
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// mongoCollectionRepository guarda cada coleção de filmes como um documento da collection
+// "collections", com a lista ordenada de IDs dos filmes em "movie_ids".
+type mongoCollectionRepository struct {
+	documents *mongo.Collection
+}
+
+// NewMongoCollectionRepository cria o repositório de coleções e os seus índices.
+func NewMongoCollectionRepository(ctx context.Context, db *mongo.Database) (service.CollectionRepository, error) {
+	repo := &mongoCollectionRepository{documents: db.Collection("collections")}
+	_, err := repo.documents.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Índice multikey: encontra as coleções de um filme quando ele é apagado.
+		{Keys: bson.D{{Key: "movie_ids", Value: 1}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// Save insere a coleção com a versão 1.
+func (r *mongoCollectionRepository) Save(ctx context.Context, collection *service.Collection) error {
+	document := *collection
+	document.Version = 1
+	if document.MovieIDs == nil {
+		document.MovieIDs = []string{}
+	}
+	_, err := r.documents.InsertOne(ctx, &document)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: já existe uma coleção com o ID '%s'", service.ErrConflict, collection.ID)
+	}
+	return err
+}
+
+// FindByID retorna a coleção, ou (nil, nil) se ela não existir.
+func (r *mongoCollectionRepository) FindByID(ctx context.Context, id string) (*service.Collection, error) {
+	var collection service.Collection
+	err := r.documents.FindOne(ctx, bson.M{"id": id}).Decode(&collection)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// FindAll retorna todas as coleções, ordenadas por ID.
+func (r *mongoCollectionRepository) FindAll(ctx context.Context) ([]*service.Collection, error) {
+	cursor, err := r.documents.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	collections := make([]*service.Collection, 0)
+	if err := cursor.All(ctx, &collections); err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+// Update grava a coleção com um filtro que inclui a versão lida, então uma alteração
+// concorrente faz o filtro não encontrar nada em vez de ser sobrescrita.
+func (r *mongoCollectionRepository) Update(ctx context.Context, collection *service.Collection) (*service.Collection, error) {
+	movieIDs := collection.MovieIDs
+	if movieIDs == nil {
+		movieIDs = []string{}
+	}
+	var updated service.Collection
+	err := r.documents.FindOneAndUpdate(ctx,
+		bson.M{"id": collection.ID, "version": collection.Version},
+		bson.M{
+			"$set": bson.M{"name": collection.Name, "description": collection.Description, "movie_ids": movieIDs},
+			"$inc": bson.M{"version": 1},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err == nil {
+		return &updated, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	// Nada foi alterado: ou a coleção não existe, ou a versão mudou.
+	count, err := r.documents.CountDocuments(ctx, bson.M{"id": collection.ID})
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: a coleção '%s' foi alterada por outra requisição", service.ErrConflict, collection.ID)
+}
+
+// DeleteByID apaga a coleção. Retorna false se ela não existir.
+func (r *mongoCollectionRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	result, err := r.documents.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// RemoveMovies tira os filmes de todas as coleções com um único UpdateMany ($pull).
+func (r *mongoCollectionRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return ctx.Err()
+	}
+	_, err := r.documents.UpdateMany(ctx,
+		bson.M{"movie_ids": bson.M{"$in": movieIDs}},
+		bson.M{
+			"$pull": bson.M{"movie_ids": bson.M{"$in": movieIDs}},
+			"$inc":  bson.M{"version": 1},
+		})
+	return err
+}
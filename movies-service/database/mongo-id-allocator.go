@@ -0,0 +1,101 @@
+// Local: movies-service/database/mongo-id-allocator.go
+
+package database
+
+import (
+	"context"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// moviesCounterID é o _id do documento, na collection "counters", que guarda o último ID de filme usado.
+const moviesCounterID = "movies"
+
+// mongoIDAllocator gera IDs numéricos sequenciais usando um contador no MongoDB.
+// O incremento é feito com um único findOneAndUpdate + $inc, que é atômico no servidor,
+// então instâncias e requisições concorrentes nunca recebem o mesmo ID.
+type mongoIDAllocator struct {
+	counters *mongo.Collection
+	repo     service.MovieRepository
+}
+
+// NewMongoIDAllocator cria o gerador de IDs. O repositório é usado apenas uma vez,
+// para iniciar o contador a partir do maior ID já existente (ex: os filmes do seed).
+func NewMongoIDAllocator(db *mongo.Database, repo service.MovieRepository) service.IDAllocator {
+	return &mongoIDAllocator{
+		counters: db.Collection("counters"),
+		repo:     repo,
+	}
+}
+
+// NextID incrementa o contador e retorna o novo valor.
+func (a *mongoIDAllocator) NextID(ctx context.Context) (string, error) {
+	ids, err := a.NextIDs(ctx, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// NextIDs soma n ao contador com um único $inc e retorna os n valores reservados.
+func (a *mongoIDAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	last, err := a.increment(ctx, n)
+	if err == mongo.ErrNoDocuments {
+		// Primeiro uso: o contador ainda não existe.
+		if err := a.initCounter(ctx); err != nil {
+			return nil, err
+		}
+		last, err = a.increment(ctx, n)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sequence(last, n), nil
+}
+
+// increment executa o $inc atômico e retorna o último valor reservado. Retorna
+// mongo.ErrNoDocuments se o contador não existe.
+func (a *mongoIDAllocator) increment(ctx context.Context, n int) (int64, error) {
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := a.counters.FindOneAndUpdate(ctx,
+		bson.M{"_id": moviesCounterID},
+		bson.M{"$inc": bson.M{"seq": int64(n)}},
+		opts,
+	).Decode(&counter)
+	return counter.Seq, err
+}
+
+// sequence retorna os n IDs que terminam em last, em ordem crescente.
+func sequence(last int64, n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = strconv.FormatInt(last-int64(n-1-i), 10)
+	}
+	return ids
+}
+
+// initCounter cria o contador com o maior ID existente. Se outra instância criar o
+// contador ao mesmo tempo, o erro de chave duplicada é ignorado: as duas usaram o mesmo
+// valor inicial e, a partir daí, o $inc garante IDs únicos.
+func (a *mongoIDAllocator) initCounter(ctx context.Context) error {
+	maxID, err := a.repo.FindMaxID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = a.counters.InsertOne(ctx, bson.M{"_id": moviesCounterID, "seq": int64(maxID)})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+//go:build integration
+
+// Local: movies-service/database/mongo-id-allocator_test.go
+
+// Estes testes precisam de um MongoDB de verdade. Para executá-los:
+//
+//	MONGO_URI=mongodb://localhost:27017 go test -tags integration ./movies-service/database/...
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// newTestDatabase conecta ao MONGO_URI e cria um banco exclusivo para o teste,
+// que é apagado ao final.
+func newTestDatabase(t *testing.T) *mongo.Database {
+	t.Helper()
+	uri := os.Getenv("MONGO_URI")
+	if uri == "" {
+		t.Skip("MONGO_URI não definido; pulando teste de integração com o MongoDB")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("Falha ao conectar com o MongoDB: %v", err)
+	}
+	db := client.Database(fmt.Sprintf("moviedb_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		client.Disconnect(context.Background())
+	})
+	return db
+}
+
+// TestMongoIDAllocator_ConcurrentCreates dispara centenas de criações em paralelo contra o
+// MongoDB e verifica que os IDs são únicos e continuam a partir do maior ID já existente.
+//
+// O contador ainda não existe quando as criações começam, e todas partem juntas (depois
+// de fechar o canal start) em várias instâncias do gerador, como vários movies-service
+// subindo ao mesmo tempo. Assim, muitas chamadas encontram o contador ausente e correm
+// para criá-lo em initCounter; metade delas cria os filmes em lote, com NextIDs.
+func TestMongoIDAllocator_ConcurrentCreates(t *testing.T) {
+	// Arrange: um banco com filmes "do seed", cujo maior ID é 10.
+	db := newTestDatabase(t)
+	ctx := context.Background()
+	repo, err := database.NewMongoMovieRepository(ctx, db)
+	if err != nil {
+		t.Fatalf("Falha ao criar o repositório: %v", err)
+	}
+	for _, id := range []string{"5", "10", "não-numérico"} {
+		if err := repo.Save(ctx, &service.Movie{ID: id, Title: "Seed " + id}); err != nil {
+			t.Fatalf("Falha ao inserir o filme do seed: %v", err)
+		}
+	}
+	const instances = 4
+	services := make([]service.MovieService, instances)
+	for i := range services {
+		services[i] = service.NewMovieService(repo, database.NewMongoIDAllocator(db, repo))
+	}
+
+	// Act: cada goroutine cria um filme sozinho ou um lote de 2, e todas começam juntas.
+	const total = 300
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	start := make(chan struct{})
+	ids := make(map[string]bool, total)
+	record := func(id string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if ids[id] {
+			t.Errorf("ID '%s' foi gerado mais de uma vez", id)
+		}
+		ids[id] = true
+	}
+	for i := 0; i < total; i += 2 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			movieService := services[i/2%instances]
+			<-start
+			if i%4 == 0 {
+				results, err := movieService.BatchCreateMovies(ctx, []*service.Movie{
+					{Title: "Filme " + strconv.Itoa(i)}, {Title: "Filme " + strconv.Itoa(i+1)},
+				})
+				if err != nil {
+					t.Errorf("Erro inesperado ao criar o lote: %v", err)
+					return
+				}
+				for _, result := range results {
+					if result.Err != nil {
+						t.Errorf("Erro inesperado ao criar filme do lote: %v", result.Err)
+						continue
+					}
+					record(result.ID)
+				}
+				return
+			}
+			for j := i; j < i+2; j++ {
+				movie, err := movieService.CreateMovie(ctx, &service.Movie{Title: "Filme " + strconv.Itoa(j)})
+				if err != nil {
+					t.Errorf("Erro inesperado ao criar filme: %v", err)
+					return
+				}
+				record(movie.ID)
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	// Assert: os IDs gerados são exatamente 11..310.
+	if len(ids) != total {
+		t.Errorf("Esperava %d IDs distintos, mas obteve %d", total, len(ids))
+	}
+	for i := 11; i < 11+total; i++ {
+		if !ids[strconv.Itoa(i)] {
+			t.Errorf("Esperava que o ID %d fosse gerado", i)
+		}
+	}
+}
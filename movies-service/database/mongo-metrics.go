@@ -0,0 +1,25 @@
+// Local: movies-service/database/mongo-metrics.go
+
+package database
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// mongoDuration é a latência das operações do repositório de filmes no MongoDB, por
+// operação (o nome do método do repositório, ex: FindPage). Ela inclui a decodificação
+// dos documentos; no Stream, inclui também o tempo de envio de cada filme ao cliente.
+var mongoDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "mongo_operation_duration_seconds",
+	Help:    "Duração das operações do repositório no MongoDB, em segundos, por collection e operação.",
+	Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+}, []string{"collection", "operation"})
+
+// observeMovies registra a duração de uma operação na collection de filmes. Deve ser usada
+// com defer no início do método: defer observeMovies("FindByID", time.Now()).
+func observeMovies(operation string, start time.Time) {
+	mongoDuration.WithLabelValues("movies", operation).Observe(time.Since(start).Seconds())
+}
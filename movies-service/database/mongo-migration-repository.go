@@ -0,0 +1,47 @@
+// Local: movies-service/database/mongo-migration-repository.go
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// mongoMigrationRepository registra cada migração de dados concluída como um documento da
+// collection "migrations", com o nome da migração no _id ({_id, applied_at}).
+type mongoMigrationRepository struct {
+	documents *mongo.Collection
+}
+
+// NewMongoMigrationRepository cria o registro das migrações de dados. O _id já é único,
+// então nenhum índice é necessário.
+func NewMongoMigrationRepository(db *mongo.Database) service.MigrationRepository {
+	return &mongoMigrationRepository{documents: db.Collection("migrations")}
+}
+
+// IsApplied indica se existe o documento da migração.
+func (r *mongoMigrationRepository) IsApplied(ctx context.Context, name string) (bool, error) {
+	count, err := r.documents.CountDocuments(ctx, bson.M{"_id": name}, options.Count().SetLimit(1))
+	return count > 0, err
+}
+
+// MarkApplied cria o documento da migração com um upsert; registrar de novo mantém a data
+// do primeiro registro.
+func (r *mongoMigrationRepository) MarkApplied(ctx context.Context, name string) error {
+	_, err := r.documents.UpdateOne(ctx,
+		bson.M{"_id": name},
+		bson.M{"$setOnInsert": bson.M{"applied_at": time.Now().UTC()}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		// Outra instância registrou a mesma migração ao mesmo tempo.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+// Local: movies-service/database/mongo-person-repository.go
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// nameKeyField só existe nas pessoas criadas por FindOrCreateByName e repete o nome delas.
+// O índice único (e esparso, para ignorar as pessoas criadas pela API, que podem ter nomes
+// repetidos) é o que impede dois upserts simultâneos de criarem a mesma pessoa.
+const nameKeyField = "name_key"
+
+// mongoPersonRepository guarda cada pessoa como um documento da collection "people".
+type mongoPersonRepository struct {
+	documents *mongo.Collection
+}
+
+// NewMongoPersonRepository cria o repositório de pessoas e os seus índices.
+func NewMongoPersonRepository(ctx context.Context, db *mongo.Database) (service.PersonRepository, error) {
+	repo := &mongoPersonRepository{documents: db.Collection("people")}
+	_, err := repo.documents.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Usado pela listagem (ordenada por nome) e pela migração dos diretores.
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}}},
+		{Keys: bson.D{{Key: nameKeyField, Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// Save insere uma pessoa nova. O índice único de "id" rejeita IDs repetidos.
+func (r *mongoPersonRepository) Save(ctx context.Context, person *service.Person) error {
+	_, err := r.documents.InsertOne(ctx, person)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: já existe uma pessoa com o ID '%s'", service.ErrConflict, person.ID)
+	}
+	return err
+}
+
+// FindByID retorna a pessoa, ou (nil, nil) se ela não existir.
+func (r *mongoPersonRepository) FindByID(ctx context.Context, id string) (*service.Person, error) {
+	return r.findOne(ctx, bson.M{"id": id}, options.FindOne())
+}
+
+// FindByIDs retorna as pessoas encontradas, ordenadas por ID, com uma única consulta.
+func (r *mongoPersonRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Person, error) {
+	if len(ids) == 0 {
+		return []*service.Person{}, ctx.Err()
+	}
+	return r.find(ctx, bson.M{"id": bson.M{"$in": ids}}, bson.D{{Key: "id", Value: 1}})
+}
+
+// FindByName retorna a pessoa de menor ID com exatamente o nome dado, ou (nil, nil).
+func (r *mongoPersonRepository) FindByName(ctx context.Context, name string) (*service.Person, error) {
+	return r.findOne(ctx, bson.M{"name": name}, options.FindOne().SetSort(bson.D{{Key: "id", Value: 1}}))
+}
+
+// FindOrCreateByName procura primeiro qualquer pessoa com o nome; se não houver, faz um
+// upsert com $setOnInsert pela chave name_key. Se dois upserts simultâneos colidirem no
+// índice único, a segunda tentativa encontra o documento criado pelo outro; um conflito
+// que persiste vem do índice de "id".
+func (r *mongoPersonRepository) FindOrCreateByName(ctx context.Context, person *service.Person) (*service.Person, bool, error) {
+	found, err := r.FindByName(ctx, person.Name)
+	if err != nil || found != nil {
+		return found, false, err
+	}
+
+	filter := bson.M{nameKeyField: person.Name}
+	update := bson.M{"$setOnInsert": bson.M{"id": person.ID, "name": person.Name}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var stored service.Person
+	for attempt := 0; attempt < 2; attempt++ {
+		err = r.documents.FindOneAndUpdate(ctx, filter, update, opts).Decode(&stored)
+		if !mongo.IsDuplicateKeyError(err) {
+			break
+		}
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, false, fmt.Errorf("%w: já existe uma pessoa com o ID '%s'", service.ErrConflict, person.ID)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &stored, stored.ID == person.ID, nil
+}
+
+// FindAll retorna todas as pessoas, ordenadas por nome e ID.
+func (r *mongoPersonRepository) FindAll(ctx context.Context) ([]*service.Person, error) {
+	return r.find(ctx, bson.M{}, bson.D{{Key: "name", Value: 1}, {Key: "id", Value: 1}})
+}
+
+// Update substitui os dados da pessoa. Retorna (nil, nil) se ela não existir.
+func (r *mongoPersonRepository) Update(ctx context.Context, person *service.Person) (*service.Person, error) {
+	var updated service.Person
+	opts := options.FindOneAndReplace().SetReturnDocument(options.After)
+	err := r.documents.FindOneAndReplace(ctx, bson.M{"id": person.ID}, person, opts).Decode(&updated)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteByID apaga a pessoa. Retorna false se ela não existir.
+func (r *mongoPersonRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	result, err := r.documents.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+func (r *mongoPersonRepository) findOne(ctx context.Context, filter bson.M, opts *options.FindOneOptions) (*service.Person, error) {
+	var person service.Person
+	err := r.documents.FindOne(ctx, filter, opts).Decode(&person)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &person, nil
+}
+
+func (r *mongoPersonRepository) find(ctx context.Context, filter bson.M, sort bson.D) ([]*service.Person, error) {
+	cursor, err := r.documents.Find(ctx, filter, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, err
+	}
+	people := make([]*service.Person, 0)
+	if err := cursor.All(ctx, &people); err != nil {
+		return nil, err
+	}
+	return people, nil
+}
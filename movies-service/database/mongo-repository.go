@@ -4,15 +4,39 @@ package database
 
 import (
 	"context"
-	"strconv"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	// Importa nosso pacote de serviço para ter acesso à interface e ao modelo
 	"github.com/alenrique/Movies-microservices/movies-service/service"
 )
 
+// deletedAtField guarda a data da exclusão lógica. Ele só existe nos documentos excluídos.
+const deletedAtField = "deleted_at"
+
+// notDeleted é o filtro que esconde os filmes com exclusão lógica. No MongoDB,
+// comparar com null também encontra os documentos em que o campo não existe.
+func notDeleted() bson.M {
+	return bson.M{deletedAtField: nil}
+}
+
+// byID é o filtro de um filme não excluído pelo seu ID.
+func byID(id string) bson.M {
+	return bson.M{"id": id, deletedAtField: nil}
+}
+
+// byIDs é o filtro dos filmes não excluídos com um dos IDs da lista.
+func byIDs(ids []string) bson.M {
+	return bson.M{"id": bson.M{"$in": ids}, deletedAtField: nil}
+}
+
 // mongoMovieRepository é a implementação do nosso repositório para o MongoDB.
 type mongoMovieRepository struct {
 	collection *mongo.Collection
@@ -20,24 +44,114 @@ type mongoMovieRepository struct {
 
 // NewMongoMovieRepository é o construtor que cria uma nova instância do nosso repositório.
 // Ele retorna a INTERFACE, e não a struct, para manter o acoplamento baixo.
-func NewMongoMovieRepository(db *mongo.Database) service.MovieRepository {
-	return &mongoMovieRepository{
+// Na criação, garantimos que os índices usados pelas consultas existem.
+func NewMongoMovieRepository(ctx context.Context, db *mongo.Database) (service.MovieRepository, error) {
+	repo := &mongoMovieRepository{
 		collection: db.Collection("movies"), // O nome da nossa collection no MongoDB
 	}
+	if err := repo.ensureIndexes(ctx); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// ensureIndexes cria os índices da collection. CreateMany é idempotente,
+// então pode ser chamado a cada inicialização do serviço.
+func (r *mongoMovieRepository) ensureIndexes(ctx context.Context) error {
+	if err := r.dropLegacyIDIndex(ctx); err != nil {
+		return err
+	}
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Garante que dois filmes nunca terão o mesmo ID. Também é usado pela
+		// busca por ID e pela paginação por keyset.
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Usados pelas ordenações mais comuns da listagem (o "id" desempata).
+		{Keys: bson.D{{Key: "year", Value: 1}, {Key: "id", Value: 1}}},
+		{Keys: bson.D{{Key: "title", Value: 1}, {Key: "id", Value: 1}}},
+		// Índice de texto usado pela busca por título (SearchMovies).
+		{Keys: bson.D{{Key: "title", Value: "text"}}},
+		// Usado pela limpeza dos filmes excluídos. Por ser esparso, só contém os excluídos.
+		{Keys: bson.D{{Key: deletedAtField, Value: 1}}, Options: options.Index().SetSparse(true)},
+		// Índice multikey usado pela filmografia de uma pessoa (filtro PersonID).
+		{Keys: bson.D{{Key: "credits.person_id", Value: 1}}},
+	})
+	return err
+}
+
+// dropLegacyIDIndex remove o índice "id_1" criado por versões anteriores sem a opção unique.
+// O MongoDB não permite criar um índice com o mesmo nome e opções diferentes.
+func (r *mongoMovieRepository) dropLegacyIDIndex(ctx context.Context) error {
+	cursor, err := r.collection.Indexes().List(ctx)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var index struct {
+			Name   string `bson:"name"`
+			Unique bool   `bson:"unique"`
+		}
+		if err := cursor.Decode(&index); err != nil {
+			return err
+		}
+		if index.Name == "id_1" && !index.Unique {
+			_, err := r.collection.Indexes().DropOne(ctx, index.Name)
+			return err
+		}
+	}
+	return cursor.Err()
 }
 
 // Save implementa o método de salvamento da interface MovieRepository.
 func (r *mongoMovieRepository) Save(ctx context.Context, movie *service.Movie) error {
+	defer observeMovies("Save", time.Now())
 	_, err := r.collection.InsertOne(ctx, movie)
+	if mongo.IsDuplicateKeyError(err) {
+		// O índice único de "id" rejeitou o filme: traduzimos para o erro do domínio.
+		return fmt.Errorf("%w: já existe um filme com o ID '%s'", service.ErrConflict, movie.ID)
+	}
 	return err
 }
 
+// SaveMany implementa a inserção em lote com um único InsertMany. Com ordered=false, o
+// MongoDB tenta inserir todos os documentos mesmo que algum falhe, e informa o índice de
+// cada documento rejeitado.
+func (r *mongoMovieRepository) SaveMany(ctx context.Context, movies []*service.Movie) ([]error, error) {
+	defer observeMovies("SaveMany", time.Now())
+	errs := make([]error, len(movies))
+	if len(movies) == 0 {
+		return errs, nil
+	}
+	documents := make([]interface{}, len(movies))
+	for i, movie := range movies {
+		documents[i] = movie
+	}
+
+	_, err := r.collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		// Sem erro, ou com uma falha que não pertence a nenhum documento específico.
+		return errs, err
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		movie := movies[writeErr.Index]
+		if mongo.IsDuplicateKeyError(writeErr) {
+			errs[writeErr.Index] = fmt.Errorf("%w: já existe um filme com o ID '%s'", service.ErrConflict, movie.ID)
+		} else {
+			errs[writeErr.Index] = fmt.Errorf("falha ao inserir o filme '%s': %w", movie.ID, writeErr)
+		}
+	}
+	return errs, nil
+}
+
 // FindByID implementa a busca por ID.
 func (r *mongoMovieRepository) FindByID(ctx context.Context, id string) (*service.Movie, error) {
+	defer observeMovies("FindByID", time.Now())
 	var movie service.Movie
 
 	// bson.M é um atalho para criar um filtro de busca
-	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&movie)
+	err := r.collection.FindOne(ctx, byID(id)).Decode(&movie)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			// Isso é importante para que o serviço saiba que o filme não foi encontrado
@@ -49,12 +163,34 @@ func (r *mongoMovieRepository) FindByID(ctx context.Context, id string) (*servic
 	return &movie, nil
 }
 
-// FindAll implementa a busca por todos os documentos.
+// FindByIDs implementa a busca em lote com o operador $in.
+func (r *mongoMovieRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Movie, error) {
+	defer observeMovies("FindByIDs", time.Now())
+	movies := make([]*service.Movie, 0, len(ids))
+	if len(ids) == 0 {
+		return movies, nil
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, byIDs(ids), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &movies); err != nil {
+		return nil, err
+	}
+	return movies, nil
+}
+
+// FindAll implementa a busca por todos os documentos, ordenados por ID.
 func (r *mongoMovieRepository) FindAll(ctx context.Context) ([]*service.Movie, error) {
+	defer observeMovies("FindAll", time.Now())
 	var movies []*service.Movie
 
-	// Passamos um filtro vazio (bson.M{}) para pegar todos os documentos
-	cursor, err := r.collection.Find(ctx, bson.M{})
+	// O filtro deixa de fora apenas os filmes excluídos
+	opts := options.Find().SetSort(bson.D{{Key: "id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, notDeleted(), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -69,46 +205,446 @@ func (r *mongoMovieRepository) FindAll(ctx context.Context) ([]*service.Movie, e
 		movies = append(movies, &movie)
 	}
 
+	return movies, cursor.Err()
+}
+
+// FindPage implementa a busca paginada por keyset: em vez de pular N documentos
+// (skip), filtramos os filmes que vêm depois do último filme da página anterior.
+func (r *mongoMovieRepository) FindPage(ctx context.Context, query service.MovieQuery) ([]*service.Movie, error) {
+	defer observeMovies("FindPage", time.Now())
+	filter := buildFilter(query.Filter)
+	if query.After != nil {
+		filter = bson.M{"$and": bson.A{filter, buildKeyset(query.OrderBy, query.After)}}
+	}
+	opts := options.Find().
+		SetSort(buildSort(query.OrderBy)).
+		SetLimit(int64(query.Limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	movies := make([]*service.Movie, 0, query.Limit)
+	if err := cursor.All(ctx, &movies); err != nil {
+		return nil, err
+	}
 	return movies, nil
 }
 
-// DeleteByID implementa a exclusão por ID.
-func (r *mongoMovieRepository) DeleteByID(ctx context.Context, id string) error {
-	_, err := r.collection.DeleteOne(ctx, bson.M{"id": id})
-	return err
+// Count implementa a contagem dos filmes que satisfazem o filtro.
+func (r *mongoMovieRepository) Count(ctx context.Context, filter service.MovieFilter) (int64, error) {
+	defer observeMovies("Count", time.Now())
+	return r.collection.CountDocuments(ctx, buildFilter(filter))
 }
 
-func (r *mongoMovieRepository) FindMaxID(ctx context.Context) (int, error) {
-	// Busca todos os documentos da coleção.
-	cursor, err := r.collection.Find(ctx, bson.M{})
+// Stream implementa a leitura em streaming: cada documento é decodificado e entregue a fn
+// assim que sai do cursor, então a memória usada não depende do tamanho da collection.
+func (r *mongoMovieRepository) Stream(ctx context.Context, filter service.MovieFilter, fn func(*service.Movie) error) error {
+	defer observeMovies("Stream", time.Now())
+	opts := options.Find().SetSort(bson.D{{Key: "id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, buildFilter(filter), opts)
 	if err != nil {
-		return 0, err
+		return err
 	}
 	defer cursor.Close(ctx)
 
-	maxID := 0
-	// Itera sobre todos os filmes.
 	for cursor.Next(ctx) {
-		var movie struct {
-			ID string `bson:"id"`
-		}
+		var movie service.Movie
 		if err := cursor.Decode(&movie); err != nil {
-			// Se um documento não puder ser decodificado, pulamos para o próximo.
-			continue
+			return err
+		}
+		if err := fn(&movie); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// searchHit é o documento retornado pela busca: o filme mais a pontuação calculada pelo MongoDB.
+type searchHit struct {
+	service.Movie `bson:",inline"`
+	Score         float64 `bson:"score"`
+}
+
+// Search implementa a busca textual usando o índice de texto da collection.
+// A pontuação ($meta: textScore) é projetada no campo "score" e usada na ordenação.
+func (r *mongoMovieRepository) Search(ctx context.Context, query service.SearchQuery) ([]*service.SearchResult, error) {
+	defer observeMovies("Search", time.Now())
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "id", Value: 1}}).
+		SetSkip(int64(query.Offset)).
+		SetLimit(int64(query.Limit))
+
+	cursor, err := r.collection.Find(ctx, searchFilter(query.Text), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := make([]*service.SearchResult, 0, query.Limit)
+	for cursor.Next(ctx) {
+		var hit searchHit
+		if err := cursor.Decode(&hit); err != nil {
+			return nil, err
 		}
+		movie := hit.Movie
+		results = append(results, &service.SearchResult{Movie: &movie, Score: hit.Score})
+	}
+	return results, cursor.Err()
+}
+
+// CountSearch implementa a contagem dos filmes encontrados pela busca textual.
+func (r *mongoMovieRepository) CountSearch(ctx context.Context, text string) (int64, error) {
+	defer observeMovies("CountSearch", time.Now())
+	return r.collection.CountDocuments(ctx, searchFilter(text))
+}
 
-		// Converte o ID de string para int para podermos comparar.
-		id, err := strconv.Atoi(movie.ID)
-		if err != nil {
-			// Se a conversão falhar (ex: o ID não é um número), pulamos.
-			continue
+// searchFilter é o filtro da busca textual, sem os filmes excluídos.
+func searchFilter(text string) bson.M {
+	return bson.M{"$text": bson.M{"$search": text}, deletedAtField: nil}
+}
+
+// buildFilter traduz o filtro do domínio para um filtro do MongoDB.
+// Os filmes excluídos ficam de fora, a não ser com IncludeDeleted.
+func buildFilter(f service.MovieFilter) bson.M {
+	filter := notDeleted()
+	if f.IncludeDeleted {
+		filter = bson.M{}
+	}
+	year := bson.M{}
+	if f.YearMin != 0 {
+		year["$gte"] = f.YearMin
+	}
+	if f.YearMax != 0 {
+		year["$lte"] = f.YearMax
+	}
+	if len(year) > 0 {
+		filter["year"] = year
+	}
+	if f.TitleContains != "" {
+		// QuoteMeta evita que caracteres especiais do texto sejam interpretados como regex.
+		filter["title"] = bson.M{"$regex": regexp.QuoteMeta(f.TitleContains), "$options": "i"}
+	}
+	if f.Director != "" {
+		filter["director"] = f.Director
+	}
+	if f.PersonID != "" {
+		filter["credits.person_id"] = f.PersonID
+	}
+	return filter
+}
+
+// buildSort traduz a ordenação do domínio para o formato do MongoDB.
+func buildSort(order []service.SortField) bson.D {
+	sort := bson.D{}
+	for _, field := range order {
+		direction := 1
+		if field.Desc {
+			direction = -1
 		}
+		sort = append(sort, bson.E{Key: field.Field, Value: direction})
+	}
+	return sort
+}
 
-		// Verifica se o ID atual é o maior que já vimos.
-		if id > maxID {
-			maxID = id
+// buildKeyset monta a condição "vem depois de 'after'" para uma ordenação com vários campos.
+// Para a ordenação (a, b, id) o resultado é:
+// a > A  OU  (a = A E b > B)  OU  (a = A E b = B E id > ID)
+// trocando '>' por '<' nos campos em ordem decrescente.
+func buildKeyset(order []service.SortField, after *service.Movie) bson.M {
+	or := bson.A{}
+	for i, field := range order {
+		condition := bson.M{}
+		for _, previous := range order[:i] {
+			condition[previous.Field] = fieldValue(after, previous.Field)
+		}
+		operator := "$gt"
+		if field.Desc {
+			operator = "$lt"
 		}
+		condition[field.Field] = bson.M{operator: fieldValue(after, field.Field)}
+		or = append(or, condition)
+	}
+	return bson.M{"$or": or}
+}
+
+// fieldValue retorna o valor de um campo ordenável do filme.
+func fieldValue(movie *service.Movie, field string) interface{} {
+	switch field {
+	case "title":
+		return movie.Title
+	case "director":
+		return movie.Director
+	case "year":
+		return movie.Year
 	}
+	return movie.ID
+}
 
-	return maxID, nil
+// Update implementa a substituição de um filme existente.
+// Usamos FindOneAndReplace para atualizar e obter o documento novo em uma única operação.
+func (r *mongoMovieRepository) Update(ctx context.Context, movie *service.Movie) (*service.Movie, error) {
+	defer observeMovies("Update", time.Now())
+	var updated service.Movie
+
+	opts := options.FindOneAndReplace().SetReturnDocument(options.After)
+	err := r.collection.FindOneAndReplace(ctx, byID(movie.ID), movie, opts).Decode(&updated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Assim como no FindByID, (nil, nil) indica que o filme não existe
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// Patch altera só os campos de paths com um FindOneAndUpdate, que retorna o documento novo.
+// Os nomes dos campos de PatchMovie são os mesmos das tags bson, inclusive os subcampos de
+// external_ids.
+func (r *mongoMovieRepository) Patch(ctx context.Context, id string, patch *service.Movie, paths []string) (*service.Movie, error) {
+	defer observeMovies("Patch", time.Now())
+	update, err := patchUpdate(patch, paths)
+	if err != nil {
+		return nil, err
+	}
+	if len(update) == 0 {
+		return r.FindByID(ctx, id)
+	}
+
+	var patched service.Movie
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = r.collection.FindOneAndUpdate(ctx, byID(id), update, opts).Decode(&patched)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &patched, nil
+}
+
+// patchUpdate monta o $set dos campos de paths a partir do documento de patch. Os campos
+// vazios que o documento omite (omitempty) entram no $unset, para que o filme fique igual
+// a um salvo com esses valores.
+func patchUpdate(patch *service.Movie, paths []string) (bson.M, error) {
+	data, err := bson.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	var document bson.M
+	if err := bson.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	set, unset := bson.M{}, bson.M{}
+	for _, path := range paths {
+		if value, ok := lookup(document, path); ok {
+			set[path] = value
+		} else {
+			unset[path] = ""
+		}
+	}
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	return update, nil
+}
+
+// lookup busca um campo do documento, seguindo os subdocumentos em caminhos como "a.b".
+func lookup(document bson.M, path string) (interface{}, bool) {
+	field, rest, nested := strings.Cut(path, ".")
+	value, ok := document[field]
+	if !ok || !nested {
+		return value, ok
+	}
+	sub, ok := value.(bson.M)
+	if !ok {
+		return nil, false
+	}
+	return lookup(sub, rest)
+}
+
+// SetCredits troca só o campo credits com um UpdateOne, que só encontra o filme se os
+// créditos ainda forem iguais a expected. Sem créditos, o campo não existe no documento
+// (omitempty), e comparar com null também encontra os documentos sem ele.
+func (r *mongoMovieRepository) SetCredits(ctx context.Context, id string, expected, credits []service.Credit) (bool, error) {
+	defer observeMovies("SetCredits", time.Now())
+	filter := byID(id)
+	if len(expected) == 0 {
+		filter["credits"] = bson.M{"$in": bson.A{nil, bson.A{}}}
+	} else {
+		filter["credits"] = expected
+	}
+	update := bson.M{"$set": bson.M{"credits": credits}}
+	if len(credits) == 0 {
+		update = bson.M{"$unset": bson.M{"credits": ""}}
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// DeleteByID implementa a exclusão por ID. O DeletedCount informa se o filme existia.
+func (r *mongoMovieRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	defer observeMovies("DeleteByID", time.Now())
+	result, err := r.collection.DeleteOne(ctx, byID(id))
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// SoftDeleteByID preenche o campo deleted_at do filme. Retorna false se ele não existir.
+func (r *mongoMovieRepository) SoftDeleteByID(ctx context.Context, id string, deletedAt time.Time) (bool, error) {
+	defer observeMovies("SoftDeleteByID", time.Now())
+	result, err := r.collection.UpdateOne(ctx, byID(id), bson.M{"$set": bson.M{deletedAtField: deletedAt}})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// DeleteByIDs implementa a exclusão em lote com DeleteMany.
+func (r *mongoMovieRepository) DeleteByIDs(ctx context.Context, ids []string) ([]string, error) {
+	defer observeMovies("DeleteByIDs", time.Now())
+	existing, err := r.existingIDs(ctx, ids)
+	if err != nil || len(existing) == 0 {
+		return existing, err
+	}
+	if _, err := r.collection.DeleteMany(ctx, byIDs(existing)); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// SoftDeleteByIDs implementa a exclusão lógica em lote com UpdateMany.
+func (r *mongoMovieRepository) SoftDeleteByIDs(ctx context.Context, ids []string, deletedAt time.Time) ([]string, error) {
+	defer observeMovies("SoftDeleteByIDs", time.Now())
+	existing, err := r.existingIDs(ctx, ids)
+	if err != nil || len(existing) == 0 {
+		return existing, err
+	}
+	if _, err := r.collection.UpdateMany(ctx, byIDs(existing), bson.M{"$set": bson.M{deletedAtField: deletedAt}}); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// existingIDs retorna quais dos IDs pertencem a filmes não excluídos. O DeleteMany e o
+// UpdateMany só informam quantos documentos alteraram, e não quais; por isso os IDs são
+// consultados antes. Se outra requisição excluir um desses filmes entre as duas operações,
+// ele ainda é informado como excluído, o que não muda o resultado final.
+func (r *mongoMovieRepository) existingIDs(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+	return r.findIDs(ctx, byIDs(ids))
+}
+
+// findIDs retorna apenas os IDs dos filmes que satisfazem o filtro.
+func (r *mongoMovieRepository) findIDs(ctx context.Context, filter bson.M) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"id": 1, "_id": 0})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	ids := []string{}
+	for cursor.Next(ctx) {
+		var document struct {
+			ID string `bson:"id"`
+		}
+		if err := cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+		ids = append(ids, document.ID)
+	}
+	return ids, cursor.Err()
+}
+
+// Restore remove o campo deleted_at e retorna o filme. Retorna (nil, nil) se ele não estiver excluído.
+func (r *mongoMovieRepository) Restore(ctx context.Context, id string) (*service.Movie, error) {
+	defer observeMovies("Restore", time.Now())
+	var restored service.Movie
+
+	filter := bson.M{"id": id, deletedAtField: bson.M{"$ne": nil}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$unset": bson.M{deletedAtField: ""}}, opts).Decode(&restored)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &restored, nil
+}
+
+// PurgeDeleted apaga de vez os filmes excluídos antes de 'before'.
+// Os IDs são buscados antes, pois o DeleteMany só informa quantos documentos apagou.
+func (r *mongoMovieRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	defer observeMovies("PurgeDeleted", time.Now())
+	expired := bson.M{deletedAtField: bson.M{"$lt": before}}
+	purged, err := r.findIDs(ctx, expired)
+	if err != nil || len(purged) == 0 {
+		return purged, err
+	}
+	expired["id"] = bson.M{"$in": purged}
+	if _, err := r.collection.DeleteMany(ctx, expired); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+// FindMaxID retorna o maior ID numérico da collection, contando os filmes excluídos.
+// IDs que não são números são ignorados.
+// O cálculo é feito no próprio MongoDB (aggregation), sem trazer os documentos para o serviço.
+// Hoje ele só é usado para iniciar o contador do gerador de IDs.
+func (r *mongoMovieRepository) FindMaxID(ctx context.Context) (int, error) {
+	defer observeMovies("FindMaxID", time.Now())
+	// $convert transforma o ID em número; IDs que não são números viram null e o $max os ignora.
+	numericID := bson.D{{Key: "$convert", Value: bson.D{
+		{Key: "input", Value: "$id"},
+		{Key: "to", Value: "long"},
+		{Key: "onError", Value: nil},
+		{Key: "onNull", Value: nil},
+	}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "max", Value: bson.D{{Key: "$max", Value: numericID}}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Max *int64 `bson:"max"`
+	}
+	if !cursor.Next(ctx) {
+		// Collection vazia: nenhum grupo é retornado.
+		return 0, cursor.Err()
+	}
+	if err := cursor.Decode(&result); err != nil {
+		return 0, err
+	}
+	if result.Max == nil {
+		return 0, nil
+	}
+	return int(*result.Max), nil
 }
@@ -0,0 +1,66 @@
+//go:build integration
+
+// Local: movies-service/database/mongo-repository_test.go
+
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database"
+	"github.com/alenrique/Movies-microservices/movies-service/database/repotest"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// TestMongoMovieRepository_Conformance roda o contrato comum dos repositórios contra o
+// MongoDB, com um banco temporário para cada teste da suíte.
+func TestMongoMovieRepository_Conformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) service.MovieRepository {
+		repo, err := database.NewMongoMovieRepository(context.Background(), newTestDatabase(t))
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestMongoCollectionRepository_Conformance roda o contrato das coleções contra o MongoDB.
+func TestMongoCollectionRepository_Conformance(t *testing.T) {
+	repotest.RunCollections(t, func(t *testing.T) service.CollectionRepository {
+		repo, err := database.NewMongoCollectionRepository(context.Background(), newTestDatabase(t))
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestMongoPersonRepository_Conformance roda o contrato das pessoas contra o MongoDB.
+func TestMongoPersonRepository_Conformance(t *testing.T) {
+	repotest.RunPeople(t, func(t *testing.T) service.PersonRepository {
+		repo, err := database.NewMongoPersonRepository(context.Background(), newTestDatabase(t))
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestMongoWatchlistRepository_Conformance roda o contrato das listas de interesse contra o MongoDB.
+func TestMongoWatchlistRepository_Conformance(t *testing.T) {
+	repotest.RunWatchlist(t, func(t *testing.T) service.WatchlistRepository {
+		repo, err := database.NewMongoWatchlistRepository(context.Background(), newTestDatabase(t))
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestMongoMigrationRepository_Conformance roda o contrato do registro de migrações contra o MongoDB.
+func TestMongoMigrationRepository_Conformance(t *testing.T) {
+	repotest.RunMigrations(t, func(t *testing.T) service.MigrationRepository {
+		return database.NewMongoMigrationRepository(newTestDatabase(t))
+	})
+}
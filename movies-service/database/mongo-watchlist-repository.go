@@ -0,0 +1,93 @@
+// Local: movies-service/database/mongo-watchlist-repository.go
+
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// mongoWatchlistRepository guarda cada filme da lista de um usuário como um documento da
+// collection "watchlist" ({user_id, movie_id, added_at}).
+type mongoWatchlistRepository struct {
+	documents *mongo.Collection
+}
+
+// NewMongoWatchlistRepository cria o repositório das listas de interesse e os seus índices.
+func NewMongoWatchlistRepository(ctx context.Context, db *mongo.Database) (service.WatchlistRepository, error) {
+	repo := &mongoWatchlistRepository{documents: db.Collection("watchlist")}
+	_, err := repo.documents.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Um filme aparece uma única vez na lista de cada usuário.
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "movie_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Segue a ordem da listagem (ver service.WatchlistQuery).
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "added_at", Value: -1}, {Key: "movie_id", Value: -1}}},
+		// Encontra as listas de um filme quando ele é apagado.
+		{Keys: bson.D{{Key: "movie_id", Value: 1}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// Add usa um upsert com $setOnInsert: a data só é gravada quando o documento é criado,
+// então incluir de novo o mesmo filme devolve a entrada original.
+func (r *mongoWatchlistRepository) Add(ctx context.Context, entry *service.WatchlistEntry) (*service.WatchlistEntry, error) {
+	var stored service.WatchlistEntry
+	err := r.documents.FindOneAndUpdate(ctx,
+		bson.M{"user_id": entry.UserID, "movie_id": entry.MovieID},
+		bson.M{"$setOnInsert": bson.M{"added_at": entry.AddedAt}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&stored)
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// Remove tira o filme da lista. Retorna false se ele não estava nela.
+func (r *mongoWatchlistRepository) Remove(ctx context.Context, userID, movieID string) (bool, error) {
+	result, err := r.documents.DeleteOne(ctx, bson.M{"user_id": userID, "movie_id": movieID})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// List filtra pelo cursor com um $or (data menor, ou mesma data e ID de filme menor),
+// que segue a ordem decrescente do índice.
+func (r *mongoWatchlistRepository) List(ctx context.Context, query service.WatchlistQuery) ([]*service.WatchlistEntry, error) {
+	filter := bson.M{"user_id": query.UserID}
+	if after := query.After; after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"added_at": bson.M{"$lt": after.AddedAt}},
+			bson.M{"added_at": after.AddedAt, "movie_id": bson.M{"$lt": after.MovieID}},
+		}
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "added_at", Value: -1}, {Key: "movie_id", Value: -1}}).
+		SetLimit(int64(query.Limit))
+	cursor, err := r.documents.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*service.WatchlistEntry, 0)
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// RemoveMovies tira os filmes das listas de todos os usuários com um único DeleteMany.
+func (r *mongoWatchlistRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return ctx.Err()
+	}
+	_, err := r.documents.DeleteMany(ctx, bson.M{"movie_id": bson.M{"$in": movieIDs}})
+	return err
+}
@@ -0,0 +1,224 @@
+// Local: movies-service/database/repotest/collections.go
+
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// CollectionFactory cria um repositório de coleções novo e vazio para um teste.
+type CollectionFactory func(t *testing.T) service.CollectionRepository
+
+// RunCollections executa o contrato de service.CollectionRepository, cada teste com um
+// repositório novo.
+func RunCollections(t *testing.T, newRepo CollectionFactory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo service.CollectionRepository)
+	}{
+		{"SaveAndFindByID", testCollectionSaveAndFindByID},
+		{"SaveRejectsDuplicateID", testCollectionSaveRejectsDuplicateID},
+		{"FindAllOrderedByID", testCollectionFindAllOrderedByID},
+		{"UpdateChecksVersion", testCollectionUpdateChecksVersion},
+		{"DeleteByID", testCollectionDeleteByID},
+		{"RemoveMovies", testCollectionRemoveMovies},
+		{"CanceledContext", testCollectionCanceledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+func saveCollection(t *testing.T, repo service.CollectionRepository, collections ...*service.Collection) {
+	t.Helper()
+	for _, collection := range collections {
+		if err := repo.Save(context.Background(), collection); err != nil {
+			t.Fatalf("Erro inesperado ao salvar a coleção '%s': %v", collection.ID, err)
+		}
+	}
+}
+
+// findCollection busca uma coleção que precisa existir.
+func findCollection(t *testing.T, repo service.CollectionRepository, id string) *service.Collection {
+	t.Helper()
+	collection, err := repo.FindByID(context.Background(), id)
+	if err != nil || collection == nil {
+		t.Fatalf("Esperava encontrar a coleção '%s', mas obteve (%+v, %v)", id, collection, err)
+	}
+	return collection
+}
+
+// testCollectionSaveAndFindByID: a coleção volta com a ordem dos filmes, na versão 1, e a
+// cópia retornada não compartilha memória com o que está guardado.
+func testCollectionSaveAndFindByID(t *testing.T, repo service.CollectionRepository) {
+	ctx := context.Background()
+	collection := &service.Collection{ID: "c1", Name: "Matrix", Description: "A trilogia", MovieIDs: []string{"3", "1", "2"}}
+	saveCollection(t, repo, collection)
+	collection.MovieIDs[0] = "alterado depois de salvar"
+
+	found := findCollection(t, repo, "c1")
+	if found.Name != "Matrix" || found.Description != "A trilogia" || found.Version != 1 ||
+		fmt.Sprint(found.MovieIDs) != "[3 1 2]" {
+		t.Fatalf("Esperava a coleção salva na versão 1, mas obteve %+v", found)
+	}
+
+	found.MovieIDs[0] = "alterado depois de buscar"
+	if again := findCollection(t, repo, "c1"); fmt.Sprint(again.MovieIDs) != "[3 1 2]" {
+		t.Errorf("Alterar a coleção retornada não deveria mudar o repositório, mas obteve %+v", again)
+	}
+
+	saveCollection(t, repo, &service.Collection{ID: "c2", Name: "Vazia"})
+	if empty := findCollection(t, repo, "c2"); len(empty.MovieIDs) != 0 {
+		t.Errorf("Esperava uma coleção sem filmes, mas obteve %+v", empty)
+	}
+	if missing, err := repo.FindByID(ctx, "c3"); missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) para uma coleção inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+}
+
+// testCollectionSaveRejectsDuplicateID: um ID repetido é um erro da categoria ErrConflict.
+func testCollectionSaveRejectsDuplicateID(t *testing.T, repo service.CollectionRepository) {
+	saveCollection(t, repo, &service.Collection{ID: "c1", Name: "Original"})
+
+	err := repo.Save(context.Background(), &service.Collection{ID: "c1", Name: "Duplicada"})
+	if !errors.Is(err, service.ErrConflict) {
+		t.Fatalf("Esperava um erro da categoria ErrConflict, mas obteve %v", err)
+	}
+	if found := findCollection(t, repo, "c1"); found.Name != "Original" {
+		t.Errorf("Esperava que a coleção original fosse mantida, mas obteve %+v", found)
+	}
+}
+
+// testCollectionFindAllOrderedByID: FindAll retorna todas as coleções em ordem de ID.
+func testCollectionFindAllOrderedByID(t *testing.T, repo service.CollectionRepository) {
+	ctx := context.Background()
+	if collections, err := repo.FindAll(ctx); err != nil || len(collections) != 0 {
+		t.Fatalf("Esperava uma lista vazia, mas obteve %v (erro: %v)", collections, err)
+	}
+
+	for _, id := range []string{"b", "c", "a"} {
+		saveCollection(t, repo, &service.Collection{ID: id, Name: "Coleção " + id})
+	}
+	collections, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	got := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		got = append(got, collection.ID)
+	}
+	if fmt.Sprint(got) != "[a b c]" {
+		t.Errorf("Esperava os IDs [a b c], mas obteve %v", got)
+	}
+}
+
+// testCollectionUpdateChecksVersion: Update só grava sobre a versão lida e avança a versão.
+func testCollectionUpdateChecksVersion(t *testing.T, repo service.CollectionRepository) {
+	ctx := context.Background()
+	saveCollection(t, repo, &service.Collection{ID: "c1", Name: "Matrix", MovieIDs: []string{"1"}})
+
+	stale := findCollection(t, repo, "c1")
+	edit := findCollection(t, repo, "c1")
+	edit.Name, edit.MovieIDs = "The Matrix", []string{"1", "2"}
+	updated, err := repo.Update(ctx, edit)
+	if err != nil || updated == nil || updated.Version != 2 || updated.Name != "The Matrix" {
+		t.Fatalf("Esperava a coleção na versão 2, mas obteve (%+v, %v)", updated, err)
+	}
+	if found := findCollection(t, repo, "c1"); found.Version != 2 || fmt.Sprint(found.MovieIDs) != "[1 2]" {
+		t.Errorf("Esperava que a edição fosse persistida, mas obteve %+v", found)
+	}
+
+	// Uma alteração feita sobre a versão antiga não pode sobrescrever a nova.
+	stale.Name = "Sobrescrita"
+	if _, err := repo.Update(ctx, stale); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict ao gravar uma versão antiga, mas obteve %v", err)
+	}
+	if found := findCollection(t, repo, "c1"); found.Name != "The Matrix" {
+		t.Errorf("A versão antiga não deveria ter sido gravada, mas obteve %+v", found)
+	}
+
+	missing, err := repo.Update(ctx, &service.Collection{ID: "c2", Name: "Fantasma", Version: 1})
+	if missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) ao editar uma coleção inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+}
+
+// testCollectionDeleteByID: informa se a coleção existia.
+func testCollectionDeleteByID(t *testing.T, repo service.CollectionRepository) {
+	ctx := context.Background()
+	saveCollection(t, repo, &service.Collection{ID: "c1", Name: "Matrix"})
+
+	if deleted, err := repo.DeleteByID(ctx, "c1"); err != nil || !deleted {
+		t.Fatalf("Esperava (true, nil), mas obteve (%v, %v)", deleted, err)
+	}
+	if deleted, err := repo.DeleteByID(ctx, "c1"); err != nil || deleted {
+		t.Errorf("Esperava (false, nil) ao apagar de novo, mas obteve (%v, %v)", deleted, err)
+	}
+	if found, _ := repo.FindByID(ctx, "c1"); found != nil {
+		t.Errorf("Esperava que a coleção fosse apagada, mas obteve %+v", found)
+	}
+}
+
+// testCollectionRemoveMovies: os filmes saem de todas as coleções, a ordem dos outros é
+// mantida e só as coleções alteradas mudam de versão.
+func testCollectionRemoveMovies(t *testing.T, repo service.CollectionRepository) {
+	ctx := context.Background()
+	saveCollection(t, repo,
+		&service.Collection{ID: "c1", Name: "Um", MovieIDs: []string{"1", "2", "3", "4"}},
+		&service.Collection{ID: "c2", Name: "Dois", MovieIDs: []string{"4", "5"}},
+		&service.Collection{ID: "c3", Name: "Três", MovieIDs: []string{"5"}},
+	)
+
+	if err := repo.RemoveMovies(ctx, []string{"2", "4", "42"}); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	want := map[string]struct {
+		movies  string
+		version int64
+	}{
+		"c1": {"[1 3]", 2},
+		"c2": {"[5]", 2},
+		"c3": {"[5]", 1},
+	}
+	for id, expected := range want {
+		found := findCollection(t, repo, id)
+		if fmt.Sprint(found.MovieIDs) != expected.movies || found.Version != expected.version {
+			t.Errorf("Coleção %s: esperava os filmes %s na versão %d, mas obteve %v na versão %d",
+				id, expected.movies, expected.version, found.MovieIDs, found.Version)
+		}
+	}
+}
+
+// testCollectionCanceledContext: todas as operações respeitam o contexto cancelado.
+func testCollectionCanceledContext(t *testing.T, repo service.CollectionRepository) {
+	saveCollection(t, repo, &service.Collection{ID: "c1", Name: "Matrix", MovieIDs: []string{"1"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.Save(ctx, &service.Collection{ID: "c2", Name: "Nova"}); err == nil {
+		t.Error("Save: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByID(ctx, "c1"); err == nil {
+		t.Error("FindByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindAll(ctx); err == nil {
+		t.Error("FindAll: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Update(ctx, &service.Collection{ID: "c1", Name: "Editada", Version: 1}); err == nil {
+		t.Error("Update: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.DeleteByID(ctx, "c1"); err == nil {
+		t.Error("DeleteByID: esperava um erro com o contexto cancelado")
+	}
+	if err := repo.RemoveMovies(ctx, []string{"1"}); err == nil {
+		t.Error("RemoveMovies: esperava um erro com o contexto cancelado")
+	}
+}
@@ -0,0 +1,63 @@
+// Local: movies-service/database/repotest/migrations.go
+
+package repotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// MigrationFactory cria um registro de migrações novo e vazio para um teste.
+type MigrationFactory func(t *testing.T) service.MigrationRepository
+
+// RunMigrations executa o contrato de service.MigrationRepository, cada teste com um
+// registro novo.
+func RunMigrations(t *testing.T, newRepo MigrationFactory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo service.MigrationRepository)
+	}{
+		{"MarkApplied", testMigrationMarkApplied},
+		{"CanceledContext", testMigrationCanceledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+// testMigrationMarkApplied: só a migração registrada aparece como concluída, e registrá-la
+// de novo não é um erro.
+func testMigrationMarkApplied(t *testing.T, repo service.MigrationRepository) {
+	ctx := context.Background()
+	if applied, err := repo.IsApplied(ctx, "directors_to_credits_v1"); err != nil || applied {
+		t.Fatalf("Esperava a migração pendente, mas obteve (%v, %v)", applied, err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := repo.MarkApplied(ctx, "directors_to_credits_v1"); err != nil {
+			t.Fatalf("Erro inesperado ao registrar a migração (vez %d): %v", i+1, err)
+		}
+	}
+	if applied, err := repo.IsApplied(ctx, "directors_to_credits_v1"); err != nil || !applied {
+		t.Errorf("Esperava a migração concluída, mas obteve (%v, %v)", applied, err)
+	}
+	if applied, err := repo.IsApplied(ctx, "directors_to_credits_v2"); err != nil || applied {
+		t.Errorf("Esperava a outra versão pendente, mas obteve (%v, %v)", applied, err)
+	}
+}
+
+// testMigrationCanceledContext: as duas operações respeitam o contexto cancelado.
+func testMigrationCanceledContext(t *testing.T, repo service.MigrationRepository) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := repo.IsApplied(ctx, "directors_to_credits_v1"); err == nil {
+		t.Error("IsApplied: esperava um erro com o contexto cancelado")
+	}
+	if err := repo.MarkApplied(ctx, "directors_to_credits_v1"); err == nil {
+		t.Error("MarkApplied: esperava um erro com o contexto cancelado")
+	}
+}
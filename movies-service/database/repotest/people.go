@@ -0,0 +1,229 @@
+// Local: movies-service/database/repotest/people.go
+
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// PersonFactory cria um repositório de pessoas novo e vazio para um teste.
+type PersonFactory func(t *testing.T) service.PersonRepository
+
+// RunPeople executa o contrato de service.PersonRepository, cada teste com um repositório novo.
+func RunPeople(t *testing.T, newRepo PersonFactory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo service.PersonRepository)
+	}{
+		{"SaveAndFind", testPersonSaveAndFind},
+		{"SaveRejectsDuplicateID", testPersonSaveRejectsDuplicateID},
+		{"FindByName", testPersonFindByName},
+		{"FindOrCreateByName", testPersonFindOrCreateByName},
+		{"ConcurrentFindOrCreateByName", testPersonConcurrentFindOrCreateByName},
+		{"FindAllOrderedByName", testPersonFindAllOrderedByName},
+		{"UpdateAndDelete", testPersonUpdateAndDelete},
+		{"CanceledContext", testPersonCanceledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+func savePeople(t *testing.T, repo service.PersonRepository, people ...*service.Person) {
+	t.Helper()
+	for _, person := range people {
+		if err := repo.Save(context.Background(), person); err != nil {
+			t.Fatalf("Erro inesperado ao salvar a pessoa '%s': %v", person.ID, err)
+		}
+	}
+}
+
+// personIDs retorna os IDs das pessoas, na ordem recebida.
+func personIDs(people []*service.Person) string {
+	ids := make([]string, len(people))
+	for i, person := range people {
+		ids[i] = person.ID
+	}
+	return fmt.Sprint(ids)
+}
+
+// testPersonSaveAndFind: a pessoa salva é encontrada por ID e em lote; IDs inexistentes
+// resultam em (nil, nil) ou são ignorados.
+func testPersonSaveAndFind(t *testing.T, repo service.PersonRepository) {
+	ctx := context.Background()
+	savePeople(t, repo, &service.Person{ID: "p2", Name: "Ridley Scott"}, &service.Person{ID: "p1", Name: "Sigourney Weaver"})
+
+	found, err := repo.FindByID(ctx, "p2")
+	if err != nil || found == nil || *found != (service.Person{ID: "p2", Name: "Ridley Scott"}) {
+		t.Errorf("Esperava encontrar Ridley Scott, mas obteve (%+v, %v)", found, err)
+	}
+	if missing, err := repo.FindByID(ctx, "p9"); missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) para uma pessoa inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+	batch, err := repo.FindByIDs(ctx, []string{"p9", "p2", "p1"})
+	if err != nil || personIDs(batch) != "[p1 p2]" {
+		t.Errorf("Esperava [p1 p2] ordenados por ID, mas obteve %s (erro: %v)", personIDs(batch), err)
+	}
+}
+
+// testPersonSaveRejectsDuplicateID: um ID repetido é um erro da categoria ErrConflict.
+func testPersonSaveRejectsDuplicateID(t *testing.T, repo service.PersonRepository) {
+	savePeople(t, repo, &service.Person{ID: "p1", Name: "Ridley Scott"})
+	err := repo.Save(context.Background(), &service.Person{ID: "p1", Name: "Outra pessoa"})
+	if !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict, mas recebeu %v", err)
+	}
+}
+
+// testPersonFindByName: o nome precisa ser exatamente igual, e entre homônimos vence o menor ID.
+func testPersonFindByName(t *testing.T, repo service.PersonRepository) {
+	ctx := context.Background()
+	savePeople(t, repo,
+		&service.Person{ID: "p3", Name: "Christopher Nolan"},
+		&service.Person{ID: "p2", Name: "Christopher Nolan"},
+		&service.Person{ID: "p1", Name: "Nolan"},
+	)
+
+	found, err := repo.FindByName(ctx, "Christopher Nolan")
+	if err != nil || found == nil || found.ID != "p2" {
+		t.Errorf("Esperava p2, mas obteve (%+v, %v)", found, err)
+	}
+	if missing, err := repo.FindByName(ctx, "christopher nolan"); missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) para um nome diferente, mas obteve (%+v, %v)", missing, err)
+	}
+}
+
+// testPersonFindOrCreateByName: entre as pessoas com o nome vence a de menor ID, e só um
+// nome novo insere a pessoa recebida.
+func testPersonFindOrCreateByName(t *testing.T, repo service.PersonRepository) {
+	ctx := context.Background()
+	savePeople(t, repo, &service.Person{ID: "p3", Name: "Ridley Scott"}, &service.Person{ID: "p2", Name: "Ridley Scott"})
+
+	found, created, err := repo.FindOrCreateByName(ctx, &service.Person{ID: "p8", Name: "Ridley Scott"})
+	if err != nil || created || found == nil || found.ID != "p2" {
+		t.Errorf("Esperava a pessoa p2 já existente, mas obteve (%+v, %v, %v)", found, created, err)
+	}
+	found, created, err = repo.FindOrCreateByName(ctx, &service.Person{ID: "p9", Name: "James Cameron"})
+	if err != nil || !created || found == nil || *found != (service.Person{ID: "p9", Name: "James Cameron"}) {
+		t.Fatalf("Esperava criar a pessoa p9, mas obteve (%+v, %v, %v)", found, created, err)
+	}
+	if stored, _ := repo.FindByID(ctx, "p9"); stored == nil || stored.Name != "James Cameron" {
+		t.Errorf("Esperava a pessoa p9 gravada, mas obteve %+v", stored)
+	}
+	if _, _, err := repo.FindOrCreateByName(ctx, &service.Person{ID: "p9", Name: "Outro nome"}); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict com um ID repetido, mas recebeu %v", err)
+	}
+}
+
+// testPersonConcurrentFindOrCreateByName: chamadas simultâneas com o mesmo nome (como as
+// de várias instâncias migrando os diretores ao mesmo tempo) criam uma única pessoa.
+func testPersonConcurrentFindOrCreateByName(t *testing.T, repo service.PersonRepository) {
+	ctx := context.Background()
+	const attempts = 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	returned := make(map[string]bool)
+	created := 0
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			person, ok, err := repo.FindOrCreateByName(ctx, &service.Person{ID: "p" + strconv.Itoa(i), Name: "Ridley Scott"})
+			if err != nil {
+				t.Errorf("Erro inesperado: %v", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			returned[person.ID] = true
+			if ok {
+				created++
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	all, err := repo.FindAll(ctx)
+	if err != nil || len(all) != 1 || created != 1 || len(returned) != 1 {
+		t.Errorf("Esperava uma única pessoa criada e devolvida a todos, mas obteve %s (criadas: %d, devolvidas: %d, erro: %v)",
+			personIDs(all), created, len(returned), err)
+	}
+}
+
+// testPersonFindAllOrderedByName: a listagem vem em ordem de nome e, no mesmo nome, de ID.
+func testPersonFindAllOrderedByName(t *testing.T, repo service.PersonRepository) {
+	savePeople(t, repo,
+		&service.Person{ID: "p1", Name: "Sigourney Weaver"},
+		&service.Person{ID: "p3", Name: "James Cameron"},
+		&service.Person{ID: "p2", Name: "James Cameron"},
+	)
+	all, err := repo.FindAll(context.Background())
+	if err != nil || personIDs(all) != "[p2 p3 p1]" {
+		t.Errorf("Esperava [p2 p3 p1], mas obteve %s (erro: %v)", personIDs(all), err)
+	}
+}
+
+// testPersonUpdateAndDelete: Update e DeleteByID informam quando a pessoa não existe.
+func testPersonUpdateAndDelete(t *testing.T, repo service.PersonRepository) {
+	ctx := context.Background()
+	savePeople(t, repo, &service.Person{ID: "p1", Name: "Nolan"})
+
+	updated, err := repo.Update(ctx, &service.Person{ID: "p1", Name: "Christopher Nolan"})
+	if err != nil || updated == nil || updated.Name != "Christopher Nolan" {
+		t.Fatalf("Esperava o nome novo, mas obteve (%+v, %v)", updated, err)
+	}
+	if found, _ := repo.FindByID(ctx, "p1"); found == nil || found.Name != "Christopher Nolan" {
+		t.Errorf("Esperava o nome novo gravado, mas obteve %+v", found)
+	}
+	if missing, err := repo.Update(ctx, &service.Person{ID: "p9", Name: "Ninguém"}); missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) ao alterar uma pessoa inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+
+	if deleted, err := repo.DeleteByID(ctx, "p1"); err != nil || !deleted {
+		t.Errorf("Esperava apagar a pessoa, mas obteve (%v, %v)", deleted, err)
+	}
+	if deleted, err := repo.DeleteByID(ctx, "p1"); err != nil || deleted {
+		t.Errorf("Esperava false ao apagar de novo, mas obteve (%v, %v)", deleted, err)
+	}
+}
+
+// testPersonCanceledContext: todas as operações respeitam o contexto cancelado.
+func testPersonCanceledContext(t *testing.T, repo service.PersonRepository) {
+	savePeople(t, repo, &service.Person{ID: "p1", Name: "Nolan"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.Save(ctx, &service.Person{ID: "p2", Name: "Nova"}); err == nil {
+		t.Error("Save: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByID(ctx, "p1"); err == nil {
+		t.Error("FindByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByIDs(ctx, []string{"p1"}); err == nil {
+		t.Error("FindByIDs: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByName(ctx, "Nolan"); err == nil {
+		t.Error("FindByName: esperava um erro com o contexto cancelado")
+	}
+	if _, _, err := repo.FindOrCreateByName(ctx, &service.Person{ID: "p3", Name: "Outra"}); err == nil {
+		t.Error("FindOrCreateByName: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindAll(ctx); err == nil {
+		t.Error("FindAll: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Update(ctx, &service.Person{ID: "p1", Name: "Editada"}); err == nil {
+		t.Error("Update: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.DeleteByID(ctx, "p1"); err == nil {
+		t.Error("DeleteByID: esperava um erro com o contexto cancelado")
+	}
+}
@@ -0,0 +1,881 @@
+// Local: movies-service/database/repotest/repotest.go
+
+// Package repotest é a suíte de conformidade dos adaptadores de MovieRepository.
+// Cada adaptador (MongoDB, memória, SQLite...) roda a mesma suíte no seu próprio teste,
+// então uma diferença de comportamento entre eles aparece como um teste quebrado:
+//
+//	func TestMovieRepository_Conformance(t *testing.T) {
+//		repotest.Run(t, func(t *testing.T) service.MovieRepository {
+//			return memory.NewMovieRepository()
+//		})
+//	}
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// Factory cria um repositório novo e vazio para um teste. Recursos externos (arquivos,
+// bancos temporários) devem ser liberados com t.Cleanup.
+type Factory func(t *testing.T) service.MovieRepository
+
+// Run executa todos os testes do contrato, cada um com um repositório novo.
+func Run(t *testing.T, newRepo Factory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo service.MovieRepository)
+	}{
+		{"SaveAndFindByID", testSaveAndFindByID},
+		{"FindByIDNotFound", testFindByIDNotFound},
+		{"SaveRejectsDuplicateID", testSaveRejectsDuplicateID},
+		{"FindAllOrderedByID", testFindAllOrderedByID},
+		{"SaveMany", testSaveMany},
+		{"FindByIDs", testFindByIDs},
+		{"DeleteByIDs", testDeleteByIDs},
+		{"SoftDeleteByIDs", testSoftDeleteByIDs},
+		{"Update", testUpdate},
+		{"Patch", testPatch},
+		{"SetCredits", testSetCredits},
+		{"DeleteByID", testDeleteByID},
+		{"SoftDeleteHidesMovie", testSoftDeleteHidesMovie},
+		{"IncludeDeleted", testIncludeDeleted},
+		{"Restore", testRestore},
+		{"PurgeDeleted", testPurgeDeleted},
+		{"FindMaxID", testFindMaxID},
+		{"FindPageAndCount", testFindPageAndCount},
+		{"Stream", testStream},
+		{"FilterByPerson", testFilterByPerson},
+		{"Search", testSearch},
+		{"ConcurrentSaves", testConcurrentSaves},
+		{"ConcurrentDuplicateSaves", testConcurrentDuplicateSaves},
+		{"CanceledContext", testCanceledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+// --- Auxiliares ---
+
+func save(t *testing.T, repo service.MovieRepository, movies ...*service.Movie) {
+	t.Helper()
+	for _, movie := range movies {
+		if err := repo.Save(context.Background(), movie); err != nil {
+			t.Fatalf("Erro inesperado ao salvar o filme '%s': %v", movie.ID, err)
+		}
+	}
+}
+
+func ids(movies []*service.Movie) []string {
+	result := make([]string, 0, len(movies))
+	for _, movie := range movies {
+		result = append(result, movie.ID)
+	}
+	return result
+}
+
+func sameIDs(got []*service.Movie, want ...string) bool {
+	return fmt.Sprint(ids(got)) == fmt.Sprint(want)
+}
+
+// sameMovie compara um filme retornado pelo repositório com o esperado. Uma lista vazia
+// e uma lista nil são equivalentes, pois cada banco representa a ausência de um jeito.
+func sameMovie(got *service.Movie, want service.Movie) bool {
+	if got == nil {
+		return false
+	}
+	normalize := func(movie service.Movie) service.Movie {
+		if len(movie.Genres) == 0 {
+			movie.Genres = nil
+		}
+		if len(movie.Cast) == 0 {
+			movie.Cast = nil
+		}
+		if len(movie.Credits) == 0 {
+			movie.Credits = nil
+		}
+		return movie
+	}
+	return reflect.DeepEqual(normalize(*got), normalize(want))
+}
+
+// fullMovie é um filme com todos os campos preenchidos.
+func fullMovie(id string) service.Movie {
+	return service.Movie{
+		ID:             id,
+		Title:          "Alien",
+		Director:       "Ridley Scott",
+		Year:           1979,
+		Genres:         []string{"Terror", "Ficção Científica"},
+		RuntimeMinutes: 117,
+		Synopsis:       "A tripulação da Nostromo recebe um sinal de socorro.",
+		Cast: []service.CastMember{
+			{Name: "Sigourney Weaver", Role: "Ripley"},
+			{Name: "Tom Skerritt", Role: "Dallas"},
+		},
+		OriginalLanguage: "en",
+		AgeRating:        "R",
+		ExternalIDs:      service.ExternalIDs{IMDbID: "tt0078748", TMDBID: 348},
+		Credits: []service.Credit{
+			{PersonID: "p1", Role: service.RoleDirector},
+			{PersonID: "p2", Role: service.RoleActor, Character: "Ripley"},
+		},
+	}
+}
+
+// --- Os Testes ---
+
+// testSaveAndFindByID: o filme salvo volta com todos os campos, e a cópia
+// retornada não compartilha memória (nem as listas) com o que está guardado.
+func testSaveAndFindByID(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	want := fullMovie("1")
+	movie := fullMovie("1")
+	save(t, repo, &movie)
+	movie.Title = "Alterado depois de salvar"
+	movie.Genres[0] = "Alterado depois de salvar"
+
+	found, err := repo.FindByID(ctx, "1")
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if !sameMovie(found, want) {
+		t.Fatalf("Esperava %+v, mas obteve %+v", want, found)
+	}
+
+	found.Title = "Alterado depois de buscar"
+	found.Cast[0].Name = "Alterado depois de buscar"
+	again, _ := repo.FindByID(ctx, "1")
+	if !sameMovie(again, want) {
+		t.Errorf("Alterar o filme retornado não deveria mudar o repositório, mas obteve %+v", again)
+	}
+
+	// Um filme só com os campos obrigatórios volta sem os opcionais.
+	save(t, repo, &service.Movie{ID: "2", Title: "Sem detalhes"})
+	minimal, _ := repo.FindByID(ctx, "2")
+	if !sameMovie(minimal, service.Movie{ID: "2", Title: "Sem detalhes"}) {
+		t.Errorf("Esperava um filme sem os campos opcionais, mas obteve %+v", minimal)
+	}
+}
+
+// testFindByIDNotFound: um ID inexistente retorna (nil, nil), e não um erro.
+func testFindByIDNotFound(t *testing.T, repo service.MovieRepository) {
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien"})
+
+	found, err := repo.FindByID(context.Background(), "2")
+	if found != nil || err != nil {
+		t.Errorf("Esperava (nil, nil), mas obteve (%+v, %v)", found, err)
+	}
+}
+
+// testSaveRejectsDuplicateID: Save nunca sobrescreve um filme existente e
+// informa o ID repetido com um erro da categoria service.ErrConflict.
+func testSaveRejectsDuplicateID(t *testing.T, repo service.MovieRepository) {
+	save(t, repo, &service.Movie{ID: "1", Title: "Original"})
+
+	err := repo.Save(context.Background(), &service.Movie{ID: "1", Title: "Duplicado"})
+	if !errors.Is(err, service.ErrConflict) {
+		t.Fatalf("Esperava um erro da categoria ErrConflict ao salvar um ID repetido, mas obteve %v", err)
+	}
+	found, _ := repo.FindByID(context.Background(), "1")
+	if found == nil || found.Title != "Original" {
+		t.Errorf("Esperava que o filme original fosse mantido, mas obteve %+v", found)
+	}
+}
+
+// testFindAllOrderedByID: FindAll retorna todos os filmes em ordem de ID
+// (comparação de strings, byte a byte) e uma lista vazia para o repositório vazio.
+func testFindAllOrderedByID(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	movies, err := repo.FindAll(ctx)
+	if err != nil || len(movies) != 0 {
+		t.Fatalf("Esperava uma lista vazia, mas obteve %v (erro: %v)", ids(movies), err)
+	}
+
+	for _, id := range []string{"3", "10", "1", "b", "2", "A"} {
+		save(t, repo, &service.Movie{ID: id, Title: "Filme " + id})
+	}
+	movies, err = repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if !sameIDs(movies, "1", "10", "2", "3", "A", "b") {
+		t.Errorf("Esperava os IDs [1 10 2 3 A b], mas obteve %v", ids(movies))
+	}
+}
+
+// testUpdate: Update substitui o filme inteiro e retorna (nil, nil) se ele não existir.
+func testUpdate(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien", Director: "Ridley Scott", Year: 1979})
+
+	want := fullMovie("1")
+	want.Title, want.Director, want.Year = "Aliens", "James Cameron", 1986
+	want.Genres = []string{"Ação"}
+	edit := want
+	updated, err := repo.Update(ctx, &edit)
+	if err != nil || !sameMovie(updated, want) {
+		t.Fatalf("Esperava %+v, mas obteve %+v (erro: %v)", want, updated, err)
+	}
+	found, _ := repo.FindByID(ctx, "1")
+	if !sameMovie(found, want) {
+		t.Errorf("Esperava que a edição fosse persistida, mas obteve %+v", found)
+	}
+
+	missing, err := repo.Update(ctx, &service.Movie{ID: "2", Title: "Fantasma"})
+	if missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) ao editar um filme inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+	if found, _ := repo.FindByID(ctx, "2"); found != nil {
+		t.Errorf("Update não deveria criar filmes, mas criou %+v", found)
+	}
+}
+
+// testPatch: só os campos da máscara mudam, inclusive um subcampo dos IDs externos, e um
+// valor vazio limpa o campo.
+func testPatch(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	want := fullMovie("1")
+	movie := want
+	save(t, repo, &movie)
+
+	patch := &service.Movie{Title: "Aliens", Year: 1986, ExternalIDs: service.ExternalIDs{IMDbID: "tt0090605", TMDBID: 679}}
+	patched, err := repo.Patch(ctx, "1", patch, []string{"title", "genres", "external_ids.imdb_id"})
+	want.Title, want.Genres, want.ExternalIDs.IMDbID = "Aliens", nil, "tt0090605"
+	if err != nil || !sameMovie(patched, want) {
+		t.Fatalf("Esperava %+v, mas obteve %+v (erro: %v)", want, patched, err)
+	}
+	if found, _ := repo.FindByID(ctx, "1"); !sameMovie(found, want) {
+		t.Errorf("Esperava que só os campos da máscara fossem gravados, mas obteve %+v", found)
+	}
+
+	patched, err = repo.Patch(ctx, "1", patch, []string{"external_ids", "credits"})
+	want.ExternalIDs, want.Credits = patch.ExternalIDs, nil
+	if err != nil || !sameMovie(patched, want) {
+		t.Errorf("Esperava %+v, mas obteve %+v (erro: %v)", want, patched, err)
+	}
+
+	if missing, err := repo.Patch(ctx, "2", patch, []string{"title"}); missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) para um filme inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+	if _, err := repo.SoftDeleteByID(ctx, "1", time.Now()); err != nil {
+		t.Fatalf("Erro inesperado ao excluir o filme: %v", err)
+	}
+	if deleted, err := repo.Patch(ctx, "1", patch, []string{"title"}); deleted != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) para um filme excluído, mas obteve (%+v, %v)", deleted, err)
+	}
+}
+
+// testSetCredits: os créditos só são trocados se ainda forem os esperados, e os outros
+// campos do filme não são tocados.
+func testSetCredits(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	want := fullMovie("1")
+	movie := want
+	save(t, repo, &movie, &service.Movie{ID: "2", Title: "Aliens"})
+	director := []service.Credit{{PersonID: "p3", Role: service.RoleDirector}}
+
+	if updated, err := repo.SetCredits(ctx, "1", want.Credits[:1], director); err != nil || updated {
+		t.Errorf("Esperava false com créditos diferentes dos esperados, mas obteve (%v, %v)", updated, err)
+	}
+	if updated, err := repo.SetCredits(ctx, "1", want.Credits, director); err != nil || !updated {
+		t.Fatalf("Esperava trocar os créditos, mas obteve (%v, %v)", updated, err)
+	}
+	want.Credits = director
+	if found, _ := repo.FindByID(ctx, "1"); !sameMovie(found, want) {
+		t.Errorf("Esperava só os créditos trocados, mas obteve %+v", found)
+	}
+
+	// Um filme sem créditos é comparado com uma lista vazia (ou nil).
+	if updated, err := repo.SetCredits(ctx, "2", []service.Credit{}, director); err != nil || !updated {
+		t.Errorf("Esperava trocar os créditos vazios, mas obteve (%v, %v)", updated, err)
+	}
+	if updated, err := repo.SetCredits(ctx, "9", nil, director); err != nil || updated {
+		t.Errorf("Esperava false para um filme inexistente, mas obteve (%v, %v)", updated, err)
+	}
+	if _, err := repo.SoftDeleteByID(ctx, "2", time.Now()); err != nil {
+		t.Fatalf("Erro inesperado ao excluir o filme: %v", err)
+	}
+	if updated, err := repo.SetCredits(ctx, "2", director, nil); err != nil || updated {
+		t.Errorf("Esperava false para um filme excluído, mas obteve (%v, %v)", updated, err)
+	}
+}
+
+// testDeleteByID: remove apenas o filme pedido e informa se ele existia.
+func testDeleteByID(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien"}, &service.Movie{ID: "2", Title: "Aliens"})
+
+	deleted, err := repo.DeleteByID(ctx, "1")
+	if err != nil || !deleted {
+		t.Fatalf("Esperava remover o filme '1', mas obteve %v (erro: %v)", deleted, err)
+	}
+	deleted, err = repo.DeleteByID(ctx, "1")
+	if err != nil || deleted {
+		t.Errorf("Remover um filme que não existe deveria retornar false sem erro, mas obteve %v (erro: %v)", deleted, err)
+	}
+	movies, err := repo.FindAll(ctx)
+	if err != nil || !sameIDs(movies, "2") {
+		t.Errorf("Esperava que restasse apenas o filme '2', mas obteve %v (erro: %v)", ids(movies), err)
+	}
+}
+
+// testSaveMany: cada filme é salvo ou rejeitado sozinho; um ID repetido (já salvo ou
+// repetido dentro do próprio lote) não impede que os outros sejam salvos.
+func testSaveMany(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien"})
+
+	errs, err := repo.SaveMany(ctx, []*service.Movie{
+		{ID: "2", Title: "Aliens"},
+		{ID: "1", Title: "Repetido no banco"},
+		{ID: "3", Title: "Alien 3"},
+		{ID: "3", Title: "Repetido no lote"},
+	})
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if len(errs) != 4 {
+		t.Fatalf("Esperava um erro por filme (4), mas obteve %d", len(errs))
+	}
+	for i, wantConflict := range []bool{false, true, false, true} {
+		if got := errors.Is(errs[i], service.ErrConflict); got != wantConflict || (!wantConflict && errs[i] != nil) {
+			t.Errorf("Filme %d: esperava conflito=%v, mas obteve %v", i, wantConflict, errs[i])
+		}
+	}
+	movies, err := repo.FindAll(ctx)
+	if err != nil || !sameIDs(movies, "1", "2", "3") {
+		t.Fatalf("Esperava os filmes [1 2 3], mas obteve %v (erro: %v)", ids(movies), err)
+	}
+	if movies[0].Title != "Alien" || movies[2].Title != "Alien 3" {
+		t.Errorf("Os filmes rejeitados não deveriam substituir os salvos, mas obteve %+v e %+v", movies[0], movies[2])
+	}
+}
+
+// testFindByIDs: retorna apenas os filmes encontrados, ordenados por ID e sem os excluídos.
+func testFindByIDs(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Alien"},
+		&service.Movie{ID: "2", Title: "Aliens"},
+		&service.Movie{ID: "3", Title: "Alien 3"},
+	)
+	if _, err := repo.SoftDeleteByID(ctx, "3", time.Now()); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	movies, err := repo.FindByIDs(ctx, []string{"2", "99", "1", "3"})
+	if err != nil || !sameIDs(movies, "1", "2") {
+		t.Errorf("Esperava os filmes [1 2], mas obteve %v (erro: %v)", ids(movies), err)
+	}
+	if len(movies) == 2 && movies[1].Title != "Aliens" {
+		t.Errorf("Esperava o título 'Aliens', mas obteve '%s'", movies[1].Title)
+	}
+	movies, err = repo.FindByIDs(ctx, []string{})
+	if err != nil || len(movies) != 0 {
+		t.Errorf("Esperava uma lista vazia para nenhum ID, mas obteve %v (erro: %v)", ids(movies), err)
+	}
+}
+
+// testDeleteByIDs: remove os filmes existentes e retorna apenas os IDs deles.
+func testDeleteByIDs(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Alien"},
+		&service.Movie{ID: "2", Title: "Aliens"},
+		&service.Movie{ID: "3", Title: "Alien 3"},
+	)
+
+	deleted, err := repo.DeleteByIDs(ctx, []string{"3", "99", "1"})
+	sort.Strings(deleted)
+	if err != nil || fmt.Sprint(deleted) != "[1 3]" {
+		t.Errorf("Esperava remover [1 3], mas obteve %v (erro: %v)", deleted, err)
+	}
+	if movies, err := repo.FindAll(ctx); err != nil || !sameIDs(movies, "2") {
+		t.Errorf("Esperava que restasse apenas o filme '2', mas obteve %v (erro: %v)", ids(movies), err)
+	}
+	if deleted, err := repo.DeleteByIDs(ctx, []string{}); err != nil || len(deleted) != 0 {
+		t.Errorf("Esperava nenhum ID removido para uma lista vazia, mas obteve %v (erro: %v)", deleted, err)
+	}
+}
+
+// testSoftDeleteByIDs: esconde os filmes existentes, que continuam restauráveis.
+func testSoftDeleteByIDs(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien"}, &service.Movie{ID: "2", Title: "Aliens"})
+
+	deleted, err := repo.SoftDeleteByIDs(ctx, []string{"1", "99"}, time.Now())
+	if err != nil || fmt.Sprint(deleted) != "[1]" {
+		t.Fatalf("Esperava excluir [1], mas obteve %v (erro: %v)", deleted, err)
+	}
+	if movies, err := repo.FindAll(ctx); err != nil || !sameIDs(movies, "2") {
+		t.Errorf("Esperava que restasse apenas o filme '2', mas obteve %v (erro: %v)", ids(movies), err)
+	}
+	if deleted, err := repo.SoftDeleteByIDs(ctx, []string{"1"}, time.Now()); err != nil || len(deleted) != 0 {
+		t.Errorf("Excluir de novo não deveria retornar IDs, mas obteve %v (erro: %v)", deleted, err)
+	}
+	if restored, err := repo.Restore(ctx, "1"); err != nil || restored == nil {
+		t.Errorf("Esperava restaurar o filme '1', mas obteve (%+v, %v)", restored, err)
+	}
+}
+
+// testSoftDeleteHidesMovie: um filme excluído some de todas as consultas, não pode ser
+// alterado nem excluído de novo, mas o seu ID continua ocupado.
+func testSoftDeleteHidesMovie(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien", Year: 1979}, &service.Movie{ID: "2", Title: "Aliens", Year: 1986})
+
+	deleted, err := repo.SoftDeleteByID(ctx, "2", time.Now())
+	if err != nil || !deleted {
+		t.Fatalf("Esperava excluir o filme '2', mas obteve %v (erro: %v)", deleted, err)
+	}
+
+	if found, err := repo.FindByID(ctx, "2"); err != nil || found != nil {
+		t.Errorf("FindByID: esperava (nil, nil) para o filme excluído, mas obteve (%+v, %v)", found, err)
+	}
+	if movies, err := repo.FindAll(ctx); err != nil || !sameIDs(movies, "1") {
+		t.Errorf("FindAll: esperava apenas o filme '1', mas obteve %v (erro: %v)", ids(movies), err)
+	}
+	page, err := repo.FindPage(ctx, service.MovieQuery{OrderBy: []service.SortField{{Field: "id"}}, Limit: 10})
+	if err != nil || !sameIDs(page, "1") {
+		t.Errorf("FindPage: esperava apenas o filme '1', mas obteve %v (erro: %v)", ids(page), err)
+	}
+	if count, err := repo.Count(ctx, service.MovieFilter{}); err != nil || count != 1 {
+		t.Errorf("Count: esperava 1, mas obteve %d (erro: %v)", count, err)
+	}
+	var streamed []*service.Movie
+	err = repo.Stream(ctx, service.MovieFilter{}, func(movie *service.Movie) error {
+		streamed = append(streamed, movie)
+		return nil
+	})
+	if err != nil || !sameIDs(streamed, "1") {
+		t.Errorf("Stream: esperava apenas o filme '1', mas obteve %v (erro: %v)", ids(streamed), err)
+	}
+	results, err := repo.Search(ctx, service.SearchQuery{Text: "aliens", Limit: 10})
+	if err != nil || len(results) != 0 {
+		t.Errorf("Search: não esperava resultados, mas obteve %d (erro: %v)", len(results), err)
+	}
+	if count, err := repo.CountSearch(ctx, "aliens"); err != nil || count != 0 {
+		t.Errorf("CountSearch: esperava 0, mas obteve %d (erro: %v)", count, err)
+	}
+
+	if updated, err := repo.Update(ctx, &service.Movie{ID: "2", Title: "Aliens 2"}); err != nil || updated != nil {
+		t.Errorf("Update: esperava (nil, nil) para o filme excluído, mas obteve (%+v, %v)", updated, err)
+	}
+	if deleted, err := repo.SoftDeleteByID(ctx, "2", time.Now()); err != nil || deleted {
+		t.Errorf("SoftDeleteByID: excluir de novo deveria retornar false, mas obteve %v (erro: %v)", deleted, err)
+	}
+	if deleted, err := repo.DeleteByID(ctx, "2"); err != nil || deleted {
+		t.Errorf("DeleteByID: esperava false para o filme excluído, mas obteve %v (erro: %v)", deleted, err)
+	}
+	if err := repo.Save(ctx, &service.Movie{ID: "2", Title: "Outro"}); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Save: o ID de um filme excluído continua ocupado, esperava ErrConflict, mas obteve %v", err)
+	}
+	if maxID, err := repo.FindMaxID(ctx); err != nil || maxID != 2 {
+		t.Errorf("FindMaxID: esperava 2 (contando o excluído), mas obteve %d (erro: %v)", maxID, err)
+	}
+	if deleted, err := repo.SoftDeleteByID(ctx, "99", time.Now()); err != nil || deleted {
+		t.Errorf("SoftDeleteByID: esperava false para um filme inexistente, mas obteve %v (erro: %v)", deleted, err)
+	}
+}
+
+// testRestore: o filme restaurado volta com os mesmos dados; só filmes excluídos são restaurados.
+func testRestore(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	movie := fullMovie("1")
+	save(t, repo, &movie)
+
+	if restored, err := repo.Restore(ctx, "1"); err != nil || restored != nil {
+		t.Errorf("Esperava (nil, nil) ao restaurar um filme não excluído, mas obteve (%+v, %v)", restored, err)
+	}
+	if _, err := repo.SoftDeleteByID(ctx, "1", time.Now()); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	restored, err := repo.Restore(ctx, "1")
+	if err != nil || !sameMovie(restored, movie) {
+		t.Fatalf("Esperava restaurar %+v, mas obteve (%+v, %v)", movie, restored, err)
+	}
+	if found, err := repo.FindByID(ctx, "1"); err != nil || found == nil {
+		t.Errorf("Esperava encontrar o filme restaurado, mas obteve (%+v, %v)", found, err)
+	}
+	if results, err := repo.Search(ctx, service.SearchQuery{Text: "alien", Limit: 10}); err != nil || len(results) != 1 {
+		t.Errorf("Esperava encontrar o filme restaurado na busca, mas obteve %d resultados (erro: %v)", len(results), err)
+	}
+	if restored, err := repo.Restore(ctx, "99"); err != nil || restored != nil {
+		t.Errorf("Esperava (nil, nil) ao restaurar um filme inexistente, mas obteve (%+v, %v)", restored, err)
+	}
+}
+
+// testPurgeDeleted: apaga apenas os filmes excluídos antes da data limite.
+func testPurgeDeleted(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Ativo"},
+		&service.Movie{ID: "2", Title: "Excluído há muito tempo"},
+		&service.Movie{ID: "3", Title: "Excluído há pouco"},
+	)
+	now := time.Now()
+	if _, err := repo.SoftDeleteByID(ctx, "2", now.Add(-48*time.Hour)); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if _, err := repo.SoftDeleteByID(ctx, "3", now.Add(-time.Hour)); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	purged, err := repo.PurgeDeleted(ctx, now.Add(-24*time.Hour))
+	if err != nil || fmt.Sprint(purged) != "[2]" {
+		t.Fatalf("Esperava apagar o filme 2, mas obteve %v (erro: %v)", purged, err)
+	}
+	if restored, err := repo.Restore(ctx, "2"); err != nil || restored != nil {
+		t.Errorf("O filme apagado não deveria poder ser restaurado, mas obteve (%+v, %v)", restored, err)
+	}
+	if restored, err := repo.Restore(ctx, "3"); err != nil || restored == nil {
+		t.Errorf("O filme excluído há pouco deveria continuar restaurável, mas obteve (%+v, %v)", restored, err)
+	}
+	// O ID apagado fica livre de novo.
+	if err := repo.Save(ctx, &service.Movie{ID: "2", Title: "Novo"}); err != nil {
+		t.Errorf("Esperava conseguir salvar um filme com o ID apagado, mas obteve: %v", err)
+	}
+}
+
+// testFindMaxID: compara os IDs como números (10 > 9) e ignora os que não são numéricos.
+func testFindMaxID(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	maxID, err := repo.FindMaxID(ctx)
+	if err != nil || maxID != 0 {
+		t.Fatalf("Esperava 0 para o repositório vazio, mas obteve %d (erro: %v)", maxID, err)
+	}
+
+	for _, id := range []string{"9", "10", "01J9ZC8WQ7X5V3T2R1N0M9K8J7", "0192f0c4-7b3a-7000-8000-000000000000", "abc"} {
+		save(t, repo, &service.Movie{ID: id, Title: "Filme " + id})
+	}
+	maxID, err = repo.FindMaxID(ctx)
+	if err != nil || maxID != 10 {
+		t.Errorf("Esperava 10, mas obteve %d (erro: %v)", maxID, err)
+	}
+}
+
+// testFindPageAndCount: filtro, ordenação composta, cursor (After) e limite.
+func testFindPageAndCount(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Alien", Director: "Ridley Scott", Year: 1979},
+		&service.Movie{ID: "2", Title: "Blade Runner", Director: "Ridley Scott", Year: 1982},
+		&service.Movie{ID: "3", Title: "Aliens", Director: "James Cameron", Year: 1986},
+		&service.Movie{ID: "4", Title: "The Terminator", Director: "James Cameron", Year: 1984},
+		&service.Movie{ID: "5", Title: "Alien 3", Director: "David Fincher", Year: 1992},
+		&service.Movie{ID: "6", Title: "Gladiator", Director: "Ridley Scott", Year: 2000},
+	)
+	order := []service.SortField{{Field: "year", Desc: true}, {Field: "id"}}
+	filter := service.MovieFilter{YearMin: 1980, YearMax: 1999}
+
+	first, err := repo.FindPage(ctx, service.MovieQuery{Filter: filter, OrderBy: order, Limit: 2})
+	if err != nil || !sameIDs(first, "5", "3") {
+		t.Fatalf("Esperava a primeira página [5 3], mas obteve %v (erro: %v)", ids(first), err)
+	}
+	second, err := repo.FindPage(ctx, service.MovieQuery{Filter: filter, OrderBy: order, After: first[1], Limit: 2})
+	if err != nil || !sameIDs(second, "4", "2") {
+		t.Fatalf("Esperava a segunda página [4 2], mas obteve %v (erro: %v)", ids(second), err)
+	}
+	last, err := repo.FindPage(ctx, service.MovieQuery{Filter: filter, OrderBy: order, After: second[1], Limit: 2})
+	if err != nil || len(last) != 0 {
+		t.Errorf("Esperava uma página vazia no final, mas obteve %v (erro: %v)", ids(last), err)
+	}
+
+	// Título sem diferenciar maiúsculas de minúsculas; diretor exato.
+	byTitle, err := repo.FindPage(ctx, service.MovieQuery{
+		Filter:  service.MovieFilter{TitleContains: "ALIEN", Director: "Ridley Scott"},
+		OrderBy: []service.SortField{{Field: "title"}, {Field: "id"}},
+		Limit:   10,
+	})
+	if err != nil || !sameIDs(byTitle, "1") {
+		t.Errorf("Esperava [1] no filtro por título e diretor, mas obteve %v (erro: %v)", ids(byTitle), err)
+	}
+
+	for _, tc := range []struct {
+		filter service.MovieFilter
+		want   int64
+	}{
+		{service.MovieFilter{}, 6},
+		{filter, 4},
+		{service.MovieFilter{TitleContains: "alien"}, 3},
+		{service.MovieFilter{Director: "James Cameron", YearMin: 1985}, 1},
+	} {
+		count, err := repo.Count(ctx, tc.filter)
+		if err != nil || count != tc.want {
+			t.Errorf("Count(%+v): esperava %d, mas obteve %d (erro: %v)", tc.filter, tc.want, count, err)
+		}
+	}
+}
+
+// testStream: entrega os filmes filtrados em ordem de ID e para no primeiro erro do callback.
+func testStream(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo,
+		&service.Movie{ID: "3", Title: "Aliens", Year: 1986},
+		&service.Movie{ID: "1", Title: "Alien", Year: 1979},
+		&service.Movie{ID: "2", Title: "Blade Runner", Year: 1982},
+	)
+
+	var got []*service.Movie
+	err := repo.Stream(ctx, service.MovieFilter{TitleContains: "alien"}, func(movie *service.Movie) error {
+		got = append(got, movie)
+		return nil
+	})
+	if err != nil || !sameIDs(got, "1", "3") {
+		t.Fatalf("Esperava [1 3], mas obteve %v (erro: %v)", ids(got), err)
+	}
+
+	errStop := errors.New("parar")
+	calls := 0
+	err = repo.Stream(ctx, service.MovieFilter{}, func(movie *service.Movie) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(err, errStop) || calls != 1 {
+		t.Errorf("Esperava parar com o erro do callback após 1 chamada, mas obteve %v após %d", err, calls)
+	}
+}
+
+// testFilterByPerson: o filtro PersonID encontra os filmes em que a pessoa tem qualquer
+// crédito, e vale para FindPage, Count e Stream.
+func testFilterByPerson(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	scott := service.Credit{PersonID: "p1", Role: service.RoleDirector}
+	weaver := service.Credit{PersonID: "p2", Role: service.RoleActor, Character: "Ripley"}
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Alien", Year: 1979, Credits: []service.Credit{scott, weaver}},
+		&service.Movie{ID: "2", Title: "Blade Runner", Year: 1982, Credits: []service.Credit{scott}},
+		&service.Movie{ID: "3", Title: "Aliens", Year: 1986, Credits: []service.Credit{weaver}},
+		&service.Movie{ID: "4", Title: "The Terminator", Year: 1984},
+	)
+
+	filter := service.MovieFilter{PersonID: "p2"}
+	page, err := repo.FindPage(ctx, service.MovieQuery{Filter: filter, OrderBy: []service.SortField{{Field: "id"}}, Limit: 10})
+	if err != nil || !sameIDs(page, "1", "3") {
+		t.Errorf("Esperava [1 3] no filtro por pessoa, mas obteve %v (erro: %v)", ids(page), err)
+	}
+	if count, err := repo.Count(ctx, service.MovieFilter{PersonID: "p1", YearMin: 1980}); err != nil || count != 1 {
+		t.Errorf("Esperava 1 filme da pessoa p1 a partir de 1980, mas obteve %d (erro: %v)", count, err)
+	}
+	var streamed []*service.Movie
+	err = repo.Stream(ctx, service.MovieFilter{PersonID: "p9"}, func(movie *service.Movie) error {
+		streamed = append(streamed, movie)
+		return nil
+	})
+	if err != nil || len(streamed) != 0 {
+		t.Errorf("Esperava nenhum filme de uma pessoa sem créditos, mas obteve %v (erro: %v)", ids(streamed), err)
+	}
+}
+
+// testIncludeDeleted: com IncludeDeleted, os filmes com exclusão lógica voltam a ser contados
+// e listados, com os outros critérios do filtro valendo igual.
+func testIncludeDeleted(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	weaver := service.Credit{PersonID: "p1", Role: service.RoleActor, Character: "Ripley"}
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Alien", Year: 1979, Credits: []service.Credit{weaver}},
+		&service.Movie{ID: "2", Title: "Aliens", Year: 1986, Credits: []service.Credit{weaver}},
+		&service.Movie{ID: "3", Title: "Blade Runner", Year: 1982},
+	)
+	if _, err := repo.SoftDeleteByIDs(ctx, []string{"2", "3"}, time.Now()); err != nil {
+		t.Fatalf("Erro inesperado ao excluir os filmes: %v", err)
+	}
+
+	if count, err := repo.Count(ctx, service.MovieFilter{PersonID: "p1"}); err != nil || count != 1 {
+		t.Errorf("Sem IncludeDeleted, esperava 1 filme da pessoa p1, mas obteve %d (erro: %v)", count, err)
+	}
+	if count, err := repo.Count(ctx, service.MovieFilter{PersonID: "p1", IncludeDeleted: true}); err != nil || count != 2 {
+		t.Errorf("Com IncludeDeleted, esperava 2 filmes da pessoa p1, mas obteve %d (erro: %v)", count, err)
+	}
+	if count, err := repo.Count(ctx, service.MovieFilter{IncludeDeleted: true}); err != nil || count != 3 {
+		t.Errorf("Com IncludeDeleted, esperava 3 filmes no total, mas obteve %d (erro: %v)", count, err)
+	}
+	filter := service.MovieFilter{YearMin: 1980, IncludeDeleted: true}
+	page, err := repo.FindPage(ctx, service.MovieQuery{Filter: filter, OrderBy: []service.SortField{{Field: "id"}}, Limit: 10})
+	if err != nil || !sameIDs(page, "2", "3") {
+		t.Errorf("Esperava [2 3] a partir de 1980 com IncludeDeleted, mas obteve %v (erro: %v)", ids(page), err)
+	}
+}
+
+// testSearch: os resultados vêm do mais relevante para o menos relevante (quem casa
+// com mais termos vem antes), com paginação por deslocamento e contagem total.
+func testSearch(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	save(t, repo,
+		&service.Movie{ID: "1", Title: "Star Trek"},
+		&service.Movie{ID: "2", Title: "Star Wars"},
+		&service.Movie{ID: "3", Title: "Blade Runner"},
+		&service.Movie{ID: "4", Title: "Wars"},
+	)
+
+	results, err := repo.Search(ctx, service.SearchQuery{Text: "star wars", Limit: 10})
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if len(results) != 3 || results[0].Movie.ID != "2" {
+		t.Fatalf("Esperava 3 resultados começando por '2', mas obteve %d", len(results))
+	}
+	for i := 1; i < len(results); i++ {
+		if results[i].Score > results[i-1].Score {
+			t.Errorf("Esperava pontuações em ordem decrescente, mas %v vem depois de %v", results[i].Score, results[i-1].Score)
+		}
+	}
+
+	rest, err := repo.Search(ctx, service.SearchQuery{Text: "star wars", Offset: 1, Limit: 10})
+	if err != nil || len(rest) != 2 || rest[0].Movie.ID != results[1].Movie.ID {
+		t.Errorf("Esperava os mesmos resultados deslocados em 1, mas obteve %d (erro: %v)", len(rest), err)
+	}
+
+	count, err := repo.CountSearch(ctx, "star wars")
+	if err != nil || count != 3 {
+		t.Errorf("Esperava 3 resultados no total, mas obteve %d (erro: %v)", count, err)
+	}
+	none, err := repo.Search(ctx, service.SearchQuery{Text: "casablanca", Limit: 10})
+	if err != nil || len(none) != 0 {
+		t.Errorf("Esperava nenhum resultado, mas obteve %d (erro: %v)", len(none), err)
+	}
+}
+
+// testConcurrentSaves: escritas e leituras em paralelo não perdem nem corrompem filmes.
+func testConcurrentSaves(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	const total = 50
+	var wg sync.WaitGroup
+	for i := 1; i <= total; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			id := strconv.Itoa(i)
+			if err := repo.Save(ctx, &service.Movie{ID: id, Title: "Filme " + id}); err != nil {
+				t.Errorf("Erro inesperado ao salvar o filme '%s': %v", id, err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			if _, err := repo.FindAll(ctx); err != nil {
+				t.Errorf("Erro inesperado ao listar durante as escritas: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	movies, err := repo.FindAll(ctx)
+	if err != nil || len(movies) != total {
+		t.Fatalf("Esperava %d filmes, mas obteve %d (erro: %v)", total, len(movies), err)
+	}
+	maxID, err := repo.FindMaxID(ctx)
+	if err != nil || maxID != total {
+		t.Errorf("Esperava o maior ID %d, mas obteve %d (erro: %v)", total, maxID, err)
+	}
+}
+
+// testConcurrentDuplicateSaves: quando vários Saves disputam o mesmo ID, exatamente um vence.
+func testConcurrentDuplicateSaves(t *testing.T, repo service.MovieRepository) {
+	ctx := context.Background()
+	const attempts = 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := repo.Save(ctx, &service.Movie{ID: "1", Title: "Tentativa " + strconv.Itoa(i)}); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("Esperava exatamente 1 Save com sucesso, mas obteve %d", succeeded)
+	}
+	count, err := repo.Count(ctx, service.MovieFilter{})
+	if err != nil || count != 1 {
+		t.Errorf("Esperava 1 filme salvo, mas obteve %d (erro: %v)", count, err)
+	}
+}
+
+// testCanceledContext: com o contexto cancelado, toda operação falha e nada é gravado.
+func testCanceledContext(t *testing.T, repo service.MovieRepository) {
+	save(t, repo, &service.Movie{ID: "1", Title: "Alien"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.Save(ctx, &service.Movie{ID: "2", Title: "Aliens"}); err == nil {
+		t.Error("Save: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByID(ctx, "1"); err == nil {
+		t.Error("FindByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindAll(ctx); err == nil {
+		t.Error("FindAll: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindPage(ctx, service.MovieQuery{OrderBy: []service.SortField{{Field: "id"}}, Limit: 10}); err == nil {
+		t.Error("FindPage: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Count(ctx, service.MovieFilter{}); err == nil {
+		t.Error("Count: esperava um erro com o contexto cancelado")
+	}
+	if err := repo.Stream(ctx, service.MovieFilter{}, func(*service.Movie) error { return nil }); err == nil {
+		t.Error("Stream: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Update(ctx, &service.Movie{ID: "1", Title: "Aliens"}); err == nil {
+		t.Error("Update: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Patch(ctx, "1", &service.Movie{Title: "Aliens"}, []string{"title"}); err == nil {
+		t.Error("Patch: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.SetCredits(ctx, "1", nil, []service.Credit{{PersonID: "p1", Role: service.RoleDirector}}); err == nil {
+		t.Error("SetCredits: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.DeleteByID(ctx, "1"); err == nil {
+		t.Error("DeleteByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.SoftDeleteByID(ctx, "1", time.Now()); err == nil {
+		t.Error("SoftDeleteByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.SaveMany(ctx, []*service.Movie{{ID: "2", Title: "Aliens"}}); err == nil {
+		t.Error("SaveMany: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByIDs(ctx, []string{"1"}); err == nil {
+		t.Error("FindByIDs: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.DeleteByIDs(ctx, []string{"1"}); err == nil {
+		t.Error("DeleteByIDs: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.SoftDeleteByIDs(ctx, []string{"1"}, time.Now()); err == nil {
+		t.Error("SoftDeleteByIDs: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Restore(ctx, "1"); err == nil {
+		t.Error("Restore: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.PurgeDeleted(ctx, time.Now()); err == nil {
+		t.Error("PurgeDeleted: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindMaxID(ctx); err == nil {
+		t.Error("FindMaxID: esperava um erro com o contexto cancelado")
+	}
+
+	// Nada mudou.
+	movies, err := repo.FindAll(context.Background())
+	if err != nil || len(movies) != 1 || movies[0].Title != "Alien" {
+		t.Errorf("Esperava apenas o filme original, mas obteve %v (erro: %v)", ids(movies), err)
+	}
+}
@@ -0,0 +1,162 @@
+// Local: movies-service/database/repotest/watchlist.go
+
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// WatchlistFactory cria um repositório de listas de interesse novo e vazio para um teste.
+type WatchlistFactory func(t *testing.T) service.WatchlistRepository
+
+// RunWatchlist executa o contrato de service.WatchlistRepository, cada teste com um
+// repositório novo.
+func RunWatchlist(t *testing.T, newRepo WatchlistFactory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo service.WatchlistRepository)
+	}{
+		{"AddIsIdempotent", testWatchlistAddIsIdempotent},
+		{"ListOrderAndPagination", testWatchlistListOrderAndPagination},
+		{"Remove", testWatchlistRemove},
+		{"RemoveMovies", testWatchlistRemoveMovies},
+		{"CanceledContext", testWatchlistCanceledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+// watchlistDate é uma data fixa, com precisão de milissegundos como a que os bancos guardam.
+var watchlistDate = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// addToWatchlist inclui filmes na lista do usuário; o filme i entra i minutos depois da data base.
+func addToWatchlist(t *testing.T, repo service.WatchlistRepository, userID string, movieIDs ...string) {
+	t.Helper()
+	for i, movieID := range movieIDs {
+		entry := &service.WatchlistEntry{UserID: userID, MovieID: movieID, AddedAt: watchlistDate.Add(time.Duration(i) * time.Minute)}
+		if _, err := repo.Add(context.Background(), entry); err != nil {
+			t.Fatalf("Erro inesperado ao incluir o filme '%s' na lista de '%s': %v", movieID, userID, err)
+		}
+	}
+}
+
+// listWatchlist devolve os IDs dos filmes da lista, no formato "[a b c]".
+func listWatchlist(t *testing.T, repo service.WatchlistRepository, query service.WatchlistQuery) string {
+	t.Helper()
+	entries, err := repo.List(context.Background(), query)
+	if err != nil {
+		t.Fatalf("Erro inesperado ao listar a lista de '%s': %v", query.UserID, err)
+	}
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.MovieID
+	}
+	return fmt.Sprint(ids)
+}
+
+// testWatchlistAddIsIdempotent: incluir de novo o mesmo filme mantém a data original.
+func testWatchlistAddIsIdempotent(t *testing.T, repo service.WatchlistRepository) {
+	ctx := context.Background()
+	first, err := repo.Add(ctx, &service.WatchlistEntry{UserID: "ana", MovieID: "1", AddedAt: watchlistDate})
+	if err != nil || first.MovieID != "1" || !first.AddedAt.Equal(watchlistDate) {
+		t.Fatalf("Esperava a entrada incluída, mas obteve (%+v, %v)", first, err)
+	}
+	again, err := repo.Add(ctx, &service.WatchlistEntry{UserID: "ana", MovieID: "1", AddedAt: watchlistDate.Add(time.Hour)})
+	if err != nil || !again.AddedAt.Equal(watchlistDate) {
+		t.Errorf("Esperava a entrada existente com a data original, mas obteve (%+v, %v)", again, err)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "ana", Limit: 10}); got != "[1]" {
+		t.Errorf("Esperava o filme uma única vez na lista, mas obteve %s", got)
+	}
+}
+
+// testWatchlistListOrderAndPagination: as entradas vêm da mais recente para a mais antiga,
+// com empate resolvido pelo maior ID de filme, e cada usuário só vê a própria lista.
+func testWatchlistListOrderAndPagination(t *testing.T, repo service.WatchlistRepository) {
+	addToWatchlist(t, repo, "ana", "1", "2", "3")
+	addToWatchlist(t, repo, "bia", "9")
+	// Mesma data do filme "3": o empate é decidido pelo ID do filme.
+	if _, err := repo.Add(context.Background(), &service.WatchlistEntry{UserID: "ana", MovieID: "4", AddedAt: watchlistDate.Add(2 * time.Minute)}); err != nil {
+		t.Fatalf("Erro inesperado ao incluir o filme '4': %v", err)
+	}
+
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "ana", Limit: 10}); got != "[4 3 2 1]" {
+		t.Errorf("Esperava a ordem [4 3 2 1], mas obteve %s", got)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "ana", Limit: 2}); got != "[4 3]" {
+		t.Errorf("Esperava a primeira página [4 3], mas obteve %s", got)
+	}
+	after := &service.WatchlistEntry{MovieID: "4", AddedAt: watchlistDate.Add(2 * time.Minute)}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "ana", After: after, Limit: 2}); got != "[3 2]" {
+		t.Errorf("Esperava a página depois do filme '4' [3 2], mas obteve %s", got)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "bia", Limit: 10}); got != "[9]" {
+		t.Errorf("Esperava só a lista da bia [9], mas obteve %s", got)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "caio", Limit: 10}); got != "[]" {
+		t.Errorf("Esperava uma lista vazia para um usuário sem filmes, mas obteve %s", got)
+	}
+}
+
+// testWatchlistRemove: o filme sai apenas da lista do usuário informado.
+func testWatchlistRemove(t *testing.T, repo service.WatchlistRepository) {
+	ctx := context.Background()
+	addToWatchlist(t, repo, "ana", "1", "2")
+	addToWatchlist(t, repo, "bia", "1")
+
+	if removed, err := repo.Remove(ctx, "ana", "1"); err != nil || !removed {
+		t.Fatalf("Esperava remover o filme '1' da lista da ana, mas obteve (%v, %v)", removed, err)
+	}
+	if removed, err := repo.Remove(ctx, "ana", "1"); err != nil || removed {
+		t.Errorf("Esperava false ao remover de novo, mas obteve (%v, %v)", removed, err)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "ana", Limit: 10}); got != "[2]" {
+		t.Errorf("Esperava [2] na lista da ana, mas obteve %s", got)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "bia", Limit: 10}); got != "[1]" {
+		t.Errorf("A lista da bia não deveria mudar, mas obteve %s", got)
+	}
+}
+
+// testWatchlistRemoveMovies: os filmes saem das listas de todos os usuários.
+func testWatchlistRemoveMovies(t *testing.T, repo service.WatchlistRepository) {
+	addToWatchlist(t, repo, "ana", "1", "2", "3")
+	addToWatchlist(t, repo, "bia", "2")
+
+	if err := repo.RemoveMovies(context.Background(), []string{"2", "3", "nao-existe"}); err != nil {
+		t.Fatalf("Erro inesperado ao remover os filmes: %v", err)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "ana", Limit: 10}); got != "[1]" {
+		t.Errorf("Esperava [1] na lista da ana, mas obteve %s", got)
+	}
+	if got := listWatchlist(t, repo, service.WatchlistQuery{UserID: "bia", Limit: 10}); got != "[]" {
+		t.Errorf("Esperava a lista da bia vazia, mas obteve %s", got)
+	}
+}
+
+func testWatchlistCanceledContext(t *testing.T, repo service.WatchlistRepository) {
+	addToWatchlist(t, repo, "ana", "1")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := repo.Add(ctx, &service.WatchlistEntry{UserID: "ana", MovieID: "2", AddedAt: watchlistDate}); err == nil {
+		t.Error("Add: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Remove(ctx, "ana", "1"); err == nil {
+		t.Error("Remove: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.List(ctx, service.WatchlistQuery{UserID: "ana", Limit: 10}); err == nil {
+		t.Error("List: esperava um erro com o contexto cancelado")
+	}
+	if err := repo.RemoveMovies(ctx, []string{"1"}); err == nil {
+		t.Error("RemoveMovies: esperava um erro com o contexto cancelado")
+	}
+}
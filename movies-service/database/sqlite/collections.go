@@ -0,0 +1,224 @@
+// Local: movies-service/database/sqlite/collections.go
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sqlitedriver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// collectionRepository guarda as coleções na tabela collections e os seus filmes, um por
+// linha, na tabela collection_movies.
+type collectionRepository struct {
+	db *sql.DB
+}
+
+// NewCollectionRepository cria o repositório de coleções e aplica as migrações pendentes.
+func NewCollectionRepository(ctx context.Context, db *sql.DB) (service.CollectionRepository, error) {
+	if _, err := Migrate(ctx, db); err != nil {
+		return nil, err
+	}
+	return &collectionRepository{db: db}, nil
+}
+
+// Save insere a coleção e os seus filmes em uma única transação.
+func (r *collectionRepository) Save(ctx context.Context, collection *service.Collection) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO collections (id, name, description, version) VALUES (?, ?, ?, 1)`,
+		collection.ID, collection.Name, collection.Description)
+	var sqliteErr *sqlitedriver.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY {
+		return fmt.Errorf("%w: já existe uma coleção com o ID '%s'", service.ErrConflict, collection.ID)
+	}
+	if err != nil {
+		return err
+	}
+	if err := insertMembers(ctx, tx, collection); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// FindByID retorna a coleção, ou (nil, nil) se ela não existir.
+func (r *collectionRepository) FindByID(ctx context.Context, id string) (*service.Collection, error) {
+	collection := &service.Collection{MovieIDs: []string{}}
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, name, description, version FROM collections WHERE id = ?`, id).
+		Scan(&collection.ID, &collection.Name, &collection.Description, &collection.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadMembers(ctx, map[string]*service.Collection{id: collection},
+		`WHERE collection_id = ?`, id); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// FindAll retorna todas as coleções, ordenadas por ID, com duas consultas no total.
+func (r *collectionRepository) FindAll(ctx context.Context) ([]*service.Collection, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name, description, version FROM collections ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := make([]*service.Collection, 0)
+	byID := make(map[string]*service.Collection)
+	for rows.Next() {
+		collection := &service.Collection{MovieIDs: []string{}}
+		if err := rows.Scan(&collection.ID, &collection.Name, &collection.Description, &collection.Version); err != nil {
+			return nil, err
+		}
+		collections = append(collections, collection)
+		byID[collection.ID] = collection
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadMembers(ctx, byID, ``); err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+// Update grava a coleção se a versão não mudou desde a leitura. A condição "version = ?"
+// no próprio UPDATE garante isso mesmo com gravações simultâneas.
+func (r *collectionRepository) Update(ctx context.Context, collection *service.Collection) (*service.Collection, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE collections SET name = ?, description = ?, version = version + 1 WHERE id = ? AND version = ?`,
+		collection.Name, collection.Description, collection.ID, collection.Version)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		var exists bool
+		err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM collections WHERE id = ?)`, collection.ID).Scan(&exists)
+		if err != nil || !exists {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: a coleção '%s' foi alterada por outra requisição", service.ErrConflict, collection.ID)
+	}
+
+	// Os filmes são regravados do zero, com as posições da nova ordem.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_movies WHERE collection_id = ?`, collection.ID); err != nil {
+		return nil, err
+	}
+	if err := insertMembers(ctx, tx, collection); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	updated := *collection
+	updated.MovieIDs = append([]string{}, collection.MovieIDs...)
+	updated.Version++
+	return &updated, nil
+}
+
+// DeleteByID apaga a coleção e os seus filmes. Retorna false se ela não existir.
+func (r *collectionRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM collections WHERE id = ?`, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_movies WHERE collection_id = ?`, id); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// RemoveMovies tira os filmes de todas as coleções. As posições dos que ficam não mudam:
+// os buracos deixados não alteram a ordem.
+func (r *collectionRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return ctx.Err()
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	in, args := inList(movieIDs)
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE collections SET version = version + 1
+		WHERE id IN (SELECT collection_id FROM collection_movies WHERE movie_id IN `+in+`)`, args...); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_movies WHERE movie_id IN `+in, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// loadMembers preenche MovieIDs das coleções do mapa, na ordem das posições.
+// where restringe as linhas lidas (vazio lê todas).
+func (r *collectionRepository) loadMembers(ctx context.Context, collections map[string]*service.Collection, where string, args ...interface{}) error {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT collection_id, movie_id FROM collection_movies `+where+` ORDER BY collection_id, position`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var collectionID, movieID string
+		if err := rows.Scan(&collectionID, &movieID); err != nil {
+			return err
+		}
+		if collection, ok := collections[collectionID]; ok {
+			collection.MovieIDs = append(collection.MovieIDs, movieID)
+		}
+	}
+	return rows.Err()
+}
+
+// insertMembers grava os filmes da coleção com as posições 0, 1, 2...
+func insertMembers(ctx context.Context, tx *sql.Tx, collection *service.Collection) error {
+	for position, movieID := range collection.MovieIDs {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO collection_movies (collection_id, position, movie_id) VALUES (?, ?, ?)`,
+			collection.ID, position, movieID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
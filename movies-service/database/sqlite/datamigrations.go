@@ -0,0 +1,37 @@
+// Local: movies-service/database/sqlite/datamigrations.go
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// migrationRepository registra as migrações de dados concluídas na tabela data_migrations.
+type migrationRepository struct {
+	db *sql.DB
+}
+
+// NewMigrationRepository cria o registro das migrações de dados e aplica as migrações
+// pendentes do esquema.
+func NewMigrationRepository(ctx context.Context, db *sql.DB) (service.MigrationRepository, error) {
+	if _, err := Migrate(ctx, db); err != nil {
+		return nil, err
+	}
+	return &migrationRepository{db: db}, nil
+}
+
+// IsApplied indica se a migração já foi registrada.
+func (r *migrationRepository) IsApplied(ctx context.Context, name string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM data_migrations WHERE name = ?`, name).Scan(&count)
+	return count > 0, err
+}
+
+// MarkApplied registra a migração. O ON CONFLICT mantém a data do primeiro registro.
+func (r *migrationRepository) MarkApplied(ctx context.Context, name string) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO data_migrations (name) VALUES (?) ON CONFLICT (name) DO NOTHING`, name)
+	return err
+}
@@ -0,0 +1,225 @@
+// Local: movies-service/database/sqlite/migrations.go
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migration é uma versão do esquema do banco. As migrações são aplicadas em ordem,
+// cada uma dentro de uma transação, e a versão aplicada fica registrada na tabela
+// schema_migrations. Uma migração já publicada nunca deve ser alterada: mudanças
+// no esquema entram como uma versão nova no final da lista.
+type migration struct {
+	version     int
+	description string
+	statements  []string
+}
+
+// migrations é o histórico completo do esquema.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "tabela de filmes com índices de título e ano",
+		statements: []string{
+			`CREATE TABLE movies (
+				id       TEXT PRIMARY KEY,
+				title    TEXT NOT NULL,
+				director TEXT NOT NULL DEFAULT '',
+				year     INTEGER NOT NULL DEFAULT 0
+			)`,
+			// O "id" no final dos índices desempata a ordenação da paginação por keyset.
+			`CREATE INDEX idx_movies_title ON movies (title, id)`,
+			`CREATE INDEX idx_movies_year ON movies (year, id)`,
+		},
+	},
+	{
+		version:     2,
+		description: "contador do gerador de IDs sequenciais",
+		statements: []string{
+			`CREATE TABLE counters (
+				name TEXT PRIMARY KEY,
+				seq  INTEGER NOT NULL
+			)`,
+		},
+	},
+	{
+		version:     3,
+		description: "índice de texto (FTS5) para a busca por título",
+		statements: []string{
+			`CREATE VIRTUAL TABLE movies_fts USING fts5(title, content='movies', content_rowid='rowid')`,
+			// Os gatilhos mantêm o índice de texto em sincronia com a tabela de filmes.
+			`CREATE TRIGGER movies_fts_insert AFTER INSERT ON movies BEGIN
+				INSERT INTO movies_fts (rowid, title) VALUES (new.rowid, new.title);
+			END`,
+			`CREATE TRIGGER movies_fts_delete AFTER DELETE ON movies BEGIN
+				INSERT INTO movies_fts (movies_fts, rowid, title) VALUES ('delete', old.rowid, old.title);
+			END`,
+			`CREATE TRIGGER movies_fts_update AFTER UPDATE OF title ON movies BEGIN
+				INSERT INTO movies_fts (movies_fts, rowid, title) VALUES ('delete', old.rowid, old.title);
+				INSERT INTO movies_fts (rowid, title) VALUES (new.rowid, new.title);
+			END`,
+			// Indexa os filmes que já existiam antes desta migração.
+			`INSERT INTO movies_fts (movies_fts) VALUES ('rebuild')`,
+		},
+	},
+	{
+		version:     4,
+		description: "exclusão lógica de filmes",
+		statements: []string{
+			// Data da exclusão em milissegundos desde a época Unix; NULL para os filmes ativos.
+			`ALTER TABLE movies ADD COLUMN deleted_at INTEGER`,
+			// Índice parcial usado pela limpeza: só contém os filmes excluídos.
+			`CREATE INDEX idx_movies_deleted_at ON movies (deleted_at) WHERE deleted_at IS NOT NULL`,
+		},
+	},
+	{
+		version:     5,
+		description: "gêneros, duração, sinopse, elenco, idioma, classificação e IDs externos",
+		statements: []string{
+			// As listas (gêneros e elenco) são guardadas como JSON. Os valores padrão
+			// deixam os filmes que já existiam com os campos novos vazios.
+			`ALTER TABLE movies ADD COLUMN genres TEXT NOT NULL DEFAULT '[]'`,
+			`ALTER TABLE movies ADD COLUMN runtime_minutes INTEGER NOT NULL DEFAULT 0`,
+			`ALTER TABLE movies ADD COLUMN synopsis TEXT NOT NULL DEFAULT ''`,
+			// "cast" é uma palavra reservada do SQL, por isso o nome cast_members.
+			`ALTER TABLE movies ADD COLUMN cast_members TEXT NOT NULL DEFAULT '[]'`,
+			`ALTER TABLE movies ADD COLUMN original_language TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE movies ADD COLUMN age_rating TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE movies ADD COLUMN imdb_id TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE movies ADD COLUMN tmdb_id INTEGER NOT NULL DEFAULT 0`,
+		},
+	},
+	{
+		version:     6,
+		description: "coleções de filmes",
+		statements: []string{
+			// version é o contador do controle de concorrência otimista (ver CollectionRepository).
+			`CREATE TABLE collections (
+				id          TEXT PRIMARY KEY,
+				name        TEXT NOT NULL,
+				description TEXT NOT NULL DEFAULT '',
+				version     INTEGER NOT NULL
+			)`,
+			// Um filme por linha; position define a ordem dos filmes dentro da coleção.
+			`CREATE TABLE collection_movies (
+				collection_id TEXT NOT NULL,
+				position      INTEGER NOT NULL,
+				movie_id      TEXT NOT NULL,
+				PRIMARY KEY (collection_id, position)
+			)`,
+			// Usado para tirar um filme apagado de todas as coleções.
+			`CREATE INDEX idx_collection_movies_movie_id ON collection_movies (movie_id)`,
+		},
+	},
+	{
+		version:     7,
+		description: "pessoas e créditos dos filmes",
+		statements: []string{
+			`CREATE TABLE people (
+				id   TEXT PRIMARY KEY,
+				name TEXT NOT NULL
+			)`,
+			// Usado pela listagem (ordenada por nome) e pela migração dos diretores.
+			`CREATE INDEX idx_people_name ON people (name, id)`,
+			// Os créditos são guardados como JSON, como o elenco. Os nomes dos diretores
+			// viram pessoas na inicialização do serviço (ver service.MigrateDirectors),
+			// pois os IDs das pessoas vêm do gerador de IDs, e não do banco.
+			`ALTER TABLE movies ADD COLUMN credits TEXT NOT NULL DEFAULT '[]'`,
+		},
+	},
+	{
+		version:     8,
+		description: "listas de interesse dos usuários",
+		statements: []string{
+			// added_at guarda a data de inclusão em milissegundos (UTC), como deleted_at.
+			`CREATE TABLE watchlist (
+				user_id  TEXT NOT NULL,
+				movie_id TEXT NOT NULL,
+				added_at INTEGER NOT NULL,
+				PRIMARY KEY (user_id, movie_id)
+			)`,
+			// Segue a ordem da listagem (ver service.WatchlistQuery).
+			`CREATE INDEX idx_watchlist_user_added ON watchlist (user_id, added_at DESC, movie_id DESC)`,
+			// Usado para tirar um filme apagado das listas de todos os usuários.
+			`CREATE INDEX idx_watchlist_movie_id ON watchlist (movie_id)`,
+		},
+	},
+	{
+		version:     9,
+		description: "registro das migrações de dados",
+		statements: []string{
+			// Uma linha por migração de dados concluída (ver service.MigrationRepository).
+			`CREATE TABLE data_migrations (
+				name       TEXT PRIMARY KEY,
+				applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`,
+		},
+	},
+}
+
+// Migrate aplica as migrações que ainda não foram aplicadas e retorna a versão final do esquema.
+// Pode ser chamado a cada inicialização do serviço.
+func Migrate(ctx context.Context, db *sql.DB) (int, error) {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return 0, fmt.Errorf("falha ao criar a tabela schema_migrations: %w", err)
+	}
+
+	current, err := schemaVersion(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if err := apply(ctx, db, m); err != nil {
+			return current, fmt.Errorf("falha na migração %d (%s): %w", m.version, m.description, err)
+		}
+		current = m.version
+	}
+	return current, nil
+}
+
+// schemaVersion retorna a última versão aplicada (0 para um banco novo).
+func schemaVersion(ctx context.Context, db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	err := db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	return int(version.Int64), nil
+}
+
+// apply executa uma migração e registra a sua versão na mesma transação,
+// então uma migração que falha no meio não deixa o esquema pela metade.
+func apply(ctx context.Context, db *sql.DB, m migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Outra instância pode ter aplicado esta versão enquanto esperávamos pela transação.
+	var applied int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, m.version).Scan(&applied)
+	if err != nil || applied > 0 {
+		return err
+	}
+
+	for _, statement := range m.statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES (?)`, m.version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,138 @@
+// Local: movies-service/database/sqlite/people.go
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sqlitedriver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// personRepository guarda as pessoas na tabela people.
+type personRepository struct {
+	db *sql.DB
+}
+
+// NewPersonRepository cria o repositório de pessoas e aplica as migrações pendentes.
+func NewPersonRepository(ctx context.Context, db *sql.DB) (service.PersonRepository, error) {
+	if _, err := Migrate(ctx, db); err != nil {
+		return nil, err
+	}
+	return &personRepository{db: db}, nil
+}
+
+// Save insere uma pessoa nova. A chave primária rejeita IDs repetidos.
+func (r *personRepository) Save(ctx context.Context, person *service.Person) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO people (id, name) VALUES (?, ?)`, person.ID, person.Name)
+	var sqliteErr *sqlitedriver.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY {
+		return fmt.Errorf("%w: já existe uma pessoa com o ID '%s'", service.ErrConflict, person.ID)
+	}
+	return err
+}
+
+// FindByID retorna a pessoa, ou (nil, nil) se ela não existir.
+func (r *personRepository) FindByID(ctx context.Context, id string) (*service.Person, error) {
+	return r.queryOne(ctx, `SELECT id, name FROM people WHERE id = ?`, id)
+}
+
+// FindByIDs retorna as pessoas encontradas, ordenadas por ID, com uma única consulta.
+func (r *personRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Person, error) {
+	if len(ids) == 0 {
+		return []*service.Person{}, ctx.Err()
+	}
+	in, args := inList(ids)
+	return r.query(ctx, `SELECT id, name FROM people WHERE id IN `+in+` ORDER BY id`, args...)
+}
+
+// FindByName retorna a pessoa de menor ID com exatamente o nome dado, ou (nil, nil).
+func (r *personRepository) FindByName(ctx context.Context, name string) (*service.Person, error) {
+	return r.queryOne(ctx, `SELECT id, name FROM people WHERE name = ? ORDER BY id LIMIT 1`, name)
+}
+
+// FindOrCreateByName insere a pessoa com um único INSERT ... WHERE NOT EXISTS. Um comando de
+// escrita do SQLite já começa com a escrita reservada, então outra conexão não consegue
+// inserir o mesmo nome entre a busca e a inserção.
+func (r *personRepository) FindOrCreateByName(ctx context.Context, person *service.Person) (*service.Person, bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		`INSERT INTO people (id, name) SELECT ?, ? WHERE NOT EXISTS (SELECT 1 FROM people WHERE name = ?)`,
+		person.ID, person.Name, person.Name)
+	var sqliteErr *sqlitedriver.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY {
+		return nil, false, fmt.Errorf("%w: já existe uma pessoa com o ID '%s'", service.ErrConflict, person.ID)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+	if affected > 0 {
+		created := *person
+		return &created, true, nil
+	}
+	found, err := r.FindByName(ctx, person.Name)
+	return found, false, err
+}
+
+// FindAll retorna todas as pessoas, ordenadas por nome e ID (a ordem do índice idx_people_name).
+func (r *personRepository) FindAll(ctx context.Context) ([]*service.Person, error) {
+	return r.query(ctx, `SELECT id, name FROM people ORDER BY name, id`)
+}
+
+// Update substitui os dados da pessoa. Retorna (nil, nil) se ela não existir.
+func (r *personRepository) Update(ctx context.Context, person *service.Person) (*service.Person, error) {
+	return r.queryOne(ctx, `UPDATE people SET name = ? WHERE id = ? RETURNING id, name`, person.Name, person.ID)
+}
+
+// DeleteByID apaga a pessoa. Retorna false se ela não existir.
+func (r *personRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM people WHERE id = ?`, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// queryOne executa uma consulta que retorna no máximo uma pessoa.
+func (r *personRepository) queryOne(ctx context.Context, query string, args ...interface{}) (*service.Person, error) {
+	var person service.Person
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&person.ID, &person.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &person, nil
+}
+
+// query executa uma consulta que retorna uma lista de pessoas.
+func (r *personRepository) query(ctx context.Context, query string, args ...interface{}) ([]*service.Person, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	people := make([]*service.Person, 0)
+	for rows.Next() {
+		var person service.Person
+		if err := rows.Scan(&person.ID, &person.Name); err != nil {
+			return nil, err
+		}
+		people = append(people, &person)
+	}
+	return people, rows.Err()
+}
@@ -0,0 +1,698 @@
+// Local: movies-service/database/sqlite/sqlite.go
+
+// Package sqlite é um adaptador de saída que guarda os filmes em um arquivo SQLite.
+// Usa um driver escrito em Go puro (modernc.org/sqlite), então o binário continua
+// sendo compilado com CGO_ENABLED=0 e roda em ambientes onde não há MongoDB.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	sqlitedriver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+func init() {
+	// contains_fold(texto, trecho) faz a busca de trecho sem diferenciar maiúsculas de
+	// minúsculas com as mesmas regras do Go (MovieFilter.Matches). O LOWER do SQLite
+	// só conhece letras ASCII e erraria títulos como "Lumière".
+	sqlitedriver.MustRegisterDeterministicScalarFunction("contains_fold", 2,
+		func(ctx *sqlitedriver.FunctionContext, args []driver.Value) (driver.Value, error) {
+			text, _ := args[0].(string)
+			part, _ := args[1].(string)
+			return strings.Contains(strings.ToLower(text), strings.ToLower(part)), nil
+		})
+}
+
+// Open abre (ou cria) o arquivo do banco. O caminho ":memory:" cria um banco temporário.
+// O modo WAL permite leituras durante uma escrita (e torna seguro o synchronous=NORMAL,
+// bem mais rápido nas escritas), o busy_timeout faz as escritas concorrentes
+// esperarem em vez de falharem, e o _txlock=immediate reserva a escrita já no início de
+// cada transação.
+func Open(path string) (*sql.DB, error) {
+	if path == ":memory:" {
+		db, err := sql.Open("sqlite", "file::memory:?_txlock=immediate")
+		if err != nil {
+			return nil, err
+		}
+		// Cada conexão com ":memory:" teria o seu próprio banco; com uma só, todos compartilham o mesmo.
+		db.SetMaxOpenConns(1)
+		return db, nil
+	}
+
+	params := url.Values{}
+	params.Add("_pragma", "busy_timeout(5000)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", "synchronous(NORMAL)")
+	params.Add("_txlock", "immediate")
+	return sql.Open("sqlite", "file:"+path+"?"+params.Encode())
+}
+
+// movieRepository é a implementação do nosso repositório para o SQLite.
+type movieRepository struct {
+	db *sql.DB
+}
+
+// NewMovieRepository cria o repositório e aplica as migrações pendentes do esquema,
+// assim como o adaptador do MongoDB cria os seus índices na inicialização.
+func NewMovieRepository(ctx context.Context, db *sql.DB) (service.MovieRepository, error) {
+	if _, err := Migrate(ctx, db); err != nil {
+		return nil, err
+	}
+	return &movieRepository{db: db}, nil
+}
+
+// movieColumnList são as colunas de um filme, na ordem esperada por scanMovie e movieArgs.
+var movieColumnList = []string{
+	"id", "title", "director", "year", "genres", "runtime_minutes", "synopsis",
+	"cast_members", "original_language", "age_rating", "imdb_id", "tmdb_id", "credits",
+}
+
+var (
+	// movieColumns é a lista usada nos SELECT e RETURNING.
+	movieColumns = strings.Join(movieColumnList, ", ")
+	// insertMovie insere um filme com todas as colunas.
+	insertMovie = `INSERT INTO movies (` + movieColumns + `) VALUES (?` +
+		strings.Repeat(", ?", len(movieColumnList)-1) + `)`
+	// updateMovie substitui todas as colunas menos o ID, que é o último argumento.
+	updateMovie = `UPDATE movies SET ` + strings.Join(movieColumnList[1:], " = ?, ") + ` = ? WHERE id = ?`
+)
+
+// notDeleted é a condição que esconde os filmes com exclusão lógica das consultas.
+const notDeleted = `deleted_at IS NULL`
+
+// rowScanner é satisfeito tanto por *sql.Row quanto por *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanMovie lê as colunas de movieColumns. Colunas extras (como a pontuação da busca)
+// são lidas em extra.
+func scanMovie(row rowScanner, extra ...interface{}) (*service.Movie, error) {
+	var movie service.Movie
+	var genres, cast, credits string
+	dest := []interface{}{
+		&movie.ID, &movie.Title, &movie.Director, &movie.Year, &genres, &movie.RuntimeMinutes,
+		&movie.Synopsis, &cast, &movie.OriginalLanguage, &movie.AgeRating,
+		&movie.ExternalIDs.IMDbID, &movie.ExternalIDs.TMDBID, &credits,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if err := unmarshalList(genres, &movie.Genres); err != nil {
+		return nil, fmt.Errorf("gêneros inválidos no filme '%s': %w", movie.ID, err)
+	}
+	if err := unmarshalList(cast, &movie.Cast); err != nil {
+		return nil, fmt.Errorf("elenco inválido no filme '%s': %w", movie.ID, err)
+	}
+	if err := unmarshalList(credits, &movie.Credits); err != nil {
+		return nil, fmt.Errorf("créditos inválidos no filme '%s': %w", movie.ID, err)
+	}
+	return &movie, nil
+}
+
+// movieArgs são os valores das colunas de movieColumns, com as listas em JSON.
+func movieArgs(movie *service.Movie) ([]interface{}, error) {
+	genres, err := marshalList(movie.Genres)
+	if err != nil {
+		return nil, err
+	}
+	cast, err := marshalList(movie.Cast)
+	if err != nil {
+		return nil, err
+	}
+	credits, err := marshalList(movie.Credits)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{
+		movie.ID, movie.Title, movie.Director, movie.Year, genres, movie.RuntimeMinutes,
+		movie.Synopsis, cast, movie.OriginalLanguage, movie.AgeRating,
+		movie.ExternalIDs.IMDbID, movie.ExternalIDs.TMDBID, credits,
+	}, nil
+}
+
+// marshalList grava uma lista como JSON; uma lista nil vira "[]", como o padrão da coluna.
+func marshalList(list interface{}) (string, error) {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return "", err
+	}
+	if string(data) == "null" {
+		return "[]", nil
+	}
+	return string(data), nil
+}
+
+// unmarshalList lê uma lista gravada por marshalList. Uma lista vazia volta como nil.
+func unmarshalList(data string, list interface{}) error {
+	if data == "" || data == "[]" {
+		return nil
+	}
+	return json.Unmarshal([]byte(data), list)
+}
+
+// Save insere um filme novo. A chave primária rejeita IDs repetidos.
+func (r *movieRepository) Save(ctx context.Context, movie *service.Movie) error {
+	args, err := movieArgs(movie)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, insertMovie, args...)
+	var sqliteErr *sqlitedriver.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY {
+		return fmt.Errorf("%w: já existe um filme com o ID '%s'", service.ErrConflict, movie.ID)
+	}
+	return err
+}
+
+// SaveMany insere vários filmes em uma única transação. O ON CONFLICT DO NOTHING faz um ID
+// repetido rejeitar apenas o seu filme (nenhuma linha é inserida), sem abortar a transação.
+func (r *movieRepository) SaveMany(ctx context.Context, movies []*service.Movie) ([]error, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	insert, err := tx.PrepareContext(ctx, insertMovie+` ON CONFLICT (id) DO NOTHING`)
+	if err != nil {
+		return nil, err
+	}
+	defer insert.Close()
+
+	errs := make([]error, len(movies))
+	for i, movie := range movies {
+		args, err := movieArgs(movie)
+		if err != nil {
+			return nil, err
+		}
+		result, err := insert.ExecContext(ctx, args...)
+		if err != nil {
+			return nil, err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if affected == 0 {
+			errs[i] = fmt.Errorf("%w: já existe um filme com o ID '%s'", service.ErrConflict, movie.ID)
+		}
+	}
+	return errs, tx.Commit()
+}
+
+// FindByID retorna o filme, ou (nil, nil) se ele não existir.
+func (r *movieRepository) FindByID(ctx context.Context, id string) (*service.Movie, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+movieColumns+` FROM movies WHERE id = ? AND `+notDeleted, id)
+	movie, err := scanMovie(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return movie, err
+}
+
+// FindByIDs retorna os filmes encontrados, ordenados por ID.
+func (r *movieRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Movie, error) {
+	if len(ids) == 0 {
+		return []*service.Movie{}, nil
+	}
+	in, args := inList(ids)
+	return r.query(ctx, `SELECT `+movieColumns+` FROM movies WHERE id IN `+in+` AND `+notDeleted+` ORDER BY id`, args...)
+}
+
+// FindAll retorna todos os filmes, ordenados por ID.
+func (r *movieRepository) FindAll(ctx context.Context) ([]*service.Movie, error) {
+	return r.query(ctx, `SELECT `+movieColumns+` FROM movies WHERE `+notDeleted+` ORDER BY id`)
+}
+
+// FindPage implementa a busca paginada por keyset, com filtro e ordenação.
+func (r *movieRepository) FindPage(ctx context.Context, query service.MovieQuery) ([]*service.Movie, error) {
+	where, args := buildWhere(query.Filter)
+	if query.After != nil {
+		keyset, keysetArgs := buildKeyset(query.OrderBy, query.After)
+		where = append(where, keyset)
+		args = append(args, keysetArgs...)
+	}
+
+	statement := `SELECT ` + movieColumns + ` FROM movies` + whereClause(where) +
+		` ORDER BY ` + buildOrderBy(query.OrderBy) + ` LIMIT ?`
+	args = append(args, query.Limit)
+	return r.query(ctx, statement, args...)
+}
+
+// Count conta os filmes que satisfazem o filtro.
+func (r *movieRepository) Count(ctx context.Context, filter service.MovieFilter) (int64, error) {
+	where, args := buildWhere(filter)
+	var count int64
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM movies`+whereClause(where), args...).Scan(&count)
+	return count, err
+}
+
+// Stream entrega os filmes filtrados, em ordem de ID, à medida que são lidos do banco.
+func (r *movieRepository) Stream(ctx context.Context, filter service.MovieFilter, fn func(*service.Movie) error) error {
+	where, args := buildWhere(filter)
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+movieColumns+` FROM movies`+whereClause(where)+` ORDER BY id`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		movie, err := scanMovie(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(movie); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Search implementa a busca textual com o índice FTS5. A pontuação é o BM25 com o sinal
+// trocado, para que, como no MongoDB, um valor maior signifique um resultado mais relevante.
+func (r *movieRepository) Search(ctx context.Context, query service.SearchQuery) ([]*service.SearchResult, error) {
+	match := ftsQuery(query.Text)
+	if match == "" {
+		return []*service.SearchResult{}, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.`+strings.Join(movieColumnList, ", m.")+`, -bm25(movies_fts) AS score
+		FROM movies_fts JOIN movies m ON m.rowid = movies_fts.rowid
+		WHERE movies_fts MATCH ? AND m.deleted_at IS NULL
+		ORDER BY score DESC, m.id
+		LIMIT ? OFFSET ?`,
+		match, query.Limit, query.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*service.SearchResult, 0, query.Limit)
+	for rows.Next() {
+		var result service.SearchResult
+		movie, err := scanMovie(rows, &result.Score)
+		if err != nil {
+			return nil, err
+		}
+		result.Movie = movie
+		results = append(results, &result)
+	}
+	return results, rows.Err()
+}
+
+// CountSearch conta os filmes encontrados pela busca textual.
+func (r *movieRepository) CountSearch(ctx context.Context, text string) (int64, error) {
+	match := ftsQuery(text)
+	if match == "" {
+		return 0, nil
+	}
+	var count int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM movies_fts JOIN movies m ON m.rowid = movies_fts.rowid
+		WHERE movies_fts MATCH ? AND m.deleted_at IS NULL`, match).Scan(&count)
+	return count, err
+}
+
+// Update substitui um filme existente. Retorna (nil, nil) se ele não existir.
+func (r *movieRepository) Update(ctx context.Context, movie *service.Movie) (*service.Movie, error) {
+	args, err := movieArgs(movie)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.db.ExecContext(ctx, updateMovie+` AND `+notDeleted, append(args[1:], movie.ID)...)
+	if err != nil {
+		return nil, err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return nil, err
+	}
+	updated := *movie
+	return &updated, nil
+}
+
+// patchColumns são as colunas de cada campo aceito por PatchMovie.
+var patchColumns = map[string][]string{
+	"title":                {"title"},
+	"director":             {"director"},
+	"year":                 {"year"},
+	"genres":               {"genres"},
+	"runtime_minutes":      {"runtime_minutes"},
+	"synopsis":             {"synopsis"},
+	"cast":                 {"cast_members"},
+	"original_language":    {"original_language"},
+	"age_rating":           {"age_rating"},
+	"external_ids":         {"imdb_id", "tmdb_id"},
+	"external_ids.imdb_id": {"imdb_id"},
+	"external_ids.tmdb_id": {"tmdb_id"},
+	"credits":              {"credits"},
+}
+
+// Patch atualiza só as colunas dos campos de paths, com um único UPDATE ... RETURNING.
+func (r *movieRepository) Patch(ctx context.Context, id string, patch *service.Movie, paths []string) (*service.Movie, error) {
+	args, err := movieArgs(patch)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{}, len(args))
+	for i, column := range movieColumnList {
+		values[column] = args[i]
+	}
+	var set []string
+	var setArgs []interface{}
+	for _, path := range paths {
+		for _, column := range patchColumns[path] {
+			set = append(set, column+` = ?`)
+			setArgs = append(setArgs, values[column])
+		}
+	}
+	if len(set) == 0 {
+		return r.FindByID(ctx, id)
+	}
+
+	row := r.db.QueryRowContext(ctx,
+		`UPDATE movies SET `+strings.Join(set, ", ")+` WHERE id = ? AND `+notDeleted+` RETURNING `+movieColumns,
+		append(setArgs, id)...)
+	movie, err := scanMovie(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return movie, err
+}
+
+// SetCredits troca só a coluna credits, se ela ainda for igual a expected. As duas listas
+// passam por marshalList, que sempre gera o mesmo JSON para os mesmos créditos.
+func (r *movieRepository) SetCredits(ctx context.Context, id string, expected, credits []service.Credit) (bool, error) {
+	before, err := marshalList(expected)
+	if err != nil {
+		return false, err
+	}
+	after, err := marshalList(credits)
+	if err != nil {
+		return false, err
+	}
+	return r.exec(ctx, `UPDATE movies SET credits = ? WHERE id = ? AND credits = ? AND `+notDeleted, after, id, before)
+}
+
+// DeleteByID remove um filme. Retorna false se ele não existir.
+func (r *movieRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	return r.exec(ctx, `DELETE FROM movies WHERE id = ? AND `+notDeleted, id)
+}
+
+// SoftDeleteByID preenche a data de exclusão do filme. Retorna false se ele não existir.
+func (r *movieRepository) SoftDeleteByID(ctx context.Context, id string, deletedAt time.Time) (bool, error) {
+	return r.exec(ctx, `UPDATE movies SET deleted_at = ? WHERE id = ? AND `+notDeleted, deletedAt.UnixMilli(), id)
+}
+
+// DeleteByIDs remove vários filmes e retorna os IDs que existiam.
+func (r *movieRepository) DeleteByIDs(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+	in, args := inList(ids)
+	return r.queryIDs(ctx, `DELETE FROM movies WHERE id IN `+in+` AND `+notDeleted+` RETURNING id`, args...)
+}
+
+// SoftDeleteByIDs preenche a data de exclusão de vários filmes e retorna os IDs que existiam.
+func (r *movieRepository) SoftDeleteByIDs(ctx context.Context, ids []string, deletedAt time.Time) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+	in, args := inList(ids)
+	args = append([]interface{}{deletedAt.UnixMilli()}, args...)
+	return r.queryIDs(ctx, `UPDATE movies SET deleted_at = ? WHERE id IN `+in+` AND `+notDeleted+` RETURNING id`, args...)
+}
+
+// Restore limpa a data de exclusão e retorna o filme. Retorna (nil, nil) se ele não estiver excluído.
+func (r *movieRepository) Restore(ctx context.Context, id string) (*service.Movie, error) {
+	row := r.db.QueryRowContext(ctx,
+		`UPDATE movies SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL RETURNING `+movieColumns, id)
+	movie, err := scanMovie(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return movie, err
+}
+
+// PurgeDeleted apaga de vez os filmes excluídos antes de 'before'.
+func (r *movieRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	return r.queryIDs(ctx, `DELETE FROM movies WHERE deleted_at < ? RETURNING id`, before.UnixMilli())
+}
+
+// exec executa um comando sobre um único filme e informa se alguma linha foi alterada.
+func (r *movieRepository) exec(ctx context.Context, statement string, args ...interface{}) (bool, error) {
+	result, err := r.db.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// FindMaxID retorna o maior ID numérico, contando os filmes excluídos. IDs com qualquer
+// caractere que não seja um dígito são ignorados, como no adaptador do MongoDB.
+func (r *movieRepository) FindMaxID(ctx context.Context) (int, error) {
+	var maxID sql.NullInt64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT MAX(CAST(id AS INTEGER)) FROM movies WHERE id <> '' AND id NOT GLOB '*[^0-9]*'`).Scan(&maxID)
+	return int(maxID.Int64), err
+}
+
+// query executa um SELECT de filmes e lê todas as linhas.
+func (r *movieRepository) query(ctx context.Context, statement string, args ...interface{}) ([]*service.Movie, error) {
+	rows, err := r.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movies := make([]*service.Movie, 0)
+	for rows.Next() {
+		movie, err := scanMovie(rows)
+		if err != nil {
+			return nil, err
+		}
+		movies = append(movies, movie)
+	}
+	return movies, rows.Err()
+}
+
+// queryIDs executa um comando que retorna uma coluna de IDs (um SELECT ou um RETURNING id).
+func (r *movieRepository) queryIDs(ctx context.Context, statement string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// inList monta a lista "(?, ?, ...)" de um IN com um parâmetro para cada ID.
+func inList(ids []string) (string, []interface{}) {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return `(` + strings.TrimSuffix(strings.Repeat(`?, `, len(ids)), `, `) + `)`, args
+}
+
+// columns mapeia os campos ordenáveis do domínio para as colunas da tabela.
+// Usar o mapa (e não o texto recebido) garante que nada de fora entra no SQL.
+var columns = map[string]string{
+	"id":       "id",
+	"title":    "title",
+	"director": "director",
+	"year":     "year",
+}
+
+// buildWhere traduz o filtro do domínio para condições SQL com parâmetros.
+// Os filmes excluídos ficam de fora, a não ser com IncludeDeleted.
+func buildWhere(f service.MovieFilter) ([]string, []interface{}) {
+	var where []string
+	if !f.IncludeDeleted {
+		where = append(where, notDeleted)
+	}
+	var args []interface{}
+	if f.YearMin != 0 {
+		where = append(where, `year >= ?`)
+		args = append(args, f.YearMin)
+	}
+	if f.YearMax != 0 {
+		where = append(where, `year <= ?`)
+		args = append(args, f.YearMax)
+	}
+	if f.TitleContains != "" {
+		where = append(where, `contains_fold(title, ?)`)
+		args = append(args, f.TitleContains)
+	}
+	if f.Director != "" {
+		where = append(where, `director = ?`)
+		args = append(args, f.Director)
+	}
+	if f.PersonID != "" {
+		// Procura a pessoa dentro do JSON dos créditos.
+		where = append(where, `EXISTS (SELECT 1 FROM json_each(movies.credits) WHERE json_extract(json_each.value, '$.person_id') = ?)`)
+		args = append(args, f.PersonID)
+	}
+	return where, args
+}
+
+func whereClause(where []string) string {
+	if len(where) == 0 {
+		return ""
+	}
+	return ` WHERE ` + strings.Join(where, ` AND `)
+}
+
+func buildOrderBy(order []service.SortField) string {
+	parts := make([]string, 0, len(order))
+	for _, field := range order {
+		part := columns[field.Field]
+		if field.Desc {
+			part += ` DESC`
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, `, `)
+}
+
+// buildKeyset monta a condição "vem depois de 'after'", da mesma forma que o adaptador do MongoDB:
+// (a > ?) OR (a = ? AND b > ?) OR ..., trocando '>' por '<' nos campos em ordem decrescente.
+func buildKeyset(order []service.SortField, after *service.Movie) (string, []interface{}) {
+	var or []string
+	var args []interface{}
+	for i, field := range order {
+		var and []string
+		for _, previous := range order[:i] {
+			and = append(and, columns[previous.Field]+` = ?`)
+			args = append(args, fieldValue(after, previous.Field))
+		}
+		operator := ` > ?`
+		if field.Desc {
+			operator = ` < ?`
+		}
+		and = append(and, columns[field.Field]+operator)
+		args = append(args, fieldValue(after, field.Field))
+		or = append(or, `(`+strings.Join(and, ` AND `)+`)`)
+	}
+	return `(` + strings.Join(or, ` OR `) + `)`, args
+}
+
+func fieldValue(movie *service.Movie, field string) interface{} {
+	switch field {
+	case "title":
+		return movie.Title
+	case "director":
+		return movie.Director
+	case "year":
+		return movie.Year
+	}
+	return movie.ID
+}
+
+// ftsQuery transforma o texto digitado em uma consulta FTS5 segura: cada palavra vira um
+// termo entre aspas, ligado aos outros por OR (como o $text do MongoDB, basta um termo
+// aparecer). Assim, caracteres especiais da sintaxe do FTS5 nunca são interpretados.
+func ftsQuery(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, `"`+word+`"`)
+	}
+	return strings.Join(terms, ` OR `)
+}
+
+// idAllocator gera IDs numéricos sequenciais com um contador na tabela counters.
+// O UPDATE ... RETURNING é atômico, então chamadas concorrentes nunca recebem o mesmo ID.
+type idAllocator struct {
+	db   *sql.DB
+	repo service.MovieRepository
+}
+
+// NewIDAllocator cria o gerador de IDs sequenciais. O repositório é usado apenas uma vez,
+// para iniciar o contador a partir do maior ID já existente.
+func NewIDAllocator(db *sql.DB, repo service.MovieRepository) service.IDAllocator {
+	return &idAllocator{db: db, repo: repo}
+}
+
+// NextID incrementa o contador e retorna o novo valor.
+func (a *idAllocator) NextID(ctx context.Context) (string, error) {
+	ids, err := a.NextIDs(ctx, 1)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// NextIDs soma n ao contador com um único UPDATE e retorna os n valores reservados.
+func (a *idAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	last, err := a.increment(ctx, n)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Primeiro uso: o contador ainda não existe.
+		if err := a.initCounter(ctx); err != nil {
+			return nil, err
+		}
+		last, err = a.increment(ctx, n)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = strconv.FormatInt(last-int64(n-1-i), 10)
+	}
+	return ids, nil
+}
+
+// initCounter cria o contador com o maior ID existente. Se outra conexão criar o contador
+// ao mesmo tempo, o ON CONFLICT mantém o primeiro valor e o incremento garante IDs únicos.
+func (a *idAllocator) initCounter(ctx context.Context) error {
+	maxID, err := a.repo.FindMaxID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = a.db.ExecContext(ctx,
+		`INSERT INTO counters (name, seq) VALUES ('movies', ?) ON CONFLICT (name) DO NOTHING`, maxID)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar o contador de IDs: %w", err)
+	}
+	return nil
+}
+
+// increment executa o incremento atômico e retorna o último valor reservado. Retorna
+// sql.ErrNoRows se o contador não existe.
+func (a *idAllocator) increment(ctx context.Context, n int) (int64, error) {
+	var seq int64
+	err := a.db.QueryRowContext(ctx,
+		`UPDATE counters SET seq = seq + ? WHERE name = 'movies' RETURNING seq`, n).Scan(&seq)
+	return seq, err
+}
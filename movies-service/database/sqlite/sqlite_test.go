@@ -0,0 +1,284 @@
+// Local: movies-service/database/sqlite/sqlite_test.go
+
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database/repotest"
+	"github.com/alenrique/Movies-microservices/movies-service/database/sqlite"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// openTestDB cria um arquivo de banco exclusivo para o teste, apagado ao final.
+func openTestDB(t *testing.T, path string) *sql.DB {
+	t.Helper()
+	db, err := sqlite.Open(path)
+	if err != nil {
+		t.Fatalf("Falha ao abrir o banco: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// TestMovieRepository_Conformance roda o contrato comum dos repositórios contra o SQLite,
+// com um arquivo novo para cada teste da suíte.
+func TestMovieRepository_Conformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) service.MovieRepository {
+		db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+		repo, err := sqlite.NewMovieRepository(context.Background(), db)
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestCollectionRepository_Conformance roda o contrato das coleções contra o SQLite.
+func TestCollectionRepository_Conformance(t *testing.T) {
+	repotest.RunCollections(t, func(t *testing.T) service.CollectionRepository {
+		db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+		repo, err := sqlite.NewCollectionRepository(context.Background(), db)
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestPersonRepository_Conformance roda o contrato das pessoas contra o SQLite.
+func TestPersonRepository_Conformance(t *testing.T) {
+	repotest.RunPeople(t, func(t *testing.T) service.PersonRepository {
+		db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+		repo, err := sqlite.NewPersonRepository(context.Background(), db)
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestWatchlistRepository_Conformance roda o contrato das listas de interesse contra o SQLite.
+func TestWatchlistRepository_Conformance(t *testing.T) {
+	repotest.RunWatchlist(t, func(t *testing.T) service.WatchlistRepository {
+		db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+		repo, err := sqlite.NewWatchlistRepository(context.Background(), db)
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestMigrationRepository_Conformance roda o contrato do registro de migrações contra o SQLite.
+func TestMigrationRepository_Conformance(t *testing.T) {
+	repotest.RunMigrations(t, func(t *testing.T) service.MigrationRepository {
+		db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+		repo, err := sqlite.NewMigrationRepository(context.Background(), db)
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// TestMigrate_IsIdempotentAndKeepsData reabre o mesmo arquivo e verifica que as migrações
+// não são reaplicadas e que os dados continuam lá.
+func TestMigrate_IsIdempotentAndKeepsData(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "movies.db")
+	first := openTestDB(t, path)
+	repo, err := sqlite.NewMovieRepository(ctx, first)
+	if err != nil {
+		t.Fatalf("Falha ao criar o repositório: %v", err)
+	}
+	if err := repo.Save(ctx, &service.Movie{ID: "1", Title: "Alien", Year: 1979}); err != nil {
+		t.Fatalf("Erro inesperado ao salvar: %v", err)
+	}
+	first.Close()
+
+	// Act
+	second := openTestDB(t, path)
+	version, err := sqlite.Migrate(ctx, second)
+	if err != nil {
+		t.Fatalf("Erro inesperado ao migrar de novo: %v", err)
+	}
+	repo, err = sqlite.NewMovieRepository(ctx, second)
+	if err != nil {
+		t.Fatalf("Falha ao recriar o repositório: %v", err)
+	}
+	movie, err := repo.FindByID(ctx, "1")
+
+	// Assert
+	if version != 9 {
+		t.Errorf("Esperava a versão 9 do esquema, mas obteve %d", version)
+	}
+	if err != nil || movie == nil || movie.Title != "Alien" {
+		t.Errorf("Esperava encontrar 'Alien' depois de reabrir o banco, mas obteve %v (erro: %v)", movie, err)
+	}
+}
+
+// TestMovieRepository_WithService executa listagem, busca, edição e remoção pelo serviço,
+// para garantir que o SQL gerado respeita o mesmo contrato dos outros adaptadores.
+func TestMovieRepository_WithService(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	db := openTestDB(t, ":memory:")
+	repo, err := sqlite.NewMovieRepository(ctx, db)
+	if err != nil {
+		t.Fatalf("Falha ao criar o repositório: %v", err)
+	}
+	movieService := service.NewMovieService(repo, sqlite.NewIDAllocator(db, repo))
+	for _, m := range []*service.Movie{
+		{Title: "Star Wars", Director: "George Lucas", Year: 1977},
+		{Title: "Star Trek", Director: "Robert Wise", Year: 1979},
+		{Title: "Wars of the Worlds", Director: "Byron Haskin", Year: 1953},
+		{Title: "Lumière", Director: "Louis Lumière", Year: 1895},
+	} {
+		if _, err := movieService.CreateMovie(ctx, m); err != nil {
+			t.Fatalf("Erro inesperado ao criar filme: %v", err)
+		}
+	}
+
+	// Act & Assert: filtro sem diferenciar maiúsculas (inclusive fora do ASCII) e ordenação por ano decrescente.
+	page, err := movieService.ListMovies(ctx, service.ListOptions{
+		PageSize: 1,
+		Filter:   service.MovieFilter{TitleContains: "STAR"},
+		OrderBy:  "year desc",
+	})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao listar: %v", err)
+	}
+	if len(page.Movies) != 1 || page.Movies[0].Title != "Star Trek" || page.TotalSize != 2 {
+		t.Fatalf("Esperava 'Star Trek' de um total de 2, mas obteve %v (total %d)", page.Movies, page.TotalSize)
+	}
+	page, err = movieService.ListMovies(ctx, service.ListOptions{
+		PageSize:  1,
+		PageToken: page.NextPageToken,
+		Filter:    service.MovieFilter{TitleContains: "STAR"},
+		OrderBy:   "year desc",
+	})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao listar a segunda página: %v", err)
+	}
+	if len(page.Movies) != 1 || page.Movies[0].Title != "Star Wars" || page.NextPageToken != "" {
+		t.Fatalf("Esperava 'Star Wars' na última página, mas obteve %v", page.Movies)
+	}
+	count, err := repo.Count(ctx, service.MovieFilter{TitleContains: "LUMIÈRE"})
+	if err != nil || count != 1 {
+		t.Errorf("Esperava 1 filme com 'LUMIÈRE', mas obteve %d (erro: %v)", count, err)
+	}
+
+	// Busca: "Star Wars" casa com os dois termos e vem primeiro.
+	results, err := movieService.SearchMovies(ctx, service.SearchOptions{Query: "star wars"})
+	if err != nil {
+		t.Fatalf("Erro inesperado na busca: %v", err)
+	}
+	if results.TotalSize != 3 || results.Results[0].Movie.Title != "Star Wars" {
+		t.Fatalf("Esperava 3 resultados começando por 'Star Wars', mas obteve %d", results.TotalSize)
+	}
+
+	// Uma edição do título precisa atualizar o índice de texto.
+	if _, err := movieService.UpdateMovie(ctx, &service.Movie{ID: "1", Title: "Uma Nova Esperança", Year: 1977}); err != nil {
+		t.Fatalf("Erro inesperado ao editar: %v", err)
+	}
+	results, err = movieService.SearchMovies(ctx, service.SearchOptions{Query: "esperança"})
+	if err != nil || results.TotalSize != 1 {
+		t.Errorf("Esperava encontrar o título editado na busca, mas obteve %v (erro: %v)", results, err)
+	}
+
+	// Remoção
+	if err := movieService.DeleteMovie(ctx, "1"); err != nil {
+		t.Fatalf("Erro inesperado ao remover: %v", err)
+	}
+	if movie, _ := repo.FindByID(ctx, "1"); movie != nil {
+		t.Errorf("Esperava que o filme removido não fosse encontrado, mas obteve %v", movie)
+	}
+	if updated, err := repo.Update(ctx, &service.Movie{ID: "1", Title: "Fantasma"}); updated != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) ao editar um filme inexistente, mas obteve %v, %v", updated, err)
+	}
+}
+
+// TestIDAllocator_ConcurrentCreates é a versão SQLite do teste de integração do MongoDB:
+// os IDs são únicos e continuam a partir do maior ID já existente.
+func TestIDAllocator_ConcurrentCreates(t *testing.T) {
+	// Arrange: um banco com filmes "do seed", cujo maior ID é 10.
+	ctx := context.Background()
+	db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+	repo, err := sqlite.NewMovieRepository(ctx, db)
+	if err != nil {
+		t.Fatalf("Falha ao criar o repositório: %v", err)
+	}
+	for _, id := range []string{"5", "10", "não-numérico"} {
+		if err := repo.Save(ctx, &service.Movie{ID: id, Title: "Seed " + id}); err != nil {
+			t.Fatalf("Falha ao inserir o filme do seed: %v", err)
+		}
+	}
+	movieService := service.NewMovieService(repo, sqlite.NewIDAllocator(db, repo))
+
+	// Act
+	const total = 200
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	ids := make(map[string]bool, total)
+	for i := 0; i < total; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			movie, err := movieService.CreateMovie(ctx, &service.Movie{Title: "Filme " + strconv.Itoa(i)})
+			if err != nil {
+				t.Errorf("Erro inesperado ao criar filme: %v", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if ids[movie.ID] {
+				t.Errorf("ID '%s' foi gerado mais de uma vez", movie.ID)
+			}
+			ids[movie.ID] = true
+		}(i)
+	}
+	wg.Wait()
+
+	// Assert: os IDs gerados são exatamente 11..210.
+	for i := 11; i < 11+total; i++ {
+		if !ids[strconv.Itoa(i)] {
+			t.Errorf("Esperava que o ID %d fosse gerado", i)
+		}
+	}
+}
+
+// TestIDAllocator_NextIDs testa se um lote de IDs é reservado em sequência, a partir do
+// maior ID existente, e se os IDs seguintes continuam depois dele.
+func TestIDAllocator_NextIDs(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	db := openTestDB(t, filepath.Join(t.TempDir(), "movies.db"))
+	repo, err := sqlite.NewMovieRepository(ctx, db)
+	if err != nil {
+		t.Fatalf("Falha ao criar o repositório: %v", err)
+	}
+	if err := repo.Save(ctx, &service.Movie{ID: "10", Title: "Seed"}); err != nil {
+		t.Fatalf("Falha ao inserir o filme do seed: %v", err)
+	}
+	allocator := sqlite.NewIDAllocator(db, repo)
+
+	// Act
+	batch, err := allocator.NextIDs(ctx, 3)
+	if err != nil {
+		t.Fatalf("Erro inesperado ao reservar os IDs: %v", err)
+	}
+	next, err := allocator.NextID(ctx)
+
+	// Assert
+	if strings.Join(batch, ",") != "11,12,13" || next != "14" || err != nil {
+		t.Errorf("Esperava [11 12 13] e depois 14, mas obteve %v e %q (erro: %v)", batch, next, err)
+	}
+}
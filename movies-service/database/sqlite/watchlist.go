@@ -0,0 +1,92 @@
+// Local: movies-service/database/sqlite/watchlist.go
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// watchlistRepository guarda as listas de interesse na tabela watchlist, uma linha por
+// filme de cada usuário.
+type watchlistRepository struct {
+	db *sql.DB
+}
+
+// NewWatchlistRepository cria o repositório das listas de interesse e aplica as migrações pendentes.
+func NewWatchlistRepository(ctx context.Context, db *sql.DB) (service.WatchlistRepository, error) {
+	if _, err := Migrate(ctx, db); err != nil {
+		return nil, err
+	}
+	return &watchlistRepository{db: db}, nil
+}
+
+// Add inclui o filme na lista. No conflito, o UPDATE não muda nada e só serve para que o
+// RETURNING devolva a linha que já existia, com a data original.
+func (r *watchlistRepository) Add(ctx context.Context, entry *service.WatchlistEntry) (*service.WatchlistEntry, error) {
+	var addedAt int64
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO watchlist (user_id, movie_id, added_at) VALUES (?, ?, ?)
+		ON CONFLICT (user_id, movie_id) DO UPDATE SET user_id = excluded.user_id
+		RETURNING added_at`,
+		entry.UserID, entry.MovieID, entry.AddedAt.UnixMilli()).Scan(&addedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &service.WatchlistEntry{UserID: entry.UserID, MovieID: entry.MovieID, AddedAt: time.UnixMilli(addedAt).UTC()}, nil
+}
+
+// Remove tira o filme da lista. Retorna false se ele não estava nela.
+func (r *watchlistRepository) Remove(ctx context.Context, userID, movieID string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM watchlist WHERE user_id = ? AND movie_id = ?`, userID, movieID)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// List usa o índice idx_watchlist_user_added; o cursor vira a comparação de linha
+// (added_at, movie_id) < (?, ?), que segue a ordem decrescente da listagem.
+func (r *watchlistRepository) List(ctx context.Context, query service.WatchlistQuery) ([]*service.WatchlistEntry, error) {
+	where := `user_id = ?`
+	args := []interface{}{query.UserID}
+	if query.After != nil {
+		where += ` AND (added_at, movie_id) < (?, ?)`
+		args = append(args, query.After.AddedAt.UnixMilli(), query.After.MovieID)
+	}
+	args = append(args, query.Limit)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT movie_id, added_at FROM watchlist WHERE `+where+`
+		ORDER BY added_at DESC, movie_id DESC LIMIT ?`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*service.WatchlistEntry{}
+	for rows.Next() {
+		entry := &service.WatchlistEntry{UserID: query.UserID}
+		var addedAt int64
+		if err := rows.Scan(&entry.MovieID, &addedAt); err != nil {
+			return nil, err
+		}
+		entry.AddedAt = time.UnixMilli(addedAt).UTC()
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// RemoveMovies tira os filmes das listas de todos os usuários.
+func (r *watchlistRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return ctx.Err()
+	}
+	in, args := inList(movieIDs)
+	_, err := r.db.ExecContext(ctx, `DELETE FROM watchlist WHERE movie_id IN `+in, args...)
+	return err
+}
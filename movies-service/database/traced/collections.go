@@ -0,0 +1,62 @@
+// Local: movies-service/database/traced/collections.go
+
+package traced
+
+import (
+	"context"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// collectionRepository cria um span para cada chamada ao repositório de coleções envolvido.
+type collectionRepository struct {
+	next  service.CollectionRepository
+	spans spans
+}
+
+// Collections envolve o repositório de coleções. system é o banco por trás dele (ex: mongodb).
+func Collections(repo service.CollectionRepository, system string) service.CollectionRepository {
+	return &collectionRepository{next: repo, spans: spans{system: system, repository: "CollectionRepository"}}
+}
+
+func (r *collectionRepository) Save(ctx context.Context, collection *service.Collection) error {
+	ctx, span := r.spans.start(ctx, "Save")
+	err := r.next.Save(ctx, collection)
+	end(span, err)
+	return err
+}
+
+func (r *collectionRepository) FindByID(ctx context.Context, id string) (*service.Collection, error) {
+	ctx, span := r.spans.start(ctx, "FindByID")
+	collection, err := r.next.FindByID(ctx, id)
+	end(span, err)
+	return collection, err
+}
+
+func (r *collectionRepository) FindAll(ctx context.Context) ([]*service.Collection, error) {
+	ctx, span := r.spans.start(ctx, "FindAll")
+	collections, err := r.next.FindAll(ctx)
+	end(span, err)
+	return collections, err
+}
+
+func (r *collectionRepository) Update(ctx context.Context, collection *service.Collection) (*service.Collection, error) {
+	ctx, span := r.spans.start(ctx, "Update")
+	updated, err := r.next.Update(ctx, collection)
+	end(span, err)
+	return updated, err
+}
+
+func (r *collectionRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	ctx, span := r.spans.start(ctx, "DeleteByID")
+	deleted, err := r.next.DeleteByID(ctx, id)
+	end(span, err)
+	return deleted, err
+}
+
+func (r *collectionRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	ctx, span := r.spans.start(ctx, "RemoveMovies")
+	err := r.next.RemoveMovies(ctx, movieIDs)
+	end(span, err)
+	return err
+}
@@ -0,0 +1,162 @@
+// Local: movies-service/database/traced/movies.go
+
+package traced
+
+import (
+	"context"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// movieRepository cria um span para cada chamada ao repositório de filmes envolvido.
+type movieRepository struct {
+	next  service.MovieRepository
+	spans spans
+}
+
+// Movies envolve o repositório de filmes. system é o banco por trás dele (ex: mongodb).
+func Movies(repo service.MovieRepository, system string) service.MovieRepository {
+	return &movieRepository{next: repo, spans: spans{system: system, repository: "MovieRepository"}}
+}
+
+func (r *movieRepository) Save(ctx context.Context, movie *service.Movie) error {
+	ctx, span := r.spans.start(ctx, "Save")
+	err := r.next.Save(ctx, movie)
+	end(span, err)
+	return err
+}
+
+func (r *movieRepository) SaveMany(ctx context.Context, movies []*service.Movie) ([]error, error) {
+	ctx, span := r.spans.start(ctx, "SaveMany")
+	errs, err := r.next.SaveMany(ctx, movies)
+	end(span, err)
+	return errs, err
+}
+
+func (r *movieRepository) FindByID(ctx context.Context, id string) (*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "FindByID")
+	movie, err := r.next.FindByID(ctx, id)
+	end(span, err)
+	return movie, err
+}
+
+func (r *movieRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "FindByIDs")
+	movies, err := r.next.FindByIDs(ctx, ids)
+	end(span, err)
+	return movies, err
+}
+
+func (r *movieRepository) FindAll(ctx context.Context) ([]*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "FindAll")
+	movies, err := r.next.FindAll(ctx)
+	end(span, err)
+	return movies, err
+}
+
+func (r *movieRepository) FindPage(ctx context.Context, query service.MovieQuery) ([]*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "FindPage")
+	movies, err := r.next.FindPage(ctx, query)
+	end(span, err)
+	return movies, err
+}
+
+func (r *movieRepository) Count(ctx context.Context, filter service.MovieFilter) (int64, error) {
+	ctx, span := r.spans.start(ctx, "Count")
+	count, err := r.next.Count(ctx, filter)
+	end(span, err)
+	return count, err
+}
+
+// Stream tem um único span para o stream inteiro, que inclui o tempo de fn.
+func (r *movieRepository) Stream(ctx context.Context, filter service.MovieFilter, fn func(*service.Movie) error) error {
+	ctx, span := r.spans.start(ctx, "Stream")
+	err := r.next.Stream(ctx, filter, fn)
+	end(span, err)
+	return err
+}
+
+func (r *movieRepository) Search(ctx context.Context, query service.SearchQuery) ([]*service.SearchResult, error) {
+	ctx, span := r.spans.start(ctx, "Search")
+	results, err := r.next.Search(ctx, query)
+	end(span, err)
+	return results, err
+}
+
+func (r *movieRepository) CountSearch(ctx context.Context, text string) (int64, error) {
+	ctx, span := r.spans.start(ctx, "CountSearch")
+	count, err := r.next.CountSearch(ctx, text)
+	end(span, err)
+	return count, err
+}
+
+func (r *movieRepository) Update(ctx context.Context, movie *service.Movie) (*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "Update")
+	updated, err := r.next.Update(ctx, movie)
+	end(span, err)
+	return updated, err
+}
+
+func (r *movieRepository) Patch(ctx context.Context, id string, patch *service.Movie, paths []string) (*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "Patch")
+	movie, err := r.next.Patch(ctx, id, patch, paths)
+	end(span, err)
+	return movie, err
+}
+
+func (r *movieRepository) SetCredits(ctx context.Context, id string, expected, credits []service.Credit) (bool, error) {
+	ctx, span := r.spans.start(ctx, "SetCredits")
+	updated, err := r.next.SetCredits(ctx, id, expected, credits)
+	end(span, err)
+	return updated, err
+}
+
+func (r *movieRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	ctx, span := r.spans.start(ctx, "DeleteByID")
+	deleted, err := r.next.DeleteByID(ctx, id)
+	end(span, err)
+	return deleted, err
+}
+
+func (r *movieRepository) SoftDeleteByID(ctx context.Context, id string, deletedAt time.Time) (bool, error) {
+	ctx, span := r.spans.start(ctx, "SoftDeleteByID")
+	deleted, err := r.next.SoftDeleteByID(ctx, id, deletedAt)
+	end(span, err)
+	return deleted, err
+}
+
+func (r *movieRepository) DeleteByIDs(ctx context.Context, ids []string) ([]string, error) {
+	ctx, span := r.spans.start(ctx, "DeleteByIDs")
+	deleted, err := r.next.DeleteByIDs(ctx, ids)
+	end(span, err)
+	return deleted, err
+}
+
+func (r *movieRepository) SoftDeleteByIDs(ctx context.Context, ids []string, deletedAt time.Time) ([]string, error) {
+	ctx, span := r.spans.start(ctx, "SoftDeleteByIDs")
+	deleted, err := r.next.SoftDeleteByIDs(ctx, ids, deletedAt)
+	end(span, err)
+	return deleted, err
+}
+
+func (r *movieRepository) Restore(ctx context.Context, id string) (*service.Movie, error) {
+	ctx, span := r.spans.start(ctx, "Restore")
+	movie, err := r.next.Restore(ctx, id)
+	end(span, err)
+	return movie, err
+}
+
+func (r *movieRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]string, error) {
+	ctx, span := r.spans.start(ctx, "PurgeDeleted")
+	purged, err := r.next.PurgeDeleted(ctx, before)
+	end(span, err)
+	return purged, err
+}
+
+func (r *movieRepository) FindMaxID(ctx context.Context) (int, error) {
+	ctx, span := r.spans.start(ctx, "FindMaxID")
+	id, err := r.next.FindMaxID(ctx)
+	end(span, err)
+	return id, err
+}
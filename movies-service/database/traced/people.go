@@ -0,0 +1,76 @@
+// Local: movies-service/database/traced/people.go
+
+package traced
+
+import (
+	"context"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// personRepository cria um span para cada chamada ao repositório de pessoas envolvido.
+type personRepository struct {
+	next  service.PersonRepository
+	spans spans
+}
+
+// People envolve o repositório de pessoas. system é o banco por trás dele (ex: mongodb).
+func People(repo service.PersonRepository, system string) service.PersonRepository {
+	return &personRepository{next: repo, spans: spans{system: system, repository: "PersonRepository"}}
+}
+
+func (r *personRepository) Save(ctx context.Context, person *service.Person) error {
+	ctx, span := r.spans.start(ctx, "Save")
+	err := r.next.Save(ctx, person)
+	end(span, err)
+	return err
+}
+
+func (r *personRepository) FindByID(ctx context.Context, id string) (*service.Person, error) {
+	ctx, span := r.spans.start(ctx, "FindByID")
+	person, err := r.next.FindByID(ctx, id)
+	end(span, err)
+	return person, err
+}
+
+func (r *personRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Person, error) {
+	ctx, span := r.spans.start(ctx, "FindByIDs")
+	people, err := r.next.FindByIDs(ctx, ids)
+	end(span, err)
+	return people, err
+}
+
+func (r *personRepository) FindByName(ctx context.Context, name string) (*service.Person, error) {
+	ctx, span := r.spans.start(ctx, "FindByName")
+	person, err := r.next.FindByName(ctx, name)
+	end(span, err)
+	return person, err
+}
+
+func (r *personRepository) FindOrCreateByName(ctx context.Context, person *service.Person) (*service.Person, bool, error) {
+	ctx, span := r.spans.start(ctx, "FindOrCreateByName")
+	found, created, err := r.next.FindOrCreateByName(ctx, person)
+	end(span, err)
+	return found, created, err
+}
+
+func (r *personRepository) FindAll(ctx context.Context) ([]*service.Person, error) {
+	ctx, span := r.spans.start(ctx, "FindAll")
+	people, err := r.next.FindAll(ctx)
+	end(span, err)
+	return people, err
+}
+
+func (r *personRepository) Update(ctx context.Context, person *service.Person) (*service.Person, error) {
+	ctx, span := r.spans.start(ctx, "Update")
+	updated, err := r.next.Update(ctx, person)
+	end(span, err)
+	return updated, err
+}
+
+func (r *personRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	ctx, span := r.spans.start(ctx, "DeleteByID")
+	deleted, err := r.next.DeleteByID(ctx, id)
+	end(span, err)
+	return deleted, err
+}
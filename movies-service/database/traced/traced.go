@@ -0,0 +1,49 @@
+// Local: movies-service/database/traced/traced.go
+
+// Package traced envolve os repositórios do movies-service com spans do OpenTelemetry: cada
+// chamada a um repositório vira um span filho do span da chamada gRPC, com o nome
+// "Repositório.Método" (ex: MovieRepository.FindPage).
+//
+// Os decoradores não dependem do banco, então servem para todos os adaptadores (mongo,
+// sqlite e memory); o banco aparece no atributo db.system.name do span.
+package traced
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer é obtido do TracerProvider global, que tracing.Setup configura na inicialização.
+var tracer = otel.Tracer("github.com/alenrique/Movies-microservices/movies-service/database/traced")
+
+// spans cria os spans de um repositório. system é o banco (ex: mongodb) e repository é o
+// nome da interface, usado como prefixo do nome dos spans.
+type spans struct {
+	system     string
+	repository string
+}
+
+// start abre o span de uma operação do repositório. O contexto retornado deve ser repassado
+// ao repositório, para que o driver do banco também enxergue o span.
+func (s spans) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, s.repository+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", s.system),
+			attribute.String("db.operation.name", operation),
+		),
+	)
+}
+
+// end fecha o span, marcando-o com o erro quando a operação falhou.
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,63 @@
+// Local: movies-service/database/traced/traced_test.go
+
+package traced_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/database/repotest"
+	"github.com/alenrique/Movies-microservices/movies-service/database/traced"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// TestMovieRepository_Conformance garante que o decorador repassa as chamadas sem mudar o
+// comportamento do repositório envolvido.
+func TestMovieRepository_Conformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) service.MovieRepository {
+		return traced.Movies(memory.NewMovieRepository(), "memory")
+	})
+}
+
+// TestMovieRepository_Spans testa se cada chamada vira um span filho do span atual e se
+// as falhas ficam marcadas no span.
+func TestMovieRepository_Spans(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	repo := traced.Movies(memory.NewMovieRepository(), "memory")
+	ctx, parent := otel.Tracer("teste").Start(context.Background(), "GetMovie")
+
+	// Act: a segunda inserção com o mesmo ID falha com ErrConflict.
+	movie := &service.Movie{ID: "1", Title: "Filme", Director: "Diretora", Year: 2000}
+	repo.Save(ctx, movie)
+	err := repo.Save(ctx, movie)
+	parent.End()
+
+	// Assert
+	if !errors.Is(err, service.ErrConflict) {
+		t.Fatalf("Esperava o erro do repositório envolvido, mas obteve %v", err)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("Esperava 3 spans (2 do repositório e o pai), mas obteve %d", len(spans))
+	}
+	for _, span := range spans[:2] {
+		if span.Name() != "MovieRepository.Save" {
+			t.Errorf("Esperava o span MovieRepository.Save, mas obteve %q", span.Name())
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Esperava que %q fosse filho do span da chamada", span.Name())
+		}
+	}
+	if spans[0].Status().Code != codes.Unset || spans[1].Status().Code != codes.Error {
+		t.Errorf("Esperava só o segundo span com erro, mas obteve %v e %v", spans[0].Status(), spans[1].Status())
+	}
+}
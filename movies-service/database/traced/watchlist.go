@@ -0,0 +1,48 @@
+// Local: movies-service/database/traced/watchlist.go
+
+package traced
+
+import (
+	"context"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// watchlistRepository cria um span para cada chamada ao repositório das listas de interesse envolvido.
+type watchlistRepository struct {
+	next  service.WatchlistRepository
+	spans spans
+}
+
+// Watchlists envolve o repositório das listas de interesse. system é o banco por trás dele (ex: mongodb).
+func Watchlists(repo service.WatchlistRepository, system string) service.WatchlistRepository {
+	return &watchlistRepository{next: repo, spans: spans{system: system, repository: "WatchlistRepository"}}
+}
+
+func (r *watchlistRepository) Add(ctx context.Context, entry *service.WatchlistEntry) (*service.WatchlistEntry, error) {
+	ctx, span := r.spans.start(ctx, "Add")
+	added, err := r.next.Add(ctx, entry)
+	end(span, err)
+	return added, err
+}
+
+func (r *watchlistRepository) Remove(ctx context.Context, userID, movieID string) (bool, error) {
+	ctx, span := r.spans.start(ctx, "Remove")
+	removed, err := r.next.Remove(ctx, userID, movieID)
+	end(span, err)
+	return removed, err
+}
+
+func (r *watchlistRepository) List(ctx context.Context, query service.WatchlistQuery) ([]*service.WatchlistEntry, error) {
+	ctx, span := r.spans.start(ctx, "List")
+	entries, err := r.next.List(ctx, query)
+	end(span, err)
+	return entries, err
+}
+
+func (r *watchlistRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	ctx, span := r.spans.start(ctx, "RemoveMovies")
+	err := r.next.RemoveMovies(ctx, movieIDs)
+	end(span, err)
+	return err
+}
@@ -0,0 +1,119 @@
+// Local: movies-service/grpc_adapter/collections.go
+
+package grpc_adapter
+
+import (
+	"context"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// GrpcCollectionServer é a implementação gRPC do serviço de coleções.
+// Os erros do domínio são traduzidos por toStatusError, como no servidor de filmes.
+type GrpcCollectionServer struct {
+	pb.UnimplementedCollectionServiceServer
+	service service.CollectionService
+}
+
+// NewGrpcCollectionServer é o construtor do servidor gRPC das coleções.
+func NewGrpcCollectionServer(svc service.CollectionService) *GrpcCollectionServer {
+	return &GrpcCollectionServer{service: svc}
+}
+
+// CreateCollection implementa o método gRPC para criar uma coleção.
+func (s *GrpcCollectionServer) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.Collection, error) {
+	created, err := s.service.CreateCollection(ctx, &service.Collection{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		MovieIDs:    req.GetMovieIds(),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoCollection(created), nil
+}
+
+// GetCollection implementa o método gRPC para buscar uma coleção por ID.
+func (s *GrpcCollectionServer) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.Collection, error) {
+	collection, err := s.service.GetCollection(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoCollection(collection), nil
+}
+
+// ListCollections implementa o método gRPC para listar todas as coleções.
+func (s *GrpcCollectionServer) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	collections, err := s.service.ListCollections(ctx)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	response := &pb.ListCollectionsResponse{Collections: make([]*pb.Collection, 0, len(collections))}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, toProtoCollection(collection))
+	}
+	return response, nil
+}
+
+// UpdateCollection implementa o método gRPC para alterar o nome e a descrição de uma coleção.
+func (s *GrpcCollectionServer) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.Collection, error) {
+	updated, err := s.service.UpdateCollection(ctx, &service.Collection{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoCollection(updated), nil
+}
+
+// DeleteCollection implementa o método gRPC para apagar uma coleção.
+func (s *GrpcCollectionServer) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	if err := s.service.DeleteCollection(ctx, req.GetId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return &pb.DeleteCollectionResponse{}, nil
+}
+
+// AddMovieToCollection implementa o método gRPC para adicionar (ou mover) um filme na coleção.
+func (s *GrpcCollectionServer) AddMovieToCollection(ctx context.Context, req *pb.AddMovieToCollectionRequest) (*pb.Collection, error) {
+	collection, err := s.service.AddMovie(ctx, req.GetCollectionId(), req.GetMovieId(), int(req.GetPosition()))
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoCollection(collection), nil
+}
+
+// RemoveMovieFromCollection implementa o método gRPC para tirar um filme da coleção.
+func (s *GrpcCollectionServer) RemoveMovieFromCollection(ctx context.Context, req *pb.RemoveMovieFromCollectionRequest) (*pb.Collection, error) {
+	collection, err := s.service.RemoveMovie(ctx, req.GetCollectionId(), req.GetMovieId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoCollection(collection), nil
+}
+
+// ListCollectionMovies implementa o método gRPC que retorna os filmes da coleção, em ordem.
+func (s *GrpcCollectionServer) ListCollectionMovies(ctx context.Context, req *pb.ListCollectionMoviesRequest) (*pb.ListCollectionMoviesResponse, error) {
+	movies, err := s.service.ListCollectionMovies(ctx, req.GetCollectionId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	response := &pb.ListCollectionMoviesResponse{Movies: make([]*pb.Movie, 0, len(movies))}
+	for _, movie := range movies {
+		response.Movies = append(response.Movies, toProtoMovie(movie))
+	}
+	return response, nil
+}
+
+// toProtoCollection traduz uma coleção do domínio para a mensagem gRPC.
+func toProtoCollection(collection *service.Collection) *pb.Collection {
+	return &pb.Collection{
+		Id:          collection.ID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		MovieIds:    collection.MovieIDs,
+	}
+}
@@ -0,0 +1,86 @@
+// Local: movies-service/grpc_adapter/convert.go
+
+package grpc_adapter
+
+import (
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// movieMessage reúne os getters que pb.Movie, pb.CreateMovieRequest e pb.UpdateMovieRequest
+// têm em comum, para que a tradução para o domínio seja escrita uma vez só.
+type movieMessage interface {
+	GetTitle() string
+	GetDirector() string
+	GetYear() int32
+	GetGenres() []string
+	GetRuntimeMinutes() int32
+	GetSynopsis() string
+	GetCast() []*pb.CastMember
+	GetOriginalLanguage() string
+	GetAgeRating() string
+	GetExternalIds() *pb.ExternalIds
+	GetCredits() []*pb.Credit
+}
+
+// toDomainMovie traduz uma mensagem gRPC para o modelo de domínio. O ID não faz parte
+// de movieMessage (a criação não tem ID), então quem chama o preenche quando precisar.
+func toDomainMovie(msg movieMessage) *service.Movie {
+	movie := &service.Movie{
+		Title:            msg.GetTitle(),
+		Director:         msg.GetDirector(),
+		Year:             msg.GetYear(),
+		Genres:           msg.GetGenres(),
+		RuntimeMinutes:   msg.GetRuntimeMinutes(),
+		Synopsis:         msg.GetSynopsis(),
+		OriginalLanguage: msg.GetOriginalLanguage(),
+		AgeRating:        msg.GetAgeRating(),
+		ExternalIDs: service.ExternalIDs{
+			IMDbID: msg.GetExternalIds().GetImdbId(),
+			TMDBID: msg.GetExternalIds().GetTmdbId(),
+		},
+	}
+	for _, member := range msg.GetCast() {
+		movie.Cast = append(movie.Cast, service.CastMember{Name: member.GetName(), Role: member.GetRole()})
+	}
+	for _, credit := range msg.GetCredits() {
+		movie.Credits = append(movie.Credits, service.Credit{
+			PersonID:  credit.GetPersonId(),
+			Role:      credit.GetRole(),
+			Character: credit.GetCharacter(),
+		})
+	}
+	return movie
+}
+
+// toProtoMovie traduz um filme do domínio para a mensagem gRPC. Os IDs externos só são
+// enviados quando pelo menos um deles é conhecido.
+func toProtoMovie(movie *service.Movie) *pb.Movie {
+	msg := &pb.Movie{
+		Id:               movie.ID,
+		Title:            movie.Title,
+		Director:         movie.Director,
+		Year:             movie.Year,
+		Genres:           movie.Genres,
+		RuntimeMinutes:   movie.RuntimeMinutes,
+		Synopsis:         movie.Synopsis,
+		OriginalLanguage: movie.OriginalLanguage,
+		AgeRating:        movie.AgeRating,
+	}
+	for _, member := range movie.Cast {
+		msg.Cast = append(msg.Cast, &pb.CastMember{Name: member.Name, Role: member.Role})
+	}
+	for _, credit := range movie.Credits {
+		msg.Credits = append(msg.Credits, &pb.Credit{PersonId: credit.PersonID, Role: credit.Role, Character: credit.Character})
+	}
+	if movie.ExternalIDs != (service.ExternalIDs{}) {
+		msg.ExternalIds = &pb.ExternalIds{
+			ImdbId: movie.ExternalIDs.IMDbID,
+			TmdbId: movie.ExternalIDs.TMDBID,
+		}
+	}
+	if movie.Rating != nil {
+		msg.Rating = &pb.RatingSummary{Average: movie.Rating.Average, Count: movie.Rating.Count}
+	}
+	return msg
+}
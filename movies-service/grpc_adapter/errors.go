@@ -0,0 +1,93 @@
+// Local: movies-service/grpc_adapter/errors.go
+
+package grpc_adapter
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// toStatusError traduz um erro do serviço para um erro gRPC com o código adequado.
+// É o único lugar que conhece a correspondência entre as categorias de erro do domínio
+// e os códigos gRPC, então todos os métodos do servidor respondem da mesma forma:
+//
+//	service.ErrInvalidArgument -> InvalidArgument (com os campos em errdetails.BadRequest)
+//	service.ErrNotFound        -> NotFound
+//	service.ErrConflict        -> AlreadyExists
+//	cancelamento / timeout     -> Canceled / DeadlineExceeded
+//	qualquer outro erro        -> Internal (os detalhes ficam só no log)
+//
+// ctx é o contexto da chamada: o log do erro interno leva o request_id dela.
+func toStatusError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	// Erros que já são um status gRPC (ex: vindos do stream.Send) são repassados como estão.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationErr *service.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return badRequest(validationErr)
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+
+	// Falhas de infraestrutura (banco fora do ar etc.) não devem vazar para o cliente.
+	slog.ErrorContext(ctx, "erro interno", "error", err)
+	return status.Error(codes.Internal, "erro interno no movies-service")
+}
+
+// badRequest monta o status InvalidArgument com a lista de campos inválidos nos detalhes,
+// para que o cliente saiba exatamente qual campo corrigir.
+func badRequest(err *service.ValidationError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	details := &errdetails.BadRequest{}
+	for _, violation := range err.Violations {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	if withDetails, detailsErr := st.WithDetails(details); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// toItemError traduz o erro de um item de um lote para a mensagem ItemError, com o mesmo
+// código e os mesmos campos inválidos que toStatusError daria para a operação individual.
+func toItemError(ctx context.Context, err error) *pb.ItemError {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(toStatusError(ctx, err))
+	itemErr := &pb.ItemError{Code: int32(st.Code()), Message: st.Message()}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				itemErr.FieldViolations = append(itemErr.FieldViolations, &pb.FieldViolation{
+					Field:       violation.GetField(),
+					Description: violation.GetDescription(),
+				})
+			}
+		}
+	}
+	return itemErr
+}
@@ -0,0 +1,60 @@
+// Local: movies-service/grpc_adapter/health.go
+
+package grpc_adapter
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger confere se o banco de dados está acessível. Um repositório sem servidor (em
+// memória) não precisa de um: o serviço fica sempre SERVING.
+type Pinger func(ctx context.Context) error
+
+// WatchDatabase mantém o estado do grpc.health.v1 de acordo com um ping periódico no banco:
+// SERVING enquanto o ping responde e NOT_SERVING quando ele falha ou demora mais que o
+// intervalo. O estado vale para o servidor como um todo (nome vazio) e para cada serviço
+// de services. A primeira verificação é feita na hora, e a função só retorna quando ctx é
+// cancelado; por isso, deve rodar em uma goroutine.
+func WatchDatabase(ctx context.Context, server *health.Server, ping Pinger, interval time.Duration, services ...string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var current healthpb.HealthCheckResponse_ServingStatus
+	for {
+		next := healthpb.HealthCheckResponse_SERVING
+		if ping != nil {
+			pingCtx, cancel := context.WithTimeout(ctx, interval)
+			err := ping(pingCtx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				next = healthpb.HealthCheckResponse_NOT_SERVING
+				if current != next {
+					slog.Error("o banco de dados não respondeu ao ping, estado NOT_SERVING", "error", err)
+				}
+			} else if current == healthpb.HealthCheckResponse_NOT_SERVING {
+				slog.Info("o banco de dados voltou a responder, estado SERVING")
+			}
+		}
+		if next != current {
+			current = next
+			server.SetServingStatus("", current)
+			for _, name := range services {
+				server.SetServingStatus(name, current)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Local: movies-service/grpc_adapter/health_test.go
+
+package grpc_adapter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// waitForStatus consulta o servidor de saúde até o serviço chegar ao estado esperado.
+func waitForStatus(t *testing.T, server *health.Server, service string, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		res, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err == nil && res.GetStatus() == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Esperava o estado %s para %q, mas obteve (%v, %v)", want, service, res.GetStatus(), err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// TestWatchDatabase testa se o estado acompanha o ping do banco e se, depois de
+// health.Server.Shutdown (chamado no desligamento), ele fica em NOT_SERVING.
+func TestWatchDatabase(t *testing.T) {
+	// Arrange: um banco que pode ser "derrubado" durante o teste.
+	var down atomic.Bool
+	ping := func(ctx context.Context) error {
+		if down.Load() {
+			return errors.New("conexão recusada")
+		}
+		return nil
+	}
+	server := health.NewServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Act & Assert
+	go WatchDatabase(ctx, server, ping, 10*time.Millisecond, "movies.MovieService")
+	waitForStatus(t, server, "movies.MovieService", healthpb.HealthCheckResponse_SERVING)
+
+	down.Store(true)
+	waitForStatus(t, server, "", healthpb.HealthCheckResponse_NOT_SERVING)
+	waitForStatus(t, server, "movies.MovieService", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	down.Store(false)
+	waitForStatus(t, server, "movies.MovieService", healthpb.HealthCheckResponse_SERVING)
+
+	server.Shutdown()
+	waitForStatus(t, server, "movies.MovieService", healthpb.HealthCheckResponse_NOT_SERVING)
+	time.Sleep(30 * time.Millisecond) // Outras verificações com o banco no ar não mudam mais o estado.
+	waitForStatus(t, server, "", healthpb.HealthCheckResponse_NOT_SERVING)
+}
@@ -0,0 +1,88 @@
+// Local: movies-service/grpc_adapter/people.go
+
+package grpc_adapter
+
+import (
+	"context"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// GrpcPeopleServer é a implementação gRPC do serviço de pessoas.
+// Os erros do domínio são traduzidos por toStatusError, como no servidor de filmes.
+type GrpcPeopleServer struct {
+	pb.UnimplementedPeopleServiceServer
+	service service.PeopleService
+}
+
+// NewGrpcPeopleServer é o construtor do servidor gRPC das pessoas.
+func NewGrpcPeopleServer(svc service.PeopleService) *GrpcPeopleServer {
+	return &GrpcPeopleServer{service: svc}
+}
+
+// CreatePerson implementa o método gRPC para criar uma pessoa.
+func (s *GrpcPeopleServer) CreatePerson(ctx context.Context, req *pb.CreatePersonRequest) (*pb.Person, error) {
+	created, err := s.service.CreatePerson(ctx, &service.Person{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoPerson(created), nil
+}
+
+// GetPerson implementa o método gRPC para buscar uma pessoa por ID.
+func (s *GrpcPeopleServer) GetPerson(ctx context.Context, req *pb.GetPersonRequest) (*pb.Person, error) {
+	person, err := s.service.GetPerson(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoPerson(person), nil
+}
+
+// ListPeople implementa o método gRPC para listar todas as pessoas.
+func (s *GrpcPeopleServer) ListPeople(ctx context.Context, req *pb.ListPeopleRequest) (*pb.ListPeopleResponse, error) {
+	people, err := s.service.ListPeople(ctx)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	response := &pb.ListPeopleResponse{People: make([]*pb.Person, 0, len(people))}
+	for _, person := range people {
+		response.People = append(response.People, toProtoPerson(person))
+	}
+	return response, nil
+}
+
+// UpdatePerson implementa o método gRPC para alterar o nome de uma pessoa.
+func (s *GrpcPeopleServer) UpdatePerson(ctx context.Context, req *pb.UpdatePersonRequest) (*pb.Person, error) {
+	updated, err := s.service.UpdatePerson(ctx, &service.Person{ID: req.GetId(), Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoPerson(updated), nil
+}
+
+// DeletePerson implementa o método gRPC para apagar uma pessoa sem créditos.
+func (s *GrpcPeopleServer) DeletePerson(ctx context.Context, req *pb.DeletePersonRequest) (*pb.DeletePersonResponse, error) {
+	if err := s.service.DeletePerson(ctx, req.GetId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return &pb.DeletePersonResponse{}, nil
+}
+
+// ListPersonMovies implementa o método gRPC que retorna a filmografia da pessoa.
+func (s *GrpcPeopleServer) ListPersonMovies(ctx context.Context, req *pb.ListPersonMoviesRequest) (*pb.ListPersonMoviesResponse, error) {
+	movies, err := s.service.ListPersonMovies(ctx, req.GetPersonId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	response := &pb.ListPersonMoviesResponse{Movies: make([]*pb.Movie, 0, len(movies))}
+	for _, movie := range movies {
+		response.Movies = append(response.Movies, toProtoMovie(movie))
+	}
+	return response, nil
+}
+
+// toProtoPerson traduz uma pessoa do domínio para a mensagem gRPC.
+func toProtoPerson(person *service.Person) *pb.Person {
+	return &pb.Person{Id: person.ID, Name: person.Name}
+}
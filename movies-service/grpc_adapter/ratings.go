@@ -0,0 +1,41 @@
+// Local: movies-service/grpc_adapter/ratings.go
+
+package grpc_adapter
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// ratingsTimeout é o tempo máximo de espera pelo reviews-service. A média é só um
+// complemento do filme, então não vale a pena segurar o GetMovie por muito tempo.
+const ratingsTimeout = 500 * time.Millisecond
+
+// reviewRatingSource é o adaptador de saída que busca a média das avaliações no
+// reviews-service, pelo cliente gRPC do ReviewService.
+type reviewRatingSource struct {
+	client pb.ReviewServiceClient
+}
+
+// NewReviewRatingSource cria a service.RatingSource que consulta o reviews-service.
+func NewReviewRatingSource(client pb.ReviewServiceClient) service.RatingSource {
+	return &reviewRatingSource{client: client}
+}
+
+// RatingSummary busca a média e a quantidade das notas do filme. As falhas ficam registradas
+// no log, pois o serviço de filmes apenas omite a média quando não a recebe.
+func (s *reviewRatingSource) RatingSummary(ctx context.Context, movieID string) (*service.RatingSummary, error) {
+	ctx, cancel := context.WithTimeout(ctx, ratingsTimeout)
+	defer cancel()
+
+	res, err := s.client.GetRatingSummary(ctx, &pb.GetRatingSummaryRequest{MovieId: movieID})
+	if err != nil {
+		slog.WarnContext(ctx, "falha ao buscar a média das avaliações", "movie_id", movieID, "error", err)
+		return nil, err
+	}
+	return &service.RatingSummary{Average: res.GetAverage(), Count: res.GetCount()}, nil
+}
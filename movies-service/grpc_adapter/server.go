@@ -4,6 +4,7 @@ package grpc_adapter
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,119 +31,253 @@ func (s *GrpcMovieServer) CreateMovie(ctx context.Context, req *pb.CreateMovieRe
 	// --- ESTE É O PADRÃO ADAPTER ---
 
 	// 1. Traduzir: Converte a requisição gRPC para o nosso modelo de domínio interno.
-	domainMovie := &service.Movie{
-		Title:    req.GetTitle(),
-		Director: req.GetDirector(),
-		Year:     req.GetYear(),
-	}
+	domainMovie := toDomainMovie(req)
 
 	// 2. Chamar o Núcleo: Executa a lógica de negócio real.
 	// O adaptador não sabe COMO o filme é criado, ele apenas delega para o serviço.
 	createdMovie, err := s.service.CreateMovie(ctx, domainMovie)
 	if err != nil {
-		// Em uma aplicação real, você traduziria o erro para um status code gRPC apropriado.
-		return nil, err
+		// O erro do domínio é traduzido para o status gRPC correspondente (ver errors.go).
+		return nil, toStatusError(ctx, err)
 	}
 
 	// 3. Traduzir de Volta: Converte o resultado do nosso domínio para a resposta gRPC.
-	return &pb.Movie{
-		Id:       createdMovie.ID, // Nota: Ainda precisamos gerar o ID no nosso service!
-		Title:    createdMovie.Title,
-		Director: createdMovie.Director,
-		Year:     createdMovie.Year,
-	}, nil
+	return toProtoMovie(createdMovie), nil
 }
 
-// TODO: Implementar os outros métodos: GetMovie, ListMovies e DeleteMovie.
-
-// ListMovies implementa o método gRPC para listar todos os filmes.
+// ListMovies implementa o método gRPC para listar os filmes de forma paginada.
 func (s *GrpcMovieServer) ListMovies(ctx context.Context, req *pb.ListMoviesRequest) (*pb.ListMoviesResponse, error) {
 	// --- PADRÃO ADAPTER PARA LISTAS ---
 
-	// 1. Chamar o Núcleo: A requisição de entrada (req) é vazia, então não há nada para traduzir.
-	// Chamamos diretamente nossa lógica de negócio para buscar a lista de filmes.
-	domainMovies, err := s.service.ListMovies(ctx)
+	// 1. Traduzir: os parâmetros de paginação, filtro e ordenação viram as opções do nosso domínio.
+	opts := service.ListOptions{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		Filter: service.MovieFilter{
+			YearMin:       req.GetFilter().GetYearMin(),
+			YearMax:       req.GetFilter().GetYearMax(),
+			TitleContains: req.GetFilter().GetTitleContains(),
+			Director:      req.GetFilter().GetDirector(),
+		},
+		OrderBy: req.GetOrderBy(),
+	}
+
+	// 2. Chamar o Núcleo para buscar a página de filmes.
+	page, err := s.service.ListMovies(ctx, opts)
 	if err != nil {
-		return nil, err // Traduzir para um status code gRPC
-	}
-
-	// 2. Traduzir a Saída: Esta é a parte principal. O serviço nos deu uma lista
-	// no formato do nosso domínio ([]*service.Movie). Precisamos convertê-la para o
-	// formato gRPC ([]*pb.Movie).
-	var grpcMovies []*pb.Movie
-
-	// Usamos um loop 'for' para percorrer cada filme do domínio.
-	for _, domainMovie := range domainMovies {
-		// Para cada um, criamos um novo filme no formato gRPC e copiamos os dados.
-		grpcMovie := &pb.Movie{
-			Id:       domainMovie.ID,
-			Title:    domainMovie.Title,
-			Director: domainMovie.Director,
-			Year:     domainMovie.Year,
-		}
-		// Adicionamos o filme convertido à nossa lista gRPC.
-		grpcMovies = append(grpcMovies, grpcMovie)
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 3. Traduzir a Saída: O serviço nos deu uma lista no formato do nosso domínio
+	// ([]*service.Movie). Precisamos convertê-la para o formato gRPC ([]*pb.Movie).
+	grpcMovies := make([]*pb.Movie, 0, len(page.Movies))
+	for _, domainMovie := range page.Movies {
+		grpcMovies = append(grpcMovies, toProtoMovie(domainMovie))
 	}
 
-	// 3. Retornar a Resposta gRPC: O nosso .proto define que a resposta é um
-	// objeto ListMoviesResponse, que por sua ve contém uma lista de filmes.
+	// 4. Retornar a Resposta gRPC com a página e as informações para buscar a próxima.
 	return &pb.ListMoviesResponse{
-		Movies: grpcMovies,
+		Movies:        grpcMovies,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
 	}, nil
 }
 
-// GetMovie implementa o método gRPC para buscar um filme por ID.
-func (s *GrpcMovieServer) GetMovie(ctx context.Context, req *pb.GetMovieRequest) (*pb.Movie, error) {
-	// 1. Extrair o Parâmetro: Pegamos o ID da requisição gRPC.
-	movieID := req.GetId()
-	if movieID == "" {
-		// É uma boa prática validar a entrada. Se o ID estiver vazio, retornamos um erro claro.
-		return nil, status.Errorf(codes.InvalidArgument, "O ID do filme não pode ser vazio")
+// StreamMovies implementa o método gRPC de streaming: cada filme é enviado ao cliente
+// assim que é lido do banco, sem montar uma resposta única com todos eles.
+func (s *GrpcMovieServer) StreamMovies(req *pb.StreamMoviesRequest, stream pb.MovieService_StreamMoviesServer) error {
+	filter := service.MovieFilter{
+		YearMin:       req.GetFilter().GetYearMin(),
+		YearMax:       req.GetFilter().GetYearMax(),
+		TitleContains: req.GetFilter().GetTitleContains(),
+		Director:      req.GetFilter().GetDirector(),
 	}
 
-	// 2. Chamar o Núcleo: Passamos o ID para a nossa lógica de negócio.
-	domainMovie, err := s.service.GetMovie(ctx, movieID)
+	err := s.service.StreamMovies(stream.Context(), filter, func(domainMovie *service.Movie) error {
+		return stream.Send(toProtoMovie(domainMovie))
+	})
 	if err != nil {
-		// Se houver um erro do banco de dados (ex: conexão caiu), repassamos o erro.
-		return nil, status.Errorf(codes.Internal, "Erro interno ao buscar o filme: %v", err)
+		// Se o cliente desistiu no meio do stream, o status reflete o cancelamento,
+		// e não o erro (de envio, por exemplo) que ele acabou causando.
+		if ctxErr := stream.Context().Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return toStatusError(stream.Context(), err)
 	}
+	return nil
+}
 
-	// 3. Lidar com o "Não Encontrado": Este é o caso especial.
-	// O nosso repositório retorna (nil, nil) se o filme não for encontrado.
-	// O serviço repassa isso. Aqui, nós traduzimos essa resposta para um erro gRPC padrão.
-	if domainMovie == nil {
-		return nil, status.Errorf(codes.NotFound, "Filme com o ID '%s' não encontrado", movieID)
+// SearchMovies implementa o método gRPC para a busca textual por título.
+func (s *GrpcMovieServer) SearchMovies(ctx context.Context, req *pb.SearchMoviesRequest) (*pb.SearchMoviesResponse, error) {
+	// 1. Traduzir e Chamar o Núcleo
+	page, err := s.service.SearchMovies(ctx, service.SearchOptions{
+		Query:     req.GetQuery(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
 	}
 
-	// 4. Traduzir a Saída: Se encontramos o filme, convertemos do nosso formato de domínio
-	// para o formato de resposta gRPC, como já fizemos antes.
-	return &pb.Movie{
-		Id:       domainMovie.ID,
-		Title:    domainMovie.Title,
-		Director: domainMovie.Director,
-		Year:     domainMovie.Year,
+	// 2. Traduzir a Saída, mantendo a pontuação de cada resultado.
+	results := make([]*pb.MovieSearchResult, 0, len(page.Results))
+	for _, result := range page.Results {
+		results = append(results, &pb.MovieSearchResult{
+			Movie: toProtoMovie(result.Movie),
+			Score: result.Score,
+		})
+	}
+
+	return &pb.SearchMoviesResponse{
+		Results:       results,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
 	}, nil
 }
 
-// DeleteMovie implementa o método gRPC para deletar um filme por ID.
-func (s *GrpcMovieServer) DeleteMovie(ctx context.Context, req *pb.DeleteMovieRequest) (*pb.DeleteMovieResponse, error) {
-	// 1. Extrair e Validar o Parâmetro
-	movieID := req.GetId()
-	if movieID == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "O ID do filme não pode ser vazio")
+// GetMovie implementa o método gRPC para buscar um filme por ID.
+func (s *GrpcMovieServer) GetMovie(ctx context.Context, req *pb.GetMovieRequest) (*pb.Movie, error) {
+	// 1. Chamar o Núcleo: Passamos o ID da requisição para a nossa lógica de negócio.
+	// O serviço valida o ID e retorna um erro da categoria ErrNotFound se o filme não existir;
+	// toStatusError traduz isso para o código gRPC NotFound.
+	domainMovie, err := s.service.GetMovie(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 2. Traduzir a Saída: Se encontramos o filme, convertemos do nosso formato de domínio
+	// para o formato de resposta gRPC, como já fizemos antes.
+	return toProtoMovie(domainMovie), nil
+}
+
+// UpdateMovie implementa o método gRPC para atualizar um filme existente.
+func (s *GrpcMovieServer) UpdateMovie(ctx context.Context, req *pb.UpdateMovieRequest) (*pb.Movie, error) {
+	// 1. Traduzir: Converte a requisição gRPC para o nosso modelo de domínio.
+	domainMovie := toDomainMovie(req)
+	domainMovie.ID = req.GetId()
+
+	// 2. Chamar o Núcleo. Erros de validação viram InvalidArgument e um filme
+	// inexistente vira NotFound, da mesma forma que no GetMovie.
+	updatedMovie, err := s.service.UpdateMovie(ctx, domainMovie)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
 	}
 
+	// 3. Traduzir a Saída
+	return toProtoMovie(updatedMovie), nil
+}
+
+// PatchMovie implementa o método gRPC para atualizar parcialmente um filme.
+func (s *GrpcMovieServer) PatchMovie(ctx context.Context, req *pb.PatchMovieRequest) (*pb.Movie, error) {
+	// 1. Traduzir: os valores novos e a lista de campos que devem ser aplicados.
+	patch := toDomainMovie(req.GetMovie())
+	paths := req.GetUpdateMask().GetPaths()
+
 	// 2. Chamar o Núcleo
-	err := s.service.DeleteMovie(ctx, movieID)
+	patchedMovie, err := s.service.PatchMovie(ctx, req.GetId(), patch, paths)
 	if err != nil {
-		// Aqui poderíamos ter uma lógica para checar se o erro foi porque o filme não existia
-		// e retornar um 'NotFound', ou se foi um erro interno. Por simplicidade,
-		// trataremos qualquer erro do serviço como um erro interno por enquanto.
-		return nil, status.Errorf(codes.Internal, "Erro interno ao deletar o filme: %v", err)
+		return nil, toStatusError(ctx, err)
 	}
 
-	// 3. Retornar a Resposta de Sucesso
+	// 3. Traduzir a Saída
+	return toProtoMovie(patchedMovie), nil
+}
+
+// DeleteMovie implementa o método gRPC para deletar um filme por ID.
+func (s *GrpcMovieServer) DeleteMovie(ctx context.Context, req *pb.DeleteMovieRequest) (*pb.DeleteMovieResponse, error) {
+	// 1. Chamar o Núcleo
+	err := s.service.DeleteMovie(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 2. Retornar a Resposta de Sucesso
 	// Conforme definido no nosso .proto, a resposta para um delete bem-sucedido
 	// é uma mensagem vazia. Apenas retornamos a struct de resposta vazia.
 	return &pb.DeleteMovieResponse{}, nil
 }
+
+// BatchCreateMovies implementa o método gRPC para criar vários filmes de uma vez.
+func (s *GrpcMovieServer) BatchCreateMovies(ctx context.Context, req *pb.BatchCreateMoviesRequest) (*pb.BatchMoviesResponse, error) {
+	// 1. Traduzir: cada item da requisição vira um filme do domínio.
+	domainMovies := make([]*service.Movie, 0, len(req.GetMovies()))
+	for _, item := range req.GetMovies() {
+		domainMovies = append(domainMovies, toDomainMovie(item))
+	}
+
+	// 2. Chamar o Núcleo. Só os erros do lote inteiro (ex: tamanho) encerram a chamada;
+	// os erros de cada filme vêm nos resultados.
+	results, err := s.service.BatchCreateMovies(ctx, domainMovies)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 3. Traduzir a Saída
+	return toBatchResponse(ctx, results), nil
+}
+
+// BatchGetMovies implementa o método gRPC para buscar vários filmes por ID.
+func (s *GrpcMovieServer) BatchGetMovies(ctx context.Context, req *pb.BatchGetMoviesRequest) (*pb.BatchMoviesResponse, error) {
+	results, err := s.service.BatchGetMovies(ctx, req.GetIds())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toBatchResponse(ctx, results), nil
+}
+
+// BatchDeleteMovies implementa o método gRPC para deletar vários filmes por ID.
+func (s *GrpcMovieServer) BatchDeleteMovies(ctx context.Context, req *pb.BatchDeleteMoviesRequest) (*pb.BatchMoviesResponse, error) {
+	results, err := s.service.BatchDeleteMovies(ctx, req.GetIds())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toBatchResponse(ctx, results), nil
+}
+
+// toBatchResponse converte os resultados de um lote do domínio para a resposta gRPC,
+// mantendo a ordem dos itens. ctx é o contexto da chamada, usado no log dos erros internos.
+func toBatchResponse(ctx context.Context, results []service.BatchResult) *pb.BatchMoviesResponse {
+	response := &pb.BatchMoviesResponse{Results: make([]*pb.BatchResult, 0, len(results))}
+	for _, result := range results {
+		item := &pb.BatchResult{Id: result.ID, Error: toItemError(ctx, result.Err)}
+		if result.Movie != nil {
+			item.Movie = toProtoMovie(result.Movie)
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response
+}
+
+// RestoreMovie implementa o método gRPC para desfazer a exclusão lógica de um filme.
+func (s *GrpcMovieServer) RestoreMovie(ctx context.Context, req *pb.RestoreMovieRequest) (*pb.Movie, error) {
+	// 1. Chamar o Núcleo. Um filme que não existe (ou que já foi apagado de vez) vira NotFound.
+	restoredMovie, err := s.service.RestoreMovie(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 2. Traduzir a Saída
+	return toProtoMovie(restoredMovie), nil
+}
+
+// PurgeDeletedMovies implementa o método gRPC que apaga de vez os filmes excluídos há mais
+// tempo que a retenção. Sem retenção na requisição, o serviço usa a retenção configurada.
+func (s *GrpcMovieServer) PurgeDeletedMovies(ctx context.Context, req *pb.PurgeDeletedMoviesRequest) (*pb.PurgeDeletedMoviesResponse, error) {
+	// 1. Traduzir: um Duration ausente vira zero, que o serviço entende como "use o padrão".
+	var retention time.Duration
+	if req.GetRetention() != nil {
+		if err := req.GetRetention().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "retenção inválida: %v", err)
+		}
+		retention = req.GetRetention().AsDuration()
+	}
+
+	// 2. Chamar o Núcleo
+	purged, err := s.service.PurgeDeletedMovies(ctx, retention)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 3. Retornar a quantidade de filmes apagados
+	return &pb.PurgeDeletedMoviesResponse{PurgedCount: purged}, nil
+}
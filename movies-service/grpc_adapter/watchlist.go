@@ -0,0 +1,62 @@
+// Local: movies-service/grpc_adapter/watchlist.go
+
+package grpc_adapter
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+	pb "github.com/alenrique/Movies-microservices/proto"
+)
+
+// GrpcWatchlistServer é a implementação gRPC das listas de interesse.
+// O user_id das requisições é confiado ao gateway, que o tira do token de acesso.
+type GrpcWatchlistServer struct {
+	pb.UnimplementedWatchlistServiceServer
+	service service.WatchlistService
+}
+
+// NewGrpcWatchlistServer é o construtor do servidor gRPC das listas de interesse.
+func NewGrpcWatchlistServer(svc service.WatchlistService) *GrpcWatchlistServer {
+	return &GrpcWatchlistServer{service: svc}
+}
+
+// AddToWatchlist implementa o método gRPC para incluir um filme na lista do usuário.
+func (s *GrpcWatchlistServer) AddToWatchlist(ctx context.Context, req *pb.AddToWatchlistRequest) (*pb.WatchlistItem, error) {
+	item, err := s.service.AddToWatchlist(ctx, req.GetUserId(), req.GetMovieId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoWatchlistItem(*item), nil
+}
+
+// RemoveFromWatchlist implementa o método gRPC para tirar um filme da lista do usuário.
+func (s *GrpcWatchlistServer) RemoveFromWatchlist(ctx context.Context, req *pb.RemoveFromWatchlistRequest) (*pb.RemoveFromWatchlistResponse, error) {
+	if err := s.service.RemoveFromWatchlist(ctx, req.GetUserId(), req.GetMovieId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return &pb.RemoveFromWatchlistResponse{}, nil
+}
+
+// ListWatchlist implementa o método gRPC que lista os filmes da lista do usuário, paginados.
+func (s *GrpcWatchlistServer) ListWatchlist(ctx context.Context, req *pb.ListWatchlistRequest) (*pb.ListWatchlistResponse, error) {
+	page, err := s.service.ListWatchlist(ctx, req.GetUserId(), int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	response := &pb.ListWatchlistResponse{
+		Items:         make([]*pb.WatchlistItem, 0, len(page.Items)),
+		NextPageToken: page.NextPageToken,
+	}
+	for _, item := range page.Items {
+		response.Items = append(response.Items, toProtoWatchlistItem(item))
+	}
+	return response, nil
+}
+
+// toProtoWatchlistItem traduz um item da lista do domínio para a mensagem gRPC.
+func toProtoWatchlistItem(item service.WatchlistItem) *pb.WatchlistItem {
+	return &pb.WatchlistItem{Movie: toProtoMovie(item.Movie), AddTime: timestamppb.New(item.AddedAt)}
+}
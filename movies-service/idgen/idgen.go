@@ -0,0 +1,80 @@
+// Local: movies-service/idgen/idgen.go
+
+// Package idgen reúne as estratégias de geração de IDs de filmes que não dependem do banco
+// de dados. Todas implementam service.IDAllocator e geram IDs que, comparados como texto,
+// seguem a ordem de criação (o que mantém a paginação por ID em ordem cronológica).
+package idgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// Nomes das estratégias aceitas na configuração (variável de ambiente ID_STRATEGY).
+const (
+	StrategySequence = "sequence" // IDs numéricos sequenciais (padrão).
+	StrategyUUIDv7   = "uuidv7"   // UUID versão 7 (RFC 9562), ordenado pelo tempo.
+	StrategyULID     = "ulid"     // ULID, ordenado pelo tempo, em base32 de Crockford.
+)
+
+// New escolhe o gerador de IDs pelo nome da estratégia.
+// A estratégia sequencial depende do banco, então o seu gerador é recebido pronto.
+// Um nome vazio usa a estratégia sequencial.
+func New(strategy string, sequence service.IDAllocator) (service.IDAllocator, error) {
+	switch strategy {
+	case "", StrategySequence:
+		return sequence, nil
+	case StrategyUUIDv7:
+		return uuidV7Allocator{}, nil
+	case StrategyULID:
+		return ulidAllocator{}, nil
+	}
+	return nil, fmt.Errorf("estratégia de ID desconhecida '%s' (use %s, %s ou %s)",
+		strategy, StrategySequence, StrategyUUIDv7, StrategyULID)
+}
+
+// uuidV7Allocator gera UUIDs v7. uuid.NewV7 é seguro para uso concorrente e garante
+// que IDs gerados no mesmo milissegundo continuam crescentes.
+type uuidV7Allocator struct{}
+
+func (uuidV7Allocator) NextID(ctx context.Context) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (a uuidV7Allocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	return repeat(ctx, a, n)
+}
+
+// ulidAllocator gera ULIDs. ulid.Make usa uma fonte de entropia monotônica protegida
+// por mutex, então também é seguro para uso concorrente.
+type ulidAllocator struct{}
+
+func (ulidAllocator) NextID(ctx context.Context) (string, error) {
+	return ulid.Make().String(), nil
+}
+
+func (a ulidAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	return repeat(ctx, a, n)
+}
+
+// repeat gera n IDs chamando NextID: sem banco envolvido, não há idas a economizar.
+func repeat(ctx context.Context, allocator service.IDAllocator, n int) ([]string, error) {
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		id, err := allocator.NextID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,69 @@
+// Local: movies-service/idgen/idgen_test.go
+
+package idgen_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/movies-service/idgen"
+)
+
+// TestNew_TimeOrderedStrategies gera IDs em paralelo com cada estratégia baseada em tempo
+// e verifica que são únicos e que, gerados em sequência, já saem em ordem crescente.
+func TestNew_TimeOrderedStrategies(t *testing.T) {
+	for _, strategy := range []string{idgen.StrategyUUIDv7, idgen.StrategyULID} {
+		t.Run(strategy, func(t *testing.T) {
+			// Arrange
+			allocator, err := idgen.New(strategy, nil)
+			if err != nil {
+				t.Fatalf("Erro inesperado ao criar o gerador: %v", err)
+			}
+			ctx := context.Background()
+
+			// Act: IDs gerados em paralelo.
+			const total = 1000
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			seen := make(map[string]bool, total)
+			for i := 0; i < total; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					id, err := allocator.NextID(ctx)
+					if err != nil {
+						t.Errorf("Erro inesperado ao gerar ID: %v", err)
+						return
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					if seen[id] {
+						t.Errorf("ID '%s' foi gerado mais de uma vez", id)
+					}
+					seen[id] = true
+				}()
+			}
+			wg.Wait()
+
+			// Act: IDs gerados em sequência.
+			sequence := make([]string, 100)
+			for i := range sequence {
+				sequence[i], _ = allocator.NextID(ctx)
+			}
+
+			// Assert
+			if !sort.StringsAreSorted(sequence) {
+				t.Errorf("Esperava IDs em ordem crescente, mas recebeu %v", sequence)
+			}
+		})
+	}
+}
+
+// TestNew_UnknownStrategy testa se uma estratégia desconhecida é rejeitada.
+func TestNew_UnknownStrategy(t *testing.T) {
+	if _, err := idgen.New("snowflake", nil); err == nil {
+		t.Error("Esperava um erro para uma estratégia desconhecida, mas não recebeu nenhum")
+	}
+}
@@ -5,60 +5,140 @@ package main
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
 	"time"
 
-	"go.mongodb.org/mongo-driver/bson" // Import corrigido
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/alenrique/Movies-microservices/config"
+	"github.com/alenrique/Movies-microservices/logging"
+	"github.com/alenrique/Movies-microservices/metrics"
 	"github.com/alenrique/Movies-microservices/movies-service/database"
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/database/sqlite"
+	"github.com/alenrique/Movies-microservices/movies-service/database/traced"
 	"github.com/alenrique/Movies-microservices/movies-service/grpc_adapter"
+	"github.com/alenrique/Movies-microservices/movies-service/idgen"
 	"github.com/alenrique/Movies-microservices/movies-service/service"
 	pb "github.com/alenrique/Movies-microservices/proto"
+	"github.com/alenrique/Movies-microservices/tracing"
 )
 
 func main() {
+	// --- Configuração ---
+	// Valores padrão, arquivo YAML, variáveis de ambiente e flags, nessa ordem (ver o pacote config).
+	cfg := config.DefaultMovies()
+	config.MustLoad("movies-service", cfg)
+
+	// --- Logs (log/slog) ---
+	// Uma linha JSON por registro, a partir do nível logging.level (LOG_LEVEL). Durante uma
+	// chamada gRPC, as linhas levam o request_id que veio do gateway (ver o pacote logging).
+	logging.Setup("movies-service", cfg.Logging)
+
+	// --- Rastreamento (OpenTelemetry) ---
+	// O exportador é escolhido por tracing.exporter (TRACING_EXPORTER): none, stdout ou otlp.
+	shutdownTracing, err := tracing.Setup(context.Background(), "movies-service", cfg.Tracing)
+	if err != nil {
+		logging.Fatal("falha ao configurar o rastreamento", "error", err)
+	}
+
 	// --- Conexão com o Banco de Dados ---
-	log.Println("movies-service: Conectando ao MongoDB...")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://mongodb:27017"))
+
+	// O adaptador de banco é escolhido por database.driver (DB_DRIVER):
+	// "mongo" (padrão), "memory" (sem nenhuma dependência externa, para desenvolvimento local)
+	// ou "sqlite" (um arquivo local, indicado por SQLITE_PATH).
+	repos := openRepositories(ctx, cfg.Database)
+	defer repos.close()
+
+	// --- Injeção de Dependências ---
+	seedDatabase(ctx, repos.movies)
+
+	// A estratégia de IDs é escolhida por id_strategy (ID_STRATEGY): sequence, uuidv7 ou ulid.
+	// O padrão mantém os IDs numéricos e sequenciais.
+	idAllocator, err := idgen.New(cfg.IDStrategy, repos.sequenceIDs)
 	if err != nil {
-		log.Fatalf("movies-service: Falha ao conectar com o MongoDB: %v", err)
+		logging.Fatal("falha ao criar o gerador de IDs", "error", err)
 	}
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		log.Fatalf("movies-service: Falha ao pingar o MongoDB: %v", err)
+	// Os serviços usam os repositórios com spans (ver o pacote traced); a carga inicial e a
+	// migração dos diretores usam os originais, para não gerar um trace por filme ao subir.
+	instrumented := repos.traced()
+	opts := append(serviceOptions(cfg),
+		service.WithCollections(instrumented.collections), service.WithPeople(instrumented.people), service.WithWatchlists(instrumented.watchlists))
+	if ratings, closeRatings := connectReviews(cfg.ReviewsAddr); ratings != nil {
+		defer closeRatings()
+		opts = append(opts, service.WithRatings(ratings))
 	}
-	log.Println("movies-service: Conectado ao MongoDB com sucesso!")
-
-	// --- Injeção de Dependências ---
-	movieRepo := database.NewMongoMovieRepository(client.Database("moviedb"))
-	seedDatabase(ctx, client, movieRepo)
-	movieService := service.NewMovieService(movieRepo)
+	movieService := service.NewMovieService(instrumented.movies, idAllocator, opts...)
 	movieServer := grpc_adapter.NewGrpcMovieServer(movieService)
 
+	// As coleções e as pessoas sempre usam ULIDs: não precisam de um contador próprio no
+	// banco, e os IDs das pessoas não se confundem com os dos filmes.
+	ulids, _ := idgen.New(idgen.StrategyULID, nil)
+	collectionService := service.NewCollectionService(instrumented.collections, instrumented.movies, ulids)
+	collectionServer := grpc_adapter.NewGrpcCollectionServer(collectionService)
+	peopleService := service.NewPeopleService(instrumented.people, instrumented.movies, ulids)
+	peopleServer := grpc_adapter.NewGrpcPeopleServer(peopleService)
+	migrateDirectors(repos, ulids)
+	watchlistService := service.NewWatchlistService(instrumented.watchlists, instrumented.movies)
+	watchlistServer := grpc_adapter.NewGrpcWatchlistServer(watchlistService)
+
 	// --- Configuração e Desligamento Gracioso do Servidor gRPC ---
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
 	if err != nil {
-		log.Fatalf("movies-service: Falha ao escutar a rede: %v", err)
+		logging.Fatal("falha ao escutar a rede", "addr", cfg.GRPCAddr, "error", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// Os interceptors do pacote metrics medem cada chamada recebida (servidas em /metrics, na
+	// porta de administração), o pacote tracing cria um span para cada uma e o pacote logging
+	// guarda o request_id do gateway no contexto e escreve uma linha de log por chamada.
+	serverOptions := append(metrics.ServerOptions(), tracing.ServerOptions()...)
+	grpcServer := grpc.NewServer(append(serverOptions, logging.ServerOptions()...)...)
 	pb.RegisterMovieServiceServer(grpcServer, movieServer)
+	pb.RegisterCollectionServiceServer(grpcServer, collectionServer)
+	pb.RegisterPeopleServiceServer(grpcServer, peopleServer)
+	pb.RegisterWatchlistServiceServer(grpcServer, watchlistServer)
+
+	// grpc.health.v1: o estado de todos os serviços segue um ping periódico no banco, para que
+	// o gateway (e o orquestrador) saibam quando o movies-service não consegue atendê-los.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	go grpc_adapter.WatchDatabase(watchCtx, healthServer, repos.ping, cfg.HealthInterval,
+		pb.MovieService_ServiceDesc.ServiceName,
+		pb.CollectionService_ServiceDesc.ServiceName,
+		pb.PeopleService_ServiceDesc.ServiceName,
+		pb.WatchlistService_ServiceDesc.ServiceName,
+	)
 
 	// Inicia o servidor em uma goroutine separada
 	go func() {
-		log.Printf("movies-service: Servidor gRPC escutando em %v", lis.Addr())
+		slog.Info("servidor gRPC escutando", "addr", lis.Addr().String())
 		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("movies-service: Falha ao iniciar o servidor gRPC: %v", err)
+			logging.Fatal("falha ao iniciar o servidor gRPC", "error", err)
+		}
+	}()
+
+	// --- Servidor HTTP de Administração ---
+	// As métricas Prometheus ficam em uma porta separada da API gRPC, que não precisa ser
+	// exposta para quem só coleta as métricas.
+	adminServer := newAdminServer(cfg.AdminAddr)
+	go func() {
+		slog.Info("métricas disponíveis em /metrics", "addr", cfg.AdminAddr)
+		if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logging.Fatal("falha ao iniciar o servidor de administração", "error", err)
 		}
 	}()
 
@@ -68,27 +148,236 @@ func main() {
 
 	// Bloqueia a execução até que um sinal seja recebido
 	<-quit
-	log.Println("movies-service: Sinal de desligamento recebido, parando o servidor gRPC...")
+	slog.Info("sinal de desligamento recebido, parando o servidor gRPC")
+
+	// Primeiro, o estado passa para NOT_SERVING (e não muda mais), para que ninguém mande
+	// chamadas novas enquanto as que estão em andamento terminam.
+	stopWatching()
+	healthServer.Shutdown()
+
+	// Tenta parar o servidor de forma graciosa; as chamadas que passarem do prazo são interrompidas.
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(cfg.ShutdownTimeout):
+		slog.Warn("prazo de desligamento esgotado, interrompendo as chamadas em andamento", "timeout", cfg.ShutdownTimeout)
+		grpcServer.Stop()
+	}
+
+	slog.Info("servidor gRPC parado")
+
+	// O servidor de administração sai por último, para que a coleta ainda veja o desligamento.
+	adminCtx, adminCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer adminCancel()
+	if err := adminServer.Shutdown(adminCtx); err != nil {
+		slog.Error("falha ao parar o servidor de administração", "error", err)
+	}
+	// Envia os spans que ainda estão no buffer do exportador.
+	if err := shutdownTracing(adminCtx); err != nil {
+		slog.Error("falha ao enviar os últimos spans", "error", err)
+	}
+}
+
+// newAdminServer cria o servidor HTTP de administração, que responde em /metrics.
+func newAdminServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+	return &http.Server{Addr: addr, Handler: mux}
+}
+
+// repositories reúne os adaptadores de saída de um mesmo banco.
+type repositories struct {
+	movies      service.MovieRepository
+	collections service.CollectionRepository
+	people      service.PersonRepository
+	watchlists  service.WatchlistRepository
+	migrations  service.MigrationRepository // Registro das migrações de dados já concluídas.
+	sequenceIDs service.IDAllocator         // Gerador de IDs sequenciais dos filmes.
+	ping        grpc_adapter.Pinger         // Confere se o banco está acessível (nil no repositório em memória).
+	system      string                      // Nome do banco nos spans (atributo db.system.name).
+	close       func()                      // Fecha a conexão com o banco.
+}
+
+// traced retorna uma cópia com os repositórios envolvidos pelos spans do OpenTelemetry.
+func (r repositories) traced() repositories {
+	r.movies = traced.Movies(r.movies, r.system)
+	r.collections = traced.Collections(r.collections, r.system)
+	r.people = traced.People(r.people, r.system)
+	r.watchlists = traced.Watchlists(r.watchlists, r.system)
+	return r
+}
+
+// openRepositories cria os adaptadores do banco escolhido e o gerador de IDs sequenciais
+// que combina com eles. A configuração já foi validada, então o driver é sempre conhecido.
+func openRepositories(ctx context.Context, dbConfig config.MoviesDatabase) repositories {
+	switch dbConfig.Driver {
+	case "memory":
+		slog.Info("usando o repositório em memória (os dados não serão persistidos)")
+		repo := memory.NewMovieRepository()
+		return repositories{
+			movies:      repo,
+			collections: memory.NewCollectionRepository(),
+			people:      memory.NewPersonRepository(),
+			watchlists:  memory.NewWatchlistRepository(),
+			migrations:  memory.NewMigrationRepository(),
+			sequenceIDs: memory.NewIDAllocator(repo),
+			system:      "memory",
+			close:       func() {},
+		}
+
+	case "sqlite":
+		path := dbConfig.SQLitePath
+		slog.Info("usando o banco SQLite", "path", path)
+		db, err := sqlite.Open(path)
+		if err != nil {
+			logging.Fatal("falha ao abrir o banco SQLite", "error", err)
+		}
+		repo, err := sqlite.NewMovieRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao aplicar as migrações do SQLite", "error", err)
+		}
+		collections, err := sqlite.NewCollectionRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao aplicar as migrações do SQLite", "error", err)
+		}
+		people, err := sqlite.NewPersonRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao aplicar as migrações do SQLite", "error", err)
+		}
+		watchlists, err := sqlite.NewWatchlistRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao aplicar as migrações do SQLite", "error", err)
+		}
+		migrations, err := sqlite.NewMigrationRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao aplicar as migrações do SQLite", "error", err)
+		}
+		return repositories{
+			movies:      repo,
+			collections: collections,
+			people:      people,
+			watchlists:  watchlists,
+			migrations:  migrations,
+			sequenceIDs: sqlite.NewIDAllocator(db, repo),
+			ping:        db.PingContext,
+			system:      "sqlite",
+			close:       func() { db.Close() },
+		}
+
+	case "mongo":
+		slog.Info("conectando ao MongoDB")
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(dbConfig.Mongo.URI))
+		if err != nil {
+			logging.Fatal("falha ao conectar com o MongoDB", "error", err)
+		}
+		err = client.Ping(ctx, nil)
+		if err != nil {
+			logging.Fatal("falha ao pingar o MongoDB", "error", err)
+		}
+		slog.Info("conectado ao MongoDB", "database", dbConfig.Mongo.Database)
+
+		db := client.Database(dbConfig.Mongo.Database)
+		repo, err := database.NewMongoMovieRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao criar os índices do MongoDB", "error", err)
+		}
+		collections, err := database.NewMongoCollectionRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao criar os índices do MongoDB", "error", err)
+		}
+		people, err := database.NewMongoPersonRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao criar os índices do MongoDB", "error", err)
+		}
+		watchlists, err := database.NewMongoWatchlistRepository(ctx, db)
+		if err != nil {
+			logging.Fatal("falha ao criar os índices do MongoDB", "error", err)
+		}
+		return repositories{
+			movies:      repo,
+			collections: collections,
+			people:      people,
+			watchlists:  watchlists,
+			migrations:  database.NewMongoMigrationRepository(db),
+			sequenceIDs: database.NewMongoIDAllocator(db, repo),
+			ping:        func(ctx context.Context) error { return client.Ping(ctx, nil) },
+			system:      "mongodb",
+			close:       func() { client.Disconnect(context.Background()) },
+		}
+	}
+
+	logging.Fatal("DB_DRIVER desconhecido (use mongo, memory ou sqlite)", "driver", dbConfig.Driver)
+	return repositories{}
+}
+
+// serviceOptions traduz a configuração para as opções do serviço de filmes:
+//   - max_batch_size: quantidade máxima de itens das operações em lote.
+//   - soft_delete: os filmes excluídos podem ser restaurados até serem apagados pela
+//     limpeza (PurgeDeletedMovies), que respeita a retenção de soft_delete_retention.
+func serviceOptions(cfg *config.Movies) []service.Option {
+	opts := []service.Option{service.WithMaxBatchSize(cfg.MaxBatchSize)}
+	if cfg.SoftDelete {
+		slog.Info("exclusão lógica ativada", "retention", cfg.SoftDeleteRetention)
+		opts = append(opts, service.WithSoftDelete(cfg.SoftDeleteRetention))
+	}
+	return opts
+}
+
+// connectReviews cria o cliente do reviews-service, de onde vem a média das avaliações
+// mostrada em GetMovie. Sem reviews_addr (ex: "reviews-service:50052"), os filmes
+// são retornados sem a média. A conexão é preguiçosa: o movies-service sobe mesmo que o
+// reviews-service ainda não esteja no ar.
+func connectReviews(addr string) (service.RatingSource, func()) {
+	if addr == "" {
+		return nil, nil
+	}
+	// O pacote logging repassa o request_id da chamada recebida para o reviews-service.
+	dialOptions := append(metrics.DialOptions(), tracing.DialOptions()...)
+	dialOptions = append(dialOptions, logging.DialOptions()...)
+	dialOptions = append(dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr, dialOptions...)
+	if err != nil {
+		logging.Fatal("REVIEWS_SERVICE_ADDR inválido", "addr", addr, "error", err)
+	}
+	slog.Info("médias das avaliações buscadas no reviews-service", "addr", addr)
+	return grpc_adapter.NewReviewRatingSource(pb.NewReviewServiceClient(conn)), func() { conn.Close() }
+}
 
-	// Tenta parar o servidor de forma graciosa
-	grpcServer.GracefulStop()
+// migrateDirectors transforma os nomes dos diretores dos filmes em pessoas com créditos
+// (ver service.MigrateDirectors). A migração fica registrada no banco ao terminar, então só
+// há trabalho na primeira inicialização depois da atualização; nas seguintes, os créditos
+// são só os que os clientes gravaram. O prazo é separado do da conexão, pois percorre o
+// catálogo inteiro.
+func migrateDirectors(repos repositories, ids service.IDAllocator) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
 
-	log.Println("movies-service: Servidor gRPC parado.")
+	result, err := service.MigrateDirectors(ctx, repos.movies, repos.people, ids, repos.migrations)
+	if err != nil {
+		logging.Fatal("falha ao migrar os diretores para pessoas", "error", err)
+	}
+	if result.MoviesLinked > 0 {
+		slog.Info("diretores migrados", "people_created", result.PeopleCreated, "movies_linked", result.MoviesLinked)
+	}
 }
 
-// SUBSTITUA A FUNÇÃO ANTIGA POR ESTA
-func seedDatabase(ctx context.Context, client *mongo.Client, movieRepo service.MovieRepository) {
-	collection := client.Database("moviedb").Collection("movies")
-	count, err := collection.CountDocuments(ctx, bson.M{})
+// seedDatabase carrega os filmes do movies.json quando o repositório está vazio. Os filmes
+// com exclusão lógica também contam: com todos na lixeira, o seed repetiria os IDs deles.
+func seedDatabase(ctx context.Context, movieRepo service.MovieRepository) {
+	count, err := movieRepo.Count(ctx, service.MovieFilter{IncludeDeleted: true})
 	if err != nil {
-		log.Fatalf("Falha ao contar documentos: %v", err)
+		logging.Fatal("falha ao contar os filmes", "error", err)
 	}
 	if count > 0 {
-		log.Println("O banco de dados já contém dados. Pulo do 'seed'.")
+		slog.Info("o banco de dados já contém dados, pulando o seed", "movies", count)
 		return
 	}
 
-	log.Println("Banco de dados vazio. Iniciando o 'seed' a partir de movies.json...")
+	slog.Info("banco de dados vazio, iniciando o seed a partir de movies.json")
 
 	// 1. Definimos uma struct temporária que espelha o JSON exatamente
 	type movieJSON struct {
@@ -99,14 +388,14 @@ func seedDatabase(ctx context.Context, client *mongo.Client, movieRepo service.M
 
 	file, err := os.Open("movies.json")
 	if err != nil {
-		log.Fatalf("Falha ao abrir movies.json: %v", err)
+		logging.Fatal("falha ao abrir movies.json", "error", err)
 	}
 	defer file.Close()
 
 	// 2. Decodificamos o JSON para a nossa struct temporária
 	var moviesFromJSON []movieJSON
 	if err := json.NewDecoder(file).Decode(&moviesFromJSON); err != nil {
-		log.Fatalf("Falha ao decodificar movies.json: %v", err)
+		logging.Fatal("falha ao decodificar movies.json", "error", err)
 	}
 
 	// 3. Convertemos da struct temporária para a nossa struct de domínio final
@@ -122,8 +411,8 @@ func seedDatabase(ctx context.Context, client *mongo.Client, movieRepo service.M
 
 		err := movieRepo.Save(ctx, movieToSave)
 		if err != nil {
-			log.Printf("Falha ao inserir filme '%s': %v\n", movieToSave.Title, err)
+			slog.Error("falha ao inserir filme", "title", movieToSave.Title, "error", err)
 		}
 	}
-	log.Println("Seed do banco de dados concluído com sucesso!")
+	slog.Info("seed do banco de dados concluído", "movies", len(moviesFromJSON))
 }
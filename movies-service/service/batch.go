@@ -0,0 +1,188 @@
+// Local: movies-service/service/batch.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultMaxBatchSize é a quantidade máxima de itens de uma operação em lote quando
+// ela não é configurada (ver WithMaxBatchSize).
+const DefaultMaxBatchSize = 500
+
+var (
+	// ErrEmptyBatch é retornado quando uma operação em lote não recebe nenhum item.
+	ErrEmptyBatch = errors.New("o lote precisa ter pelo menos um item")
+	// ErrBatchTooLarge é retornado quando uma operação em lote passa do tamanho máximo.
+	ErrBatchTooLarge = errors.New("o lote tem itens demais")
+)
+
+// BatchResult é o resultado de um item de uma operação em lote, na mesma posição do item
+// na entrada. Err é o mesmo erro que a operação individual retornaria para o item
+// (CreateMovie, GetMovie ou DeleteMovie); quando ele é nil, o item foi processado.
+type BatchResult struct {
+	ID    string
+	Movie *Movie // O filme criado ou encontrado; sempre nil no BatchDeleteMovies.
+	Err   error
+}
+
+// BatchCreateMovies cria vários filmes com uma quantidade fixa de idas ao banco, qualquer
+// que seja o tamanho do lote: uma para conferir as pessoas dos créditos, uma para reservar
+// os IDs e uma para salvar. Filmes inválidos são rejeitados individualmente, sem impedir
+// que os outros sejam criados.
+func (s *movieService) BatchCreateMovies(ctx context.Context, movies []*Movie) ([]BatchResult, error) {
+	if err := s.checkBatchSize("movies", len(movies)); err != nil {
+		return nil, err
+	}
+
+	// 1. Valida cada filme.
+	results := make([]BatchResult, len(movies))
+	var credits []Credit
+	for i, movie := range movies {
+		if err := validateMovie(movie); err != nil {
+			results[i].Err = err
+			continue
+		}
+		credits = append(credits, movie.Credits...)
+	}
+
+	// 2. Confere de uma vez as pessoas dos créditos de todos os filmes válidos. Uma pessoa
+	// inexistente rejeita apenas os filmes que a citam; falhas do banco abortam o lote.
+	exists, err := s.existingPeople(ctx, credits)
+	if err != nil {
+		return nil, err
+	}
+	valid := make([]*Movie, 0, len(movies))
+	positions := make([]int, 0, len(movies))
+	for i, movie := range movies {
+		if results[i].Err != nil {
+			continue
+		}
+		if err := missingPerson(movie.Credits, exists); err != nil {
+			results[i].Err = err
+			continue
+		}
+		valid = append(valid, movie)
+		positions = append(positions, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	// 3. Reserva os IDs de todos os filmes válidos em um único passo.
+	newIDs, err := s.ids.NextIDs(ctx, len(valid))
+	if err != nil {
+		return nil, err
+	}
+	for j, movie := range valid {
+		movie.ID = newIDs[j]
+	}
+
+	// 4. Salva todos os válidos de uma vez e distribui os erros de volta às posições originais.
+	errs, err := s.repo.SaveMany(ctx, valid)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range positions {
+		results[i] = BatchResult{ID: valid[j].ID, Movie: valid[j], Err: errs[j]}
+		if errs[j] != nil {
+			results[i].Movie = nil
+		}
+	}
+	return results, nil
+}
+
+// BatchGetMovies busca vários filmes por ID com uma única consulta. Um ID inexistente
+// resulta em um erro da categoria ErrNotFound apenas no seu item.
+func (s *movieService) BatchGetMovies(ctx context.Context, ids []string) ([]BatchResult, error) {
+	if err := s.checkBatchSize("ids", len(ids)); err != nil {
+		return nil, err
+	}
+	movies, err := s.repo.FindByIDs(ctx, uniqueIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]*Movie, len(movies))
+	for _, movie := range movies {
+		found[movie.ID] = movie
+	}
+
+	results := make([]BatchResult, len(ids))
+	for i, id := range ids {
+		results[i].ID = id
+		switch movie, ok := found[id]; {
+		case id == "":
+			results[i].Err = invalidArgument("id", ErrEmptyID)
+		case !ok:
+			results[i].Err = movieNotFound(id)
+		default:
+			copied := *movie
+			results[i].Movie = &copied
+		}
+	}
+	return results, nil
+}
+
+// BatchDeleteMovies exclui vários filmes de uma vez, da mesma forma que DeleteMovie
+// (com exclusão lógica, se ela estiver ativada, e com a limpeza das referências antes da
+// exclusão definitiva). Um ID repetido no lote é excluído uma vez só, e todas as suas
+// posições informam sucesso.
+func (s *movieService) BatchDeleteMovies(ctx context.Context, ids []string) ([]BatchResult, error) {
+	if err := s.checkBatchSize("ids", len(ids)); err != nil {
+		return nil, err
+	}
+
+	unique := uniqueIDs(ids)
+	var deletedIDs []string
+	var err error
+	if s.softDelete {
+		deletedIDs, err = s.repo.SoftDeleteByIDs(ctx, unique, s.now())
+	} else if err = s.removeReferences(ctx, unique); err == nil {
+		deletedIDs, err = s.repo.DeleteByIDs(ctx, unique)
+	}
+	if err != nil {
+		return nil, err
+	}
+	deleted := make(map[string]bool, len(deletedIDs))
+	for _, id := range deletedIDs {
+		deleted[id] = true
+	}
+
+	results := make([]BatchResult, len(ids))
+	for i, id := range ids {
+		results[i].ID = id
+		switch {
+		case id == "":
+			results[i].Err = invalidArgument("id", ErrEmptyID)
+		case !deleted[id]:
+			results[i].Err = movieNotFound(id)
+		}
+	}
+	return results, nil
+}
+
+// checkBatchSize valida a quantidade de itens de um lote. field é o nome da lista na requisição.
+func (s *movieService) checkBatchSize(field string, size int) error {
+	if size == 0 {
+		return invalidArgument(field, ErrEmptyBatch)
+	}
+	if size > s.maxBatchSize {
+		return invalidArgument(field, fmt.Errorf("%w: %d (o máximo é %d)", ErrBatchTooLarge, size, s.maxBatchSize))
+	}
+	return nil
+}
+
+// uniqueIDs retorna os IDs sem os vazios e sem repetições, para a consulta ao repositório.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,338 @@
+// Local: movies-service/service/collections.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Collection agrupa filmes de uma mesma franquia ou série (ex: "Matrix", "Star Wars").
+// MovieIDs guarda a ordem dos filmes na coleção, definida por quem a monta (e não pelo ano).
+//
+// Version é incrementado a cada alteração e serve para o controle de concorrência otimista:
+// duas alterações simultâneas da mesma coleção nunca se sobrescrevem (ver CollectionRepository).
+type Collection struct {
+	ID          string   `json:"id" bson:"id"`
+	Name        string   `json:"name" bson:"name"`
+	Description string   `json:"description,omitempty" bson:"description,omitempty"`
+	MovieIDs    []string `json:"movie_ids" bson:"movie_ids"`
+	Version     int64    `json:"-" bson:"version"`
+}
+
+// CollectionRepository é a porta de saída para persistir as coleções.
+type CollectionRepository interface {
+	// Save insere uma coleção nova com Version 1. Um ID repetido é um erro da categoria ErrConflict.
+	Save(ctx context.Context, collection *Collection) error
+	// FindByID retorna (nil, nil) se a coleção não existir.
+	FindByID(ctx context.Context, id string) (*Collection, error)
+	// FindAll retorna todas as coleções, ordenadas por ID.
+	FindAll(ctx context.Context) ([]*Collection, error)
+	// Update substitui a coleção somente se a versão guardada ainda for collection.Version,
+	// e retorna a coleção com a versão seguinte. Retorna (nil, nil) se a coleção não existir
+	// e um erro da categoria ErrConflict se ela foi alterada por outra requisição.
+	Update(ctx context.Context, collection *Collection) (*Collection, error)
+	// DeleteByID retorna false se a coleção não existir.
+	DeleteByID(ctx context.Context, id string) (bool, error)
+	// RemoveMovies tira os filmes de todas as coleções em que eles aparecem.
+	RemoveMovies(ctx context.Context, movieIDs []string) error
+}
+
+// CollectionService é a porta de entrada das coleções.
+type CollectionService interface {
+	CreateCollection(ctx context.Context, collection *Collection) (*Collection, error)
+	GetCollection(ctx context.Context, id string) (*Collection, error)
+	ListCollections(ctx context.Context) ([]*Collection, error)
+	UpdateCollection(ctx context.Context, collection *Collection) (*Collection, error)
+	DeleteCollection(ctx context.Context, id string) error
+	AddMovie(ctx context.Context, collectionID, movieID string, position int) (*Collection, error)
+	RemoveMovie(ctx context.Context, collectionID, movieID string) (*Collection, error)
+	ListCollectionMovies(ctx context.Context, collectionID string) ([]*Movie, error)
+}
+
+// Limites das coleções.
+const (
+	MaxCollectionNameLength        = 200
+	MaxCollectionDescriptionLength = 2000
+	MaxCollectionMovies            = 1000
+)
+
+// Erros específicos das coleções. Os de validação pertencem à categoria ErrInvalidArgument.
+var (
+	ErrEmptyCollectionName    = errors.New("o nome da coleção não pode ser vazio")
+	ErrCollectionNameTooLong  = fmt.Errorf("o nome da coleção pode ter no máximo %d caracteres", MaxCollectionNameLength)
+	ErrDescriptionTooLong     = fmt.Errorf("a descrição pode ter no máximo %d caracteres", MaxCollectionDescriptionLength)
+	ErrTooManyCollectionItems = fmt.Errorf("uma coleção pode ter no máximo %d filmes", MaxCollectionMovies)
+	ErrDuplicateMovie         = errors.New("o filme aparece mais de uma vez na coleção")
+	ErrInvalidPosition        = errors.New("a posição não pode ser negativa")
+)
+
+// maxUpdateAttempts é quantas vezes uma alteração é refeita quando outra requisição alterou
+// a mesma coleção no meio do caminho (ver mutate).
+const maxUpdateAttempts = 5
+
+type collectionService struct {
+	collections CollectionRepository // Porta de saída das coleções.
+	movies      MovieRepository      // Usada para conferir e buscar os filmes das coleções.
+	ids         IDAllocator          // Gera os IDs das coleções novas.
+}
+
+// NewCollectionService cria o serviço de coleções. Ele recebe também o repositório de filmes,
+// pois só aceita filmes que existem e devolve os filmes completos de uma coleção.
+func NewCollectionService(collections CollectionRepository, movies MovieRepository, ids IDAllocator) CollectionService {
+	return &collectionService{collections: collections, movies: movies, ids: ids}
+}
+
+// CreateCollection cria uma coleção, opcionalmente já com os seus filmes, na ordem dada.
+// Um filme inexistente é um erro da categoria ErrNotFound.
+func (s *collectionService) CreateCollection(ctx context.Context, collection *Collection) (*Collection, error) {
+	if err := validateCollection(collection); err != nil {
+		return nil, err
+	}
+	if err := s.checkMoviesExist(ctx, collection.MovieIDs); err != nil {
+		return nil, err
+	}
+
+	newID, err := s.ids.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collection.ID = newID
+	if collection.MovieIDs == nil {
+		collection.MovieIDs = []string{}
+	}
+	if err := s.collections.Save(ctx, collection); err != nil {
+		return nil, err
+	}
+	collection.Version = 1
+	return collection, nil
+}
+
+// GetCollection busca uma coleção. Retorna um erro da categoria ErrNotFound se ela não existir.
+func (s *collectionService) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	if id == "" {
+		return nil, invalidArgument("id", ErrEmptyID)
+	}
+	collection, err := s.collections.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if collection == nil {
+		return nil, collectionNotFound(id)
+	}
+	return collection, nil
+}
+
+// ListCollections retorna todas as coleções, ordenadas por ID.
+func (s *collectionService) ListCollections(ctx context.Context) ([]*Collection, error) {
+	return s.collections.FindAll(ctx)
+}
+
+// UpdateCollection altera o nome e a descrição de uma coleção. Os filmes não mudam: eles
+// são alterados um a um com AddMovie e RemoveMovie.
+func (s *collectionService) UpdateCollection(ctx context.Context, collection *Collection) (*Collection, error) {
+	if collection.ID == "" {
+		return nil, invalidArgument("id", ErrEmptyID)
+	}
+	if err := validateCollection(&Collection{Name: collection.Name, Description: collection.Description}); err != nil {
+		return nil, err
+	}
+	return s.mutate(ctx, collection.ID, func(current *Collection) error {
+		current.Name = collection.Name
+		current.Description = collection.Description
+		return nil
+	})
+}
+
+// DeleteCollection apaga uma coleção. Os filmes dela não são afetados.
+func (s *collectionService) DeleteCollection(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidArgument("id", ErrEmptyID)
+	}
+	deleted, err := s.collections.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return collectionNotFound(id)
+	}
+	return nil
+}
+
+// AddMovie coloca um filme na coleção. position começa em 1; zero (ou uma posição além do
+// fim) coloca o filme no final. Se o filme já estiver na coleção, ele é movido para a
+// posição pedida, então repetir a mesma chamada não muda nada.
+func (s *collectionService) AddMovie(ctx context.Context, collectionID, movieID string, position int) (*Collection, error) {
+	if collectionID == "" {
+		return nil, invalidArgument("collection_id", ErrEmptyID)
+	}
+	if movieID == "" {
+		return nil, invalidArgument("movie_id", ErrEmptyID)
+	}
+	if position < 0 {
+		return nil, invalidArgument("position", ErrInvalidPosition)
+	}
+	if err := s.checkMoviesExist(ctx, []string{movieID}); err != nil {
+		return nil, err
+	}
+
+	return s.mutate(ctx, collectionID, func(current *Collection) error {
+		movieIDs := without(current.MovieIDs, movieID)
+		if len(movieIDs) >= MaxCollectionMovies {
+			return invalidArgument("movie_id", ErrTooManyCollectionItems)
+		}
+		index := len(movieIDs)
+		if position > 0 && position-1 < index {
+			index = position - 1
+		}
+		movieIDs = append(movieIDs, "")
+		copy(movieIDs[index+1:], movieIDs[index:])
+		movieIDs[index] = movieID
+		current.MovieIDs = movieIDs
+		return nil
+	})
+}
+
+// RemoveMovie tira um filme da coleção. Retorna um erro da categoria ErrNotFound se a
+// coleção não existir ou se o filme não fizer parte dela.
+func (s *collectionService) RemoveMovie(ctx context.Context, collectionID, movieID string) (*Collection, error) {
+	if collectionID == "" {
+		return nil, invalidArgument("collection_id", ErrEmptyID)
+	}
+	if movieID == "" {
+		return nil, invalidArgument("movie_id", ErrEmptyID)
+	}
+	return s.mutate(ctx, collectionID, func(current *Collection) error {
+		movieIDs := without(current.MovieIDs, movieID)
+		if len(movieIDs) == len(current.MovieIDs) {
+			return &NotFoundError{Resource: "filme na coleção", ID: movieID}
+		}
+		current.MovieIDs = movieIDs
+		return nil
+	})
+}
+
+// ListCollectionMovies retorna os filmes da coleção, na ordem dela. Filmes com exclusão
+// lógica ficam de fora até serem restaurados.
+func (s *collectionService) ListCollectionMovies(ctx context.Context, collectionID string) ([]*Movie, error) {
+	if collectionID == "" {
+		return nil, invalidArgument("collection_id", ErrEmptyID)
+	}
+	collection, err := s.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	movies := make([]*Movie, 0, len(collection.MovieIDs))
+	if len(collection.MovieIDs) == 0 {
+		return movies, nil
+	}
+
+	// Uma única consulta para todos os filmes; o repositório os devolve ordenados por ID.
+	found, err := s.movies.FindByIDs(ctx, collection.MovieIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Movie, len(found))
+	for _, movie := range found {
+		byID[movie.ID] = movie
+	}
+	for _, id := range collection.MovieIDs {
+		if movie, ok := byID[id]; ok {
+			movies = append(movies, movie)
+		}
+	}
+	return movies, nil
+}
+
+// mutate aplica change sobre a versão atual da coleção e a salva. Se outra requisição
+// alterar a coleção entre a leitura e a gravação, a alteração é refeita sobre a versão nova.
+func (s *collectionService) mutate(ctx context.Context, id string, change func(current *Collection) error) (*Collection, error) {
+	for attempt := 1; ; attempt++ {
+		current, err := s.GetCollection(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := change(current); err != nil {
+			return nil, err
+		}
+		updated, err := s.collections.Update(ctx, current)
+		switch {
+		case errors.Is(err, ErrConflict) && attempt < maxUpdateAttempts:
+			continue
+		case err != nil:
+			return nil, err
+		case updated == nil:
+			// A coleção foi apagada entre a leitura e a gravação.
+			return nil, collectionNotFound(id)
+		}
+		return updated, nil
+	}
+}
+
+// checkMoviesExist retorna um erro da categoria ErrNotFound com o primeiro filme que não existe.
+func (s *collectionService) checkMoviesExist(ctx context.Context, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return nil
+	}
+	found, err := s.movies.FindByIDs(ctx, movieIDs)
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool, len(found))
+	for _, movie := range found {
+		exists[movie.ID] = true
+	}
+	for _, id := range movieIDs {
+		if !exists[id] {
+			return movieNotFound(id)
+		}
+	}
+	return nil
+}
+
+// validateCollection verifica o nome, a descrição e a lista de filmes de uma coleção.
+func validateCollection(collection *Collection) error {
+	v := &violations{}
+	switch {
+	case collection.Name == "":
+		v.add("name", ErrEmptyCollectionName)
+	case utf8.RuneCountInString(collection.Name) > MaxCollectionNameLength:
+		v.add("name", ErrCollectionNameTooLong)
+	}
+	if utf8.RuneCountInString(collection.Description) > MaxCollectionDescriptionLength {
+		v.add("description", ErrDescriptionTooLong)
+	}
+	if len(collection.MovieIDs) > MaxCollectionMovies {
+		v.add("movie_ids", ErrTooManyCollectionItems)
+	}
+	seen := make(map[string]bool, len(collection.MovieIDs))
+	for i, id := range collection.MovieIDs {
+		field := fmt.Sprintf("movie_ids[%d]", i)
+		switch {
+		case id == "":
+			v.add(field, ErrEmptyID)
+		case seen[id]:
+			v.add(field, ErrDuplicateMovie)
+		}
+		seen[id] = true
+	}
+	return v.err()
+}
+
+// without retorna uma cópia da lista sem o ID.
+func without(ids []string, id string) []string {
+	result := make([]string, 0, len(ids))
+	for _, current := range ids {
+		if current != id {
+			result = append(result, current)
+		}
+	}
+	return result
+}
+
+// collectionNotFound é o erro retornado quando uma coleção não existe.
+func collectionNotFound(id string) error {
+	return &NotFoundError{Resource: "coleção", ID: id}
+}
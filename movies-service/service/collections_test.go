@@ -0,0 +1,254 @@
+// Local: movies-service/service/collections_test.go
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// createMovies cria um filme para cada título e retorna os seus IDs.
+func createMovies(t *testing.T, movieService service.MovieService, titles ...string) []string {
+	t.Helper()
+	ids := make([]string, 0, len(titles))
+	for _, title := range titles {
+		movie, err := movieService.CreateMovie(context.Background(), &service.Movie{Title: title})
+		if err != nil {
+			t.Fatalf("Erro inesperado ao criar o filme '%s': %v", title, err)
+		}
+		ids = append(ids, movie.ID)
+	}
+	return ids
+}
+
+// TestCollections_OrderedMembership testa se a ordem dos filmes da coleção é respeitada ao
+// adicionar, mover e remover filmes.
+func TestCollections_OrderedMembership(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{})
+	collectionService := service.NewCollectionService(memory.NewCollectionRepository(), movies, &fakeIDAllocator{})
+	ids := createMovies(t, movieService, "The Matrix", "The Matrix Reloaded", "The Matrix Revolutions")
+	collection, err := collectionService.CreateCollection(ctx, &service.Collection{Name: "Matrix", MovieIDs: ids[:1]})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao criar a coleção: %v", err)
+	}
+
+	// Act & Assert: sem posição, o filme vai para o final; com posição, entra no lugar pedido.
+	steps := []struct {
+		movieID  string
+		position int
+		want     string
+	}{
+		{ids[2], 0, "[1 3]"},
+		{ids[1], 2, "[1 2 3]"},
+		{ids[2], 1, "[3 1 2]"}, // Um filme que já está na coleção é movido.
+		{ids[2], 1, "[3 1 2]"}, // Repetir a chamada não muda nada.
+		{ids[0], 99, "[3 2 1]"},
+	}
+	for _, step := range steps {
+		updated, err := collectionService.AddMovie(ctx, collection.ID, step.movieID, step.position)
+		if err != nil || fmt.Sprint(updated.MovieIDs) != step.want {
+			t.Fatalf("AddMovie(%s, %d): esperava %s, mas obteve %+v (erro: %v)", step.movieID, step.position, step.want, updated, err)
+		}
+	}
+
+	listed, err := collectionService.ListCollectionMovies(ctx, collection.ID)
+	if err != nil || len(listed) != 3 || listed[0].Title != "The Matrix Revolutions" || listed[2].Title != "The Matrix" {
+		t.Errorf("Esperava os filmes na ordem da coleção, mas obteve %v (erro: %v)", listed, err)
+	}
+
+	updated, err := collectionService.RemoveMovie(ctx, collection.ID, ids[1])
+	if err != nil || fmt.Sprint(updated.MovieIDs) != "[3 1]" {
+		t.Errorf("Esperava [3 1] depois da remoção, mas obteve %+v (erro: %v)", updated, err)
+	}
+	if _, err := collectionService.RemoveMovie(ctx, collection.ID, ids[1]); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao remover um filme que não está na coleção, mas recebeu %v", err)
+	}
+}
+
+// TestCollections_Validation testa as regras de criação e de alteração das coleções.
+func TestCollections_Validation(t *testing.T) {
+	ctx := context.Background()
+	movies := newMovieRepository()
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{})
+	collectionService := service.NewCollectionService(memory.NewCollectionRepository(), movies, &fakeIDAllocator{})
+	ids := createMovies(t, movieService, "Alien")
+
+	tests := []struct {
+		name  string
+		call  func() error
+		field string
+	}{
+		{"nome vazio", func() error {
+			_, err := collectionService.CreateCollection(ctx, &service.Collection{})
+			return err
+		}, "name"},
+		{"filme repetido", func() error {
+			_, err := collectionService.CreateCollection(ctx, &service.Collection{Name: "Alien", MovieIDs: []string{ids[0], ids[0]}})
+			return err
+		}, "movie_ids[1]"},
+		{"posição negativa", func() error {
+			_, err := collectionService.AddMovie(ctx, "1", ids[0], -1)
+			return err
+		}, "position"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr *service.ValidationError
+			if err := tt.call(); !errors.As(err, &validationErr) || validationErr.Violations[0].Field != tt.field {
+				t.Errorf("Esperava uma violação no campo '%s', mas recebeu %v", tt.field, err)
+			}
+		})
+	}
+
+	// Filmes e coleções inexistentes são erros da categoria ErrNotFound.
+	if _, err := collectionService.CreateCollection(ctx, &service.Collection{Name: "Alien", MovieIDs: []string{"42"}}); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao criar uma coleção com um filme inexistente, mas recebeu %v", err)
+	}
+	if _, err := collectionService.AddMovie(ctx, "42", ids[0], 0); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao alterar uma coleção inexistente, mas recebeu %v", err)
+	}
+}
+
+// conflictingCollections simula outra requisição alterando a coleção entre a leitura e a
+// primeira gravação do serviço.
+type conflictingCollections struct {
+	service.CollectionRepository
+	interfered bool
+}
+
+func (r *conflictingCollections) Update(ctx context.Context, collection *service.Collection) (*service.Collection, error) {
+	if !r.interfered {
+		r.interfered = true
+		current, _ := r.CollectionRepository.FindByID(ctx, collection.ID)
+		current.Description = "Alterada por outra requisição"
+		if _, err := r.CollectionRepository.Update(ctx, current); err != nil {
+			return nil, err
+		}
+	}
+	return r.CollectionRepository.Update(ctx, collection)
+}
+
+// TestCollections_RetriesOnConcurrentUpdate testa se uma alteração concorrente não é perdida:
+// o serviço refaz a sua alteração sobre a versão nova da coleção.
+func TestCollections_RetriesOnConcurrentUpdate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{})
+	collections := &conflictingCollections{CollectionRepository: memory.NewCollectionRepository()}
+	collectionService := service.NewCollectionService(collections, movies, &fakeIDAllocator{})
+	ids := createMovies(t, movieService, "Alien")
+	collection, _ := collectionService.CreateCollection(ctx, &service.Collection{Name: "Alien"})
+
+	// Act
+	updated, err := collectionService.AddMovie(ctx, collection.ID, ids[0], 0)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if fmt.Sprint(updated.MovieIDs) != "[1]" || updated.Description != "Alterada por outra requisição" {
+		t.Errorf("Esperava as duas alterações na coleção, mas obteve %+v", updated)
+	}
+}
+
+// TestDeleteMovie_RemovesFromCollections testa se um filme apagado de vez sai das coleções,
+// enquanto um filme com exclusão lógica apenas deixa de aparecer nelas.
+func TestDeleteMovie_RemovesFromCollections(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	movies := newMovieRepository()
+	collections := memory.NewCollectionRepository()
+	softService := service.NewMovieService(movies, &fakeIDAllocator{},
+		service.WithCollections(collections), service.WithSoftDelete(time.Hour), service.WithClock(func() time.Time { return now }))
+	hardService := service.NewMovieService(movies, &fakeIDAllocator{last: 10}, service.WithCollections(collections))
+	collectionService := service.NewCollectionService(collections, movies, &fakeIDAllocator{})
+	ids := createMovies(t, softService, "Alien", "Aliens", "Alien 3")
+	collection, _ := collectionService.CreateCollection(ctx, &service.Collection{Name: "Alien", MovieIDs: ids})
+
+	// Act & Assert: a exclusão lógica mantém o filme na coleção, mas ele não é listado.
+	if err := softService.DeleteMovie(ctx, ids[1]); err != nil {
+		t.Fatalf("Erro inesperado ao excluir: %v", err)
+	}
+	found, _ := collectionService.GetCollection(ctx, collection.ID)
+	listed, _ := collectionService.ListCollectionMovies(ctx, collection.ID)
+	if len(found.MovieIDs) != 3 || len(listed) != 2 {
+		t.Errorf("Esperava 3 IDs e 2 filmes listados, mas obteve %v e %d filmes", found.MovieIDs, len(listed))
+	}
+
+	// A exclusão definitiva e a limpeza tiram os filmes da coleção.
+	if err := hardService.DeleteMovie(ctx, ids[0]); err != nil {
+		t.Fatalf("Erro inesperado ao apagar: %v", err)
+	}
+	now = now.Add(2 * time.Hour)
+	if purged, err := softService.PurgeDeletedMovies(ctx, 0); err != nil || purged != 1 {
+		t.Fatalf("Esperava apagar 1 filme, mas obteve %d (erro: %v)", purged, err)
+	}
+	found, _ = collectionService.GetCollection(ctx, collection.ID)
+	if fmt.Sprint(found.MovieIDs) != fmt.Sprint(ids[2:]) {
+		t.Errorf("Esperava apenas %v na coleção, mas obteve %v", ids[2:], found.MovieIDs)
+	}
+}
+
+// failingCollectionRepository falha ao tirar filmes das coleções enquanto err estiver definido.
+type failingCollectionRepository struct {
+	service.CollectionRepository
+	err error
+}
+
+func (r *failingCollectionRepository) RemoveMovies(ctx context.Context, movieIDs []string) error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.CollectionRepository.RemoveMovies(ctx, movieIDs)
+}
+
+// TestDeleteMovie_CleanupFails testa se uma falha na limpeza das coleções mantém o filme,
+// para que a exclusão possa ser repetida, em vez de falhar com o filme já apagado.
+func TestDeleteMovie_CleanupFails(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	errCleanup := errors.New("banco das coleções fora do ar")
+	movies := newMovieRepository()
+	collections := &failingCollectionRepository{CollectionRepository: memory.NewCollectionRepository(), err: errCleanup}
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{}, service.WithCollections(collections))
+	collectionService := service.NewCollectionService(collections, movies, &fakeIDAllocator{})
+	ids := createMovies(t, movieService, "Alien", "Aliens")
+	collection, _ := collectionService.CreateCollection(ctx, &service.Collection{Name: "Alien", MovieIDs: ids})
+
+	// Act & Assert: com a limpeza falhando, nada é apagado.
+	if err := movieService.DeleteMovie(ctx, ids[0]); !errors.Is(err, errCleanup) {
+		t.Fatalf("Esperava o erro da limpeza, mas recebeu %v", err)
+	}
+	if results, err := movieService.BatchDeleteMovies(ctx, ids); !errors.Is(err, errCleanup) {
+		t.Fatalf("Esperava o erro da limpeza no lote, mas recebeu (%v, %v)", results, err)
+	}
+	for _, id := range ids {
+		if _, err := movieService.GetMovie(ctx, id); err != nil {
+			t.Errorf("Esperava que o filme '%s' continuasse existindo, mas recebeu %v", id, err)
+		}
+	}
+
+	// Quando a limpeza volta a funcionar, repetir a exclusão apaga o filme e o tira da coleção.
+	collections.err = nil
+	if err := movieService.DeleteMovie(ctx, ids[0]); err != nil {
+		t.Fatalf("Erro inesperado ao repetir a exclusão: %v", err)
+	}
+	if _, err := movieService.GetMovie(ctx, ids[0]); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound depois de apagar, mas recebeu %v", err)
+	}
+	found, _ := collectionService.GetCollection(ctx, collection.ID)
+	if fmt.Sprint(found.MovieIDs) != fmt.Sprint(ids[1:]) {
+		t.Errorf("Esperava apenas %v na coleção, mas obteve %v", ids[1:], found.MovieIDs)
+	}
+}
@@ -0,0 +1,95 @@
+// Local: movies-service/service/delete.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultRetention é por quanto tempo um filme excluído é mantido antes de poder ser apagado
+// definitivamente, quando a retenção não é configurada.
+const DefaultRetention = 30 * 24 * time.Hour
+
+// ErrNegativeRetention é retornado quando a limpeza recebe uma retenção negativa.
+var ErrNegativeRetention = errors.New("a retenção não pode ser negativa")
+
+// DeleteMovie exclui um filme. Com a exclusão lógica ativada (WithSoftDelete), o filme só
+// recebe a data da exclusão e deixa de aparecer nas consultas; senão, é apagado de vez.
+// Retorna um erro da categoria ErrNotFound se o filme não existir ou já estiver excluído.
+//
+// Na exclusão definitiva, o filme sai das coleções e das listas de interesse antes de ser
+// apagado: se a limpeza falhar, o filme continua existindo e a exclusão pode ser repetida,
+// em vez de a chamada falhar com o filme já apagado.
+func (s *movieService) DeleteMovie(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidArgument("id", ErrEmptyID)
+	}
+
+	var deleted bool
+	var err error
+	if s.softDelete {
+		deleted, err = s.repo.SoftDeleteByID(ctx, id, s.now())
+	} else if err = s.removeReferences(ctx, []string{id}); err == nil {
+		deleted, err = s.repo.DeleteByID(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return movieNotFound(id)
+	}
+	return nil
+}
+
+// RestoreMovie desfaz a exclusão lógica de um filme e o retorna.
+// Restaurar um filme que não está excluído não muda nada: ele é apenas retornado.
+// Retorna um erro da categoria ErrNotFound se o filme não existir (ou já tiver sido apagado de vez).
+func (s *movieService) RestoreMovie(ctx context.Context, id string) (*Movie, error) {
+	if id == "" {
+		return nil, invalidArgument("id", ErrEmptyID)
+	}
+	movie, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if movie == nil {
+		return s.GetMovie(ctx, id)
+	}
+	return movie, nil
+}
+
+// PurgeDeletedMovies apaga definitivamente os filmes excluídos há mais tempo que a retenção
+// e retorna quantos foram apagados. Uma retenção zero usa a retenção configurada no serviço.
+func (s *movieService) PurgeDeletedMovies(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention < 0 {
+		return 0, invalidArgument("retention", ErrNegativeRetention)
+	}
+	if retention == 0 {
+		retention = s.retention
+	}
+	purged, err := s.repo.PurgeDeleted(ctx, s.now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(purged)), s.removeReferences(ctx, purged)
+}
+
+// removeReferences retira das coleções e das listas de interesse os filmes que vão ser (ou
+// foram) apagados de vez. Uma referência incluída entre a limpeza e a exclusão não atrapalha:
+// as listagens ignoram os filmes que não existem mais.
+func (s *movieService) removeReferences(ctx context.Context, movieIDs []string) error {
+	if len(movieIDs) == 0 {
+		return nil
+	}
+	if s.collections != nil {
+		if err := s.collections.RemoveMovies(ctx, movieIDs); err != nil {
+			return err
+		}
+	}
+	if s.watchlists != nil {
+		return s.watchlists.RemoveMovies(ctx, movieIDs)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Local: movies-service/service/directors.go
+
+package service
+
+import "context"
+
+// DirectorsMigration é o nome com que MigrateDirectors fica registrada no MigrationRepository.
+// O sufixo é a versão: uma mudança de regra que precise rodar de novo entra com um nome novo.
+const DirectorsMigration = "directors_to_credits_v1"
+
+// DirectorMigration resume o que MigrateDirectors fez.
+type DirectorMigration struct {
+	PeopleCreated  int  // Pessoas criadas a partir dos nomes dos diretores.
+	MoviesLinked   int  // Filmes que ganharam o crédito de direção.
+	AlreadyApplied bool // A migração já tinha sido concluída antes, então nada foi feito.
+}
+
+// MigrateDirectors transforma o texto livre do campo Director em créditos: para cada filme
+// com um diretor e sem nenhum crédito de direção, busca a pessoa com esse nome (ou a cria)
+// e adiciona o crédito. O campo Director não é alterado, para não quebrar os clientes que
+// ainda o usam.
+//
+// A migração roda uma única vez: ao terminar, fica registrada em migrations com o nome
+// DirectorsMigration, e as próximas chamadas não fazem nada. Assim, um crédito de direção
+// removido depois por um usuário não volta a cada reinício do serviço. Se a migração for
+// interrompida (ou várias instâncias subirem juntas), rodar de novo é seguro: a pessoa de
+// cada nome é criada uma única vez (FindOrCreateByName) e o crédito só é gravado se os
+// créditos do filme não mudaram desde a leitura (SetCredits), sem tocar nos outros campos.
+//
+// Os nomes são comparados depois de tirar os espaços sobrando, mas sem nenhuma outra
+// normalização: "Nolan" e "Christopher Nolan" viram duas pessoas, que podem ser unidas
+// depois trocando os créditos.
+func MigrateDirectors(ctx context.Context, movies MovieRepository, people PersonRepository, ids IDAllocator, migrations MigrationRepository) (DirectorMigration, error) {
+	var result DirectorMigration
+	applied, err := migrations.IsApplied(ctx, DirectorsMigration)
+	if err != nil || applied {
+		result.AlreadyApplied = applied
+		return result, err
+	}
+
+	// 1. Junta os filmes pendentes antes de alterá-los, para não gravar no meio do streaming.
+	var pending []*Movie
+	err = movies.Stream(ctx, MovieFilter{}, func(movie *Movie) error {
+		if normalizeName(movie.Director) != "" && !hasRole(movie, RoleDirector) {
+			pending = append(pending, movie)
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// 2. Liga cada filme à pessoa com o nome do diretor. O mapa evita buscar o mesmo nome várias vezes.
+	personIDs := make(map[string]string)
+	for _, movie := range pending {
+		name := normalizeName(movie.Director)
+		personID, ok := personIDs[name]
+		if !ok {
+			person, err := findOrCreatePerson(ctx, people, ids, name, &result)
+			if err != nil {
+				return result, err
+			}
+			personID = person.ID
+			personIDs[name] = personID
+		}
+
+		credits := append(append([]Credit(nil), movie.Credits...), Credit{PersonID: personID, Role: RoleDirector})
+		linked, err := movies.SetCredits(ctx, movie.ID, movie.Credits, credits)
+		if err != nil {
+			return result, err
+		}
+		// Um filme apagado ou com os créditos alterados depois do streaming simplesmente
+		// não é migrado: quem o alterou já decidiu quais créditos ele tem.
+		if linked {
+			result.MoviesLinked++
+		}
+	}
+
+	// 3. Registra a migração só no final, para que uma execução interrompida seja retomada.
+	return result, migrations.MarkApplied(ctx, DirectorsMigration)
+}
+
+// findOrCreatePerson retorna a pessoa com o nome, criando-a se ainda não existir. A busca
+// vem antes para não gastar um ID com os nomes que já existem; o ID só é descartado se
+// outra instância criar a mesma pessoa entre a busca e a inserção.
+func findOrCreatePerson(ctx context.Context, people PersonRepository, ids IDAllocator, name string, result *DirectorMigration) (*Person, error) {
+	person, err := people.FindByName(ctx, name)
+	if err != nil || person != nil {
+		return person, err
+	}
+	candidate := &Person{Name: name}
+	if candidate.ID, err = ids.NextID(ctx); err != nil {
+		return nil, err
+	}
+	person, created, err := people.FindOrCreateByName(ctx, candidate)
+	if created {
+		result.PeopleCreated++
+	}
+	return person, err
+}
+
+// hasRole indica se algum crédito do filme tem a função dada.
+func hasRole(movie *Movie, role string) bool {
+	for _, credit := range movie.Credits {
+		if credit.Role == role {
+			return true
+		}
+	}
+	return false
+}
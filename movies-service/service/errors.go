@@ -0,0 +1,69 @@
+// Local: movies-service/service/errors.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Categorias de erro do domínio. Todo erro retornado pelo serviço que não seja uma falha
+// de infraestrutura pertence a uma delas, e os adaptadores de entrada (como o gRPC) usam
+// errors.Is com as categorias para escolher o código de status, sem precisar conhecer
+// cada erro específico (ErrEmptyTitle, ErrInvalidPageToken...).
+var (
+	// ErrNotFound indica que o recurso pedido não existe.
+	ErrNotFound = errors.New("recurso não encontrado")
+	// ErrInvalidArgument indica que a requisição tem dados inválidos.
+	ErrInvalidArgument = errors.New("argumento inválido")
+	// ErrConflict indica que a operação conflita com o estado atual (ex: um ID que já existe).
+	// Os repositórios embrulham os seus erros de chave duplicada com ele.
+	ErrConflict = errors.New("conflito com o estado atual")
+)
+
+// FieldViolation descreve o problema de um campo da requisição.
+// Field usa o nome do campo no .proto (ex: "title", "filter.year_min").
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError é um erro da categoria ErrInvalidArgument com a lista de campos inválidos.
+// Err é o erro específico: errors.Is funciona tanto com ele quanto com ErrInvalidArgument.
+type ValidationError struct {
+	Err        error
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// Is faz com que errors.Is(err, ErrInvalidArgument) seja verdadeiro.
+func (e *ValidationError) Is(target error) bool { return target == ErrInvalidArgument }
+
+// NotFoundError é um erro da categoria ErrNotFound que informa qual recurso faltou.
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s com o ID '%s' não encontrado", e.Resource, e.ID)
+}
+
+// Is faz com que errors.Is(err, ErrNotFound) seja verdadeiro.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// invalidArgument cria um ValidationError com uma única violação no campo dado.
+func invalidArgument(field string, err error) error {
+	return &ValidationError{
+		Err:        err,
+		Violations: []FieldViolation{{Field: field, Description: err.Error()}},
+	}
+}
+
+// movieNotFound é o erro retornado quando um filme não existe.
+func movieNotFound(id string) error {
+	return &NotFoundError{Resource: "filme", ID: id}
+}
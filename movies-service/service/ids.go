@@ -0,0 +1,15 @@
+// Local: movies-service/service/ids.go
+
+package service
+
+import "context"
+
+// IDAllocator é a porta de saída responsável por gerar os IDs dos filmes novos.
+// As implementações precisam ser seguras para uso concorrente: duas chamadas
+// simultâneas a NextID (ou a NextIDs) nunca podem devolver o mesmo ID.
+type IDAllocator interface {
+	NextID(ctx context.Context) (string, error)
+	// NextIDs reserva n IDs de uma vez, na ordem em que seriam gerados um a um. É usado
+	// nas criações em lote, para não ir ao banco uma vez por item.
+	NextIDs(ctx context.Context, n int) ([]string, error)
+}
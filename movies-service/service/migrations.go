@@ -0,0 +1,15 @@
+// Local: movies-service/service/migrations.go
+
+package service
+
+import "context"
+
+// MigrationRepository é a porta de saída que registra as migrações de dados já concluídas
+// (ex: MigrateDirectors), para que cada uma rode uma única vez. As migrações do esquema
+// continuam com cada adaptador; estas dependem das regras do serviço (como o gerador de IDs).
+type MigrationRepository interface {
+	// IsApplied indica se a migração com esse nome já foi concluída.
+	IsApplied(ctx context.Context, name string) (bool, error)
+	// MarkApplied registra a migração como concluída. Registrar de novo não é um erro.
+	MarkApplied(ctx context.Context, name string) error
+}
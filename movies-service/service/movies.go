@@ -7,28 +7,94 @@ import (
 	// Novo import
 	"context"
 	"errors"
-	"strconv"
+	"fmt"
+	"strings"
+	"time"
 )
 
 // === 1. Modelo de Domínio ===
 // Esta é a estrutura de dados principal que nossa lógica de negócio vai usar.
 // Ela representa um filme dentro do nosso sistema.
+//
+// As tags bson definem os nomes dos campos no MongoDB. Os campos novos usam omitempty:
+// documentos antigos, sem eles, continuam sendo lidos normalmente (com os valores vazios).
 type Movie struct {
-	ID       string `json:"id"`
-	Title    string `json:"title"`
-	Director string `json:"director"`
-	Year     int32  `json:"year"`
+	ID               string       `json:"id" bson:"id"`
+	Title            string       `json:"title" bson:"title"`
+	Director         string       `json:"director" bson:"director"`
+	Year             int32        `json:"year" bson:"year"`
+	Genres           []string     `json:"genres,omitempty" bson:"genres,omitempty"`
+	RuntimeMinutes   int32        `json:"runtime_minutes,omitempty" bson:"runtime_minutes,omitempty"`
+	Synopsis         string       `json:"synopsis,omitempty" bson:"synopsis,omitempty"`
+	Cast             []CastMember `json:"cast,omitempty" bson:"cast,omitempty"`
+	OriginalLanguage string       `json:"original_language,omitempty" bson:"original_language,omitempty"`
+	AgeRating        string       `json:"age_rating,omitempty" bson:"age_rating,omitempty"`
+	ExternalIDs      ExternalIDs  `json:"external_ids" bson:"external_ids,omitempty"`
+	Credits          []Credit     `json:"credits,omitempty" bson:"credits,omitempty"`
+	// Rating vem do reviews-service e não é guardado com o filme (ver RatingSource).
+	// Fica nil se o filme não tem avaliações.
+	Rating *RatingSummary `json:"rating,omitempty" bson:"-"`
+}
+
+// CastMember é uma pessoa do elenco e o papel (personagem) que ela interpreta.
+type CastMember struct {
+	Name string `json:"name" bson:"name"`
+	Role string `json:"role,omitempty" bson:"role,omitempty"`
+}
+
+// ExternalIDs guarda os identificadores do filme em catálogos externos.
+// Valores vazios (ou zero) significam que o ID não é conhecido.
+type ExternalIDs struct {
+	IMDbID string `json:"imdb_id,omitempty" bson:"imdb_id,omitempty"`
+	TMDBID int64  `json:"tmdb_id,omitempty" bson:"tmdb_id,omitempty"`
 }
 
 // === 2. Porta de Saída (Driven Port) ===
 // Esta é a interface que define o que nossa aplicação PRECISA do mundo exterior.
 // No caso, ela precisa de um meio para persistir e buscar dados de filmes.
 // Qualquer banco de dados que queira se conectar ao nosso núcleo, DEVE implementar esta interface.
+//
+// Filmes com exclusão lógica (SoftDeleteByID) não aparecem em nenhuma consulta nem podem
+// ser alterados até serem restaurados, mas continuam ocupando o seu ID: Save de um ID
+// excluído é um conflito e FindMaxID também os considera.
 type MovieRepository interface {
 	Save(ctx context.Context, movie *Movie) error
+	// SaveMany insere vários filmes de uma vez. Cada filme é salvo ou rejeitado de forma
+	// independente: o erro de cada um fica na mesma posição da lista retornada (nil quando foi
+	// salvo). O segundo retorno é reservado para falhas que impedem a operação inteira.
+	SaveMany(ctx context.Context, movies []*Movie) ([]error, error)
 	FindByID(ctx context.Context, id string) (*Movie, error)
+	// FindByIDs retorna os filmes encontrados entre os IDs pedidos, ordenados por ID.
+	// IDs inexistentes são simplesmente ignorados.
+	FindByIDs(ctx context.Context, ids []string) ([]*Movie, error)
 	FindAll(ctx context.Context) ([]*Movie, error)
-	DeleteByID(ctx context.Context, id string) error
+	FindPage(ctx context.Context, query MovieQuery) ([]*Movie, error)
+	Count(ctx context.Context, filter MovieFilter) (int64, error)
+	Stream(ctx context.Context, filter MovieFilter, fn func(*Movie) error) error
+	Search(ctx context.Context, query SearchQuery) ([]*SearchResult, error)
+	CountSearch(ctx context.Context, text string) (int64, error)
+	Update(ctx context.Context, movie *Movie) (*Movie, error)
+	// Patch grava apenas os campos de paths (os nomes aceitos por PatchMovie, sem repetições
+	// e sem um subcampo junto do campo inteiro), copiando os valores de patch, e retorna o
+	// filme inteiro depois da alteração. Os outros campos não são tocados, então duas
+	// atualizações parciais simultâneas de campos diferentes não se sobrescrevem.
+	// Retorna (nil, nil) se o filme não existir.
+	Patch(ctx context.Context, id string, patch *Movie, paths []string) (*Movie, error)
+	// SetCredits troca apenas os créditos do filme, e só se eles ainda forem iguais a
+	// expected (nil e uma lista vazia são iguais). Retorna false, sem alterar nada, se o
+	// filme não existir ou se os créditos tiverem mudado.
+	SetCredits(ctx context.Context, id string, expected, credits []Credit) (bool, error)
+	// DeleteByID apaga o filme definitivamente e SoftDeleteByID apenas registra a data da
+	// exclusão. Os dois retornam false se não existir um filme (não excluído) com o ID.
+	DeleteByID(ctx context.Context, id string) (bool, error)
+	SoftDeleteByID(ctx context.Context, id string, deletedAt time.Time) (bool, error)
+	// DeleteByIDs e SoftDeleteByIDs são as versões em lote e retornam os IDs que foram excluídos.
+	DeleteByIDs(ctx context.Context, ids []string) ([]string, error)
+	SoftDeleteByIDs(ctx context.Context, ids []string, deletedAt time.Time) ([]string, error)
+	// Restore desfaz a exclusão lógica. Retorna (nil, nil) se não houver um filme excluído com o ID.
+	Restore(ctx context.Context, id string) (*Movie, error)
+	// PurgeDeleted apaga definitivamente os filmes excluídos antes de 'before' e retorna os seus IDs.
+	PurgeDeleted(ctx context.Context, before time.Time) ([]string, error)
 	FindMaxID(ctx context.Context) (int, error)
 }
 
@@ -38,24 +104,79 @@ type MovieRepository interface {
 type MovieService interface {
 	CreateMovie(ctx context.Context, movie *Movie) (*Movie, error)
 	GetMovie(ctx context.Context, id string) (*Movie, error)
-	ListMovies(ctx context.Context) ([]*Movie, error)
+	ListMovies(ctx context.Context, opts ListOptions) (*MoviePage, error)
+	StreamMovies(ctx context.Context, filter MovieFilter, fn func(*Movie) error) error
+	SearchMovies(ctx context.Context, opts SearchOptions) (*SearchPage, error)
+	UpdateMovie(ctx context.Context, movie *Movie) (*Movie, error)
+	PatchMovie(ctx context.Context, id string, patch *Movie, paths []string) (*Movie, error)
 	DeleteMovie(ctx context.Context, id string) error
+	BatchCreateMovies(ctx context.Context, movies []*Movie) ([]BatchResult, error)
+	BatchGetMovies(ctx context.Context, ids []string) ([]BatchResult, error)
+	BatchDeleteMovies(ctx context.Context, ids []string) ([]BatchResult, error)
+	RestoreMovie(ctx context.Context, id string) (*Movie, error)
+	PurgeDeletedMovies(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// ErrEmptyID é retornado quando uma operação sobre um filme recebe um ID vazio.
+var ErrEmptyID = errors.New("o ID do filme não pode ser vazio")
+
+// ErrUnknownField é retornado quando uma atualização parcial cita um campo que não existe
+// ou que não pode ser alterado (como o ID).
+var ErrUnknownField = errors.New("campo desconhecido ou não atualizável")
+
+// patchableFields mapeia o nome de cada campo atualizável (o mesmo usado no .proto)
+// para a função que copia o valor novo para o filme existente.
+// Os IDs externos podem ser alterados inteiros ("external_ids") ou um de cada vez.
+var patchableFields = map[string]func(dst, src *Movie){
+	"title":                func(dst, src *Movie) { dst.Title = src.Title },
+	"director":             func(dst, src *Movie) { dst.Director = src.Director },
+	"year":                 func(dst, src *Movie) { dst.Year = src.Year },
+	"genres":               func(dst, src *Movie) { dst.Genres = src.Genres },
+	"runtime_minutes":      func(dst, src *Movie) { dst.RuntimeMinutes = src.RuntimeMinutes },
+	"synopsis":             func(dst, src *Movie) { dst.Synopsis = src.Synopsis },
+	"cast":                 func(dst, src *Movie) { dst.Cast = src.Cast },
+	"original_language":    func(dst, src *Movie) { dst.OriginalLanguage = src.OriginalLanguage },
+	"age_rating":           func(dst, src *Movie) { dst.AgeRating = src.AgeRating },
+	"external_ids":         func(dst, src *Movie) { dst.ExternalIDs = src.ExternalIDs },
+	"external_ids.imdb_id": func(dst, src *Movie) { dst.ExternalIDs.IMDbID = src.ExternalIDs.IMDbID },
+	"external_ids.tmdb_id": func(dst, src *Movie) { dst.ExternalIDs.TMDBID = src.ExternalIDs.TMDBID },
+	"credits":              func(dst, src *Movie) { dst.Credits = src.Credits },
 }
 
 // === 4. Implementação do Serviço (O Núcleo em si) ===
 // Esta é a implementação concreta da nossa interface MovieService.
 // Note que ela não sabe nada sobre MongoDB, apenas sobre a interface MovieRepository.
 type movieService struct {
-	repo MovieRepository // A única dependência é a nossa porta de saída.
+	repo MovieRepository // Porta de saída para persistir os filmes.
+	ids  IDAllocator     // Porta de saída para gerar os IDs dos filmes novos.
+
+	collections CollectionRepository // Opcional: coleções das quais os filmes apagados são retirados.
+	people      PersonRepository     // Opcional: confere se as pessoas dos créditos existem.
+	ratings     RatingSource         // Opcional: preenche a média das avaliações em GetMovie.
+	watchlists  WatchlistRepository  // Opcional: listas de interesse das quais os filmes apagados são retirados.
+
+	maxBatchSize int              // Quantidade máxima de itens de uma operação em lote.
+	softDelete   bool             // Se true, DeleteMovie faz apenas a exclusão lógica.
+	retention    time.Duration    // Retenção padrão dos filmes excluídos (ver PurgeDeletedMovies).
+	now          func() time.Time // Relógio usado nas datas de exclusão.
 }
 
 // NewMovieService é um "construtor" que cria uma nova instância do nosso serviço.
 // Ele recebe o adaptador de banco de dados (que implementa a interface Repository)
-// e o injeta na nossa struct de serviço. Isso é Injeção de Dependência.
-func NewMovieService(repo MovieRepository) MovieService {
-	return &movieService{
-		repo: repo,
+// e o gerador de IDs, e os injeta na nossa struct de serviço. Isso é Injeção de Dependência.
+// As opções (ver options.go) mudam o comportamento padrão, como o tipo de exclusão.
+func NewMovieService(repo MovieRepository, ids IDAllocator, opts ...Option) MovieService {
+	s := &movieService{
+		repo:         repo,
+		ids:          ids,
+		maxBatchSize: DefaultMaxBatchSize,
+		retention:    DefaultRetention,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // Abaixo estão as implementações dos métodos da nossa lógica de negócio.
@@ -63,21 +184,22 @@ func NewMovieService(repo MovieRepository) MovieService {
 // Aqui é onde você adicionaria regras de negócio (validações, etc).
 
 func (s *movieService) CreateMovie(ctx context.Context, movie *Movie) (*Movie, error) {
-	if movie.Title == "" {
-		return nil, errors.New("o título do filme não pode ser vazio")
+	if err := validateMovie(movie); err != nil {
+		return nil, err
+	}
+	if err := s.checkPeopleExist(ctx, movie.Credits); err != nil {
+		return nil, err
 	}
 
-	// 1. Pergunta ao repositório qual é o maior ID existente.
-	maxID, err := s.repo.FindMaxID(ctx)
+	// 1. Pede um ID novo ao gerador. Ele garante que duas criações simultâneas
+	// nunca recebem o mesmo ID.
+	newID, err := s.ids.NextID(ctx)
 	if err != nil {
 		return nil, err
 	}
+	movie.ID = newID
 
-	// 2. Calcula o novo ID e o converte para string.
-	newID := maxID + 1
-	movie.ID = strconv.Itoa(newID)
-
-	// 3. Salva o filme com o novo ID numérico.
+	// 2. Salva o filme com o novo ID.
 	err = s.repo.Save(ctx, movie)
 	if err != nil {
 		return nil, err
@@ -85,14 +207,227 @@ func (s *movieService) CreateMovie(ctx context.Context, movie *Movie) (*Movie, e
 	return movie, nil
 }
 
+// GetMovie busca um filme. Retorna um erro da categoria ErrNotFound se ele não existir.
 func (s *movieService) GetMovie(ctx context.Context, id string) (*Movie, error) {
-	return s.repo.FindByID(ctx, id)
+	if id == "" {
+		return nil, invalidArgument("id", ErrEmptyID)
+	}
+	movie, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// O repositório retorna (nil, nil) quando o filme não existe; o serviço transforma isso em um erro.
+	if movie == nil {
+		return nil, movieNotFound(id)
+	}
+	// A média das avaliações é um complemento: se o reviews-service falhar, o filme é
+	// retornado sem ela em vez de a busca inteira falhar. Sem avaliações, não há média.
+	if s.ratings != nil {
+		if summary, err := s.ratings.RatingSummary(ctx, id); err == nil && summary.Count > 0 {
+			movie.Rating = summary
+		}
+	}
+	return movie, nil
+}
+
+// ListMovies retorna uma página de filmes filtrados e ordenados.
+// O token da próxima página guarda os valores de ordenação do último filme, então novas
+// inserções não fazem nenhum filme aparecer duas vezes nem ser pulado entre as páginas.
+func (s *movieService) ListMovies(ctx context.Context, opts ListOptions) (*MoviePage, error) {
+	size, err := pageSize(opts.PageSize)
+	if err != nil {
+		return nil, invalidArgument("page_size", err)
+	}
+	if err := opts.Filter.Validate(); err != nil {
+		return nil, err
+	}
+	order, err := parseOrderBy(opts.OrderBy)
+	if err != nil {
+		return nil, invalidArgument("order_by", err)
+	}
+	var token pageToken
+	if err := decodeToken(opts.PageToken, &token); err != nil {
+		return nil, invalidArgument("page_token", err)
+	}
+	// Um token só vale para a mesma ordenação em que foi gerado.
+	if opts.PageToken != "" && (token.ID == "" || token.OrderBy != formatOrderBy(order)) {
+		return nil, invalidArgument("page_token", ErrInvalidPageToken)
+	}
+
+	// Pedimos um filme a mais para saber se existe uma próxima página.
+	query := MovieQuery{
+		Filter:  opts.Filter,
+		OrderBy: order,
+		After:   token.after(),
+		Limit:   size + 1,
+	}
+	movies, err := s.repo.FindPage(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repo.Count(ctx, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &MoviePage{Movies: movies, TotalSize: total}
+	if len(movies) > size {
+		page.Movies = movies[:size]
+		page.NextPageToken = encodeToken(newPageToken(order, page.Movies[size-1]))
+	}
+	return page, nil
+}
+
+// StreamMovies chama fn para cada filme que satisfaz o filtro, em ordem de ID.
+// Os filmes vêm direto do repositório, um de cada vez, sem montar a lista inteira em memória.
+// Se fn retornar um erro, o streaming é interrompido e o erro é devolvido.
+func (s *movieService) StreamMovies(ctx context.Context, filter MovieFilter, fn func(*Movie) error) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	return s.repo.Stream(ctx, filter, fn)
+}
+
+// UpdateMovie substitui os dados de um filme existente, mantendo o seu ID.
+// Assim como GetMovie, retorna um erro da categoria ErrNotFound quando o filme não existe.
+func (s *movieService) UpdateMovie(ctx context.Context, movie *Movie) (*Movie, error) {
+	if movie.ID == "" {
+		return nil, invalidArgument("id", ErrEmptyID)
+	}
+	if err := validateMovie(movie); err != nil {
+		return nil, err
+	}
+	if err := s.checkPeopleExist(ctx, movie.Credits); err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.Update(ctx, movie)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, movieNotFound(movie.ID)
+	}
+	return updated, nil
 }
 
-func (s *movieService) ListMovies(ctx context.Context) ([]*Movie, error) {
-	return s.repo.FindAll(ctx)
+// PatchMovie altera apenas os campos listados em paths, copiando os valores de patch.
+// Campos fora da lista (por exemplo, um Director que o seed deixou vazio) não são tocados:
+// o repositório grava só os campos da máscara (MovieRepository.Patch), então uma alteração
+// feita ao mesmo tempo em outros campos é mantida.
+// Retorna um erro da categoria ErrNotFound quando o filme não existe.
+func (s *movieService) PatchMovie(ctx context.Context, id string, patch *Movie, paths []string) (*Movie, error) {
+	// 1. Valida todos os campos antes de ir ao banco.
+	if id == "" {
+		return nil, invalidArgument("id", ErrEmptyID)
+	}
+	for _, path := range paths {
+		if _, ok := patchableFields[path]; !ok {
+			return nil, invalidArgument("update_mask", fmt.Errorf("%w: '%s'", ErrUnknownField, path))
+		}
+	}
+
+	// 2. Busca o estado atual do filme.
+	movie, err := s.GetMovie(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return movie, nil
+	}
+
+	// 3. Aplica a máscara e valida o resultado como em qualquer atualização. As regras de
+	// validação são de cada campo, então o resultado continua válido mesmo que outros campos
+	// mudem antes da gravação.
+	ApplyPatch(movie, patch, paths)
+	if err := validateMovie(movie); err != nil {
+		return nil, err
+	}
+	if err := s.checkPeopleExist(ctx, movie.Credits); err != nil {
+		return nil, err
+	}
+
+	// 4. Grava apenas os campos da máscara.
+	updated, err := s.repo.Patch(ctx, id, patch, normalizePaths(paths))
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, movieNotFound(id)
+	}
+	return updated, nil
+}
+
+// ApplyPatch copia para dst os campos de src listados em paths, que precisam ser nomes
+// aceitos por PatchMovie. É a referência de comportamento para os adaptadores que alteram
+// os filmes em memória (ver MovieRepository.Patch).
+func ApplyPatch(dst, src *Movie, paths []string) {
+	for _, path := range paths {
+		patchableFields[path](dst, src)
+	}
+}
+
+// normalizePaths tira da máscara os campos repetidos e os subcampos de um campo que também
+// aparece inteiro (ex: "external_ids.imdb_id" junto de "external_ids"), que o MongoDB
+// rejeitaria como atualizações conflitantes. A ordem dos que sobram é mantida.
+func normalizePaths(paths []string) []string {
+	listed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		listed[path] = true
+	}
+	seen := make(map[string]bool, len(paths))
+	normalized := make([]string, 0, len(paths))
+	for _, path := range paths {
+		parent, _, nested := strings.Cut(path, ".")
+		if seen[path] || (nested && listed[parent]) {
+			continue
+		}
+		seen[path] = true
+		normalized = append(normalized, path)
+	}
+	return normalized
 }
 
-func (s *movieService) DeleteMovie(ctx context.Context, id string) error {
-	return s.repo.DeleteByID(ctx, id)
+// checkPeopleExist retorna um erro da categoria ErrNotFound com a primeira pessoa dos
+// créditos que não existe. Sem o repositório de pessoas (ver WithPeople), não confere nada.
+func (s *movieService) checkPeopleExist(ctx context.Context, credits []Credit) error {
+	exists, err := s.existingPeople(ctx, credits)
+	if err != nil {
+		return err
+	}
+	return missingPerson(credits, exists)
+}
+
+// existingPeople busca, com uma única consulta, as pessoas citadas nos créditos e retorna
+// os IDs das que existem. Retorna nil sem o repositório de pessoas ou sem créditos.
+func (s *movieService) existingPeople(ctx context.Context, credits []Credit) (map[string]bool, error) {
+	if s.people == nil || len(credits) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(credits))
+	for _, credit := range credits {
+		ids = append(ids, credit.PersonID)
+	}
+	found, err := s.people.FindByIDs(ctx, uniqueIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]bool, len(found))
+	for _, person := range found {
+		exists[person.ID] = true
+	}
+	return exists, nil
+}
+
+// missingPerson retorna o erro da primeira pessoa dos créditos que não está em exists.
+// Um exists nil (sem o repositório de pessoas) aceita todas.
+func missingPerson(credits []Credit, exists map[string]bool) error {
+	if exists == nil {
+		return nil
+	}
+	for _, credit := range credits {
+		if !exists[credit.PersonID] {
+			return personNotFound(credit.PersonID)
+		}
+	}
+	return nil
 }
@@ -6,58 +6,76 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	// Importamos o pacote de serviço que queremos testar
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/idgen"
 	"github.com/alenrique/Movies-microservices/movies-service/service"
 )
 
-// --- 1. O Repositório Falso (Fake/Mock) ---
-// fakeMovieRepository é uma implementação "dublê" da nossa interface MovieRepository.
-// Ele usa um mapa em memória em vez de um banco de dados real.
-type fakeMovieRepository struct {
-	movies map[string]*service.Movie
+// --- 1. Os Dublês ---
+// O repositório usado nos testes é o adaptador em memória (database/memory), que
+// implementa toda a interface MovieRepository com a mesma semântica dos outros adaptadores.
+func newMovieRepository() service.MovieRepository {
+	return memory.NewMovieRepository()
 }
 
-// NewFakeMovieRepository cria uma nova instância do nosso repositório falso.
-func NewFakeMovieRepository() *fakeMovieRepository {
-	return &fakeMovieRepository{
-		movies: make(map[string]*service.Movie),
+// countMovies conta os filmes salvos no repositório.
+func countMovies(t *testing.T, repo service.MovieRepository) int64 {
+	t.Helper()
+	count, err := repo.Count(context.Background(), service.MovieFilter{})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao contar filmes: %v", err)
 	}
+	return count
 }
 
-// Implementação dos métodos da interface para o nosso dublê:
-func (f *fakeMovieRepository) Save(ctx context.Context, movie *service.Movie) error {
-	f.movies[movie.ID] = movie
-	return nil
+// fakeIDAllocator gera IDs sequenciais com um contador atômico, como o contador do MongoDB.
+type fakeIDAllocator struct {
+	last int64
 }
 
-func (f *fakeMovieRepository) FindMaxID(ctx context.Context) (int, error) {
-	maxID := 0
-	for _, movie := range f.movies {
-		id, _ := strconv.Atoi(movie.ID)
-		if id > maxID {
-			maxID = id
-		}
+func (a *fakeIDAllocator) NextID(ctx context.Context) (string, error) {
+	return strconv.FormatInt(atomic.AddInt64(&a.last, 1), 10), nil
+}
+
+func (a *fakeIDAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	last := atomic.AddInt64(&a.last, int64(n))
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = strconv.FormatInt(last-int64(n-1-i), 10)
 	}
-	return maxID, nil
+	return ids, nil
+}
+
+// constantIDAllocator devolve sempre o mesmo ID, para simular uma colisão.
+type constantIDAllocator string
+
+func (a constantIDAllocator) NextID(ctx context.Context) (string, error) {
+	return string(a), nil
 }
 
-// (Implementações vazias para os outros métodos, pois não os usamos nestes testes)
-func (f *fakeMovieRepository) FindByID(ctx context.Context, id string) (*service.Movie, error) {
-	return nil, nil
+func (a constantIDAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = string(a)
+	}
+	return ids, nil
 }
-func (f *fakeMovieRepository) FindAll(ctx context.Context) ([]*service.Movie, error) { return nil, nil }
-func (f *fakeMovieRepository) DeleteByID(ctx context.Context, id string) error       { return nil }
 
 // --- 2. Os Testes ---
 
 // TestCreateMovie_Success testa o caminho feliz da criação de um filme.
 func TestCreateMovie_Success(t *testing.T) {
 	// Arrange (Preparação)
-	repo := NewFakeMovieRepository()
-	movieService := service.NewMovieService(repo)
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
 	ctx := context.Background()
 	movieToCreate := &service.Movie{Title: "The Matrix", Year: 1999}
 
@@ -71,16 +89,16 @@ func TestCreateMovie_Success(t *testing.T) {
 	if createdMovie.ID != "1" {
 		t.Errorf("Esperava ID '1', mas recebeu '%s'", createdMovie.ID)
 	}
-	if len(repo.movies) != 1 {
-		t.Errorf("Esperava que 1 filme fosse salvo no repositório, mas encontrou %d", len(repo.movies))
+	if count := countMovies(t, repo); count != 1 {
+		t.Errorf("Esperava que 1 filme fosse salvo no repositório, mas encontrou %d", count)
 	}
 }
 
 // TestCreateMovie_FailsOnEmptyTitle testa se a validação de título vazio está funcionando.
 func TestCreateMovie_FailsOnEmptyTitle(t *testing.T) {
 	// Arrange
-	repo := NewFakeMovieRepository()
-	movieService := service.NewMovieService(repo)
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
 	ctx := context.Background()
 	movieToCreate := &service.Movie{Title: ""} // Título propositalmente vazio
 
@@ -92,3 +110,822 @@ func TestCreateMovie_FailsOnEmptyTitle(t *testing.T) {
 		t.Error("Esperava um erro ao criar filme com título vazio, mas não recebeu nenhum")
 	}
 }
+
+// TestUpdateMovie_Success testa a atualização de um filme existente.
+func TestUpdateMovie_Success(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{Title: "The Matrx", Year: 1999})
+
+	// Act
+	updated, err := movieService.UpdateMovie(ctx, &service.Movie{ID: created.ID, Title: "The Matrix", Year: 1999})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erro inesperado ao atualizar filme: %v", err)
+	}
+	if updated == nil || updated.ID != created.ID {
+		t.Fatalf("Esperava o filme com ID '%s', mas recebeu %+v", created.ID, updated)
+	}
+	if saved, _ := repo.FindByID(ctx, created.ID); saved.Title != "The Matrix" {
+		t.Errorf("Esperava o título 'The Matrix', mas encontrou '%s'", saved.Title)
+	}
+}
+
+// TestUpdateMovie_NotFound testa se a atualização de um filme inexistente retorna ErrNotFound.
+func TestUpdateMovie_NotFound(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+
+	// Act
+	updated, err := movieService.UpdateMovie(context.Background(), &service.Movie{ID: "42", Title: "Inexistente"})
+
+	// Assert
+	if !errors.Is(err, service.ErrNotFound) {
+		t.Fatalf("Esperava ErrNotFound, mas recebeu %v", err)
+	}
+	if updated != nil {
+		t.Errorf("Esperava nil para um filme inexistente, mas recebeu %+v", updated)
+	}
+	if countMovies(t, repo) != 0 {
+		t.Error("A atualização de um filme inexistente não deveria criar um filme")
+	}
+}
+
+// TestGetMovie_NotFound testa se o erro de filme inexistente informa o recurso e o ID.
+func TestGetMovie_NotFound(t *testing.T) {
+	// Arrange
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+
+	// Act
+	_, err := movieService.GetMovie(context.Background(), "42")
+
+	// Assert
+	var notFound *service.NotFoundError
+	if !errors.Is(err, service.ErrNotFound) || !errors.As(err, &notFound) || notFound.ID != "42" {
+		t.Errorf("Esperava um NotFoundError para o ID '42', mas recebeu %v", err)
+	}
+}
+
+// fakeRatingSource devolve sempre o mesmo resumo, ou o erro, se ele estiver preenchido.
+type fakeRatingSource struct {
+	summary service.RatingSummary
+	err     error
+}
+
+func (s fakeRatingSource) RatingSummary(ctx context.Context, movieID string) (*service.RatingSummary, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	summary := s.summary
+	return &summary, nil
+}
+
+// TestGetMovie_WithRatings testa se GetMovie traz a média das avaliações, se um filme sem
+// avaliações fica sem ela e se uma falha do reviews-service apenas deixa a média de fora.
+func TestGetMovie_WithRatings(t *testing.T) {
+	ctx := context.Background()
+	repo := newMovieRepository()
+	repo.Save(ctx, &service.Movie{ID: "1", Title: "The Matrix"})
+	want := service.RatingSummary{Average: 4.5, Count: 2}
+
+	withRatings := service.NewMovieService(repo, &fakeIDAllocator{}, service.WithRatings(fakeRatingSource{summary: want}))
+	movie, err := withRatings.GetMovie(ctx, "1")
+	if err != nil || movie.Rating == nil || *movie.Rating != want {
+		t.Errorf("Esperava a média %+v, mas obteve (%+v, %v)", want, movie, err)
+	}
+
+	noReviews := service.NewMovieService(repo, &fakeIDAllocator{}, service.WithRatings(fakeRatingSource{}))
+	if movie, err := noReviews.GetMovie(ctx, "1"); err != nil || movie.Rating != nil {
+		t.Errorf("Esperava o filme sem avaliações sem a média, mas obteve (%+v, %v)", movie, err)
+	}
+
+	unavailable := service.NewMovieService(repo, &fakeIDAllocator{}, service.WithRatings(fakeRatingSource{err: errors.New("fora do ar")}))
+	movie, err = unavailable.GetMovie(ctx, "1")
+	if err != nil || movie.Rating != nil {
+		t.Errorf("Esperava o filme sem a média, mas obteve (%+v, %v)", movie, err)
+	}
+}
+
+// TestValidationErrors_ReportTheInvalidField testa se os erros de validação pertencem à
+// categoria ErrInvalidArgument e apontam o campo da requisição que precisa ser corrigido.
+func TestValidationErrors_ReportTheInvalidField(t *testing.T) {
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		call  func() error
+		field string
+	}{
+		{"título vazio", func() error {
+			_, err := movieService.CreateMovie(ctx, &service.Movie{})
+			return err
+		}, "title"},
+		{"ID vazio", func() error {
+			_, err := movieService.GetMovie(ctx, "")
+			return err
+		}, "id"},
+		{"campo desconhecido na máscara", func() error {
+			_, err := movieService.PatchMovie(ctx, "1", &service.Movie{}, []string{"id"})
+			return err
+		}, "update_mask"},
+		{"tamanho de página negativo", func() error {
+			_, err := movieService.ListMovies(ctx, service.ListOptions{PageSize: -1})
+			return err
+		}, "page_size"},
+		{"ano mínimo maior que o máximo", func() error {
+			_, err := movieService.ListMovies(ctx, service.ListOptions{Filter: service.MovieFilter{YearMin: 2000, YearMax: 1990}})
+			return err
+		}, "filter.year_min"},
+		{"busca vazia", func() error {
+			_, err := movieService.SearchMovies(ctx, service.SearchOptions{Query: "  "})
+			return err
+		}, "query"},
+		{"lote vazio", func() error {
+			_, err := movieService.BatchGetMovies(ctx, nil)
+			return err
+		}, "ids"},
+		{"lote grande demais", func() error {
+			_, err := movieService.BatchCreateMovies(ctx, make([]*service.Movie, service.DefaultMaxBatchSize+1))
+			return err
+		}, "movies"},
+		{"retenção negativa", func() error {
+			_, err := movieService.PurgeDeletedMovies(ctx, -time.Hour)
+			return err
+		}, "retention"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+
+			var validationErr *service.ValidationError
+			if !errors.Is(err, service.ErrInvalidArgument) || !errors.As(err, &validationErr) {
+				t.Fatalf("Esperava um ValidationError, mas recebeu %v", err)
+			}
+			if len(validationErr.Violations) != 1 || validationErr.Violations[0].Field != tt.field {
+				t.Errorf("Esperava uma violação no campo '%s', mas recebeu %+v", tt.field, validationErr.Violations)
+			}
+		})
+	}
+}
+
+// TestDeleteMovie_NotFound testa se excluir um filme inexistente (ou já excluído) retorna ErrNotFound.
+func TestDeleteMovie_NotFound(t *testing.T) {
+	// Arrange
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{Title: "The Matrix", Year: 1999})
+
+	// Act
+	firstErr := movieService.DeleteMovie(ctx, created.ID)
+	secondErr := movieService.DeleteMovie(ctx, created.ID)
+
+	// Assert
+	if firstErr != nil {
+		t.Fatalf("Erro inesperado ao excluir o filme: %v", firstErr)
+	}
+	if !errors.Is(secondErr, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao excluir de novo, mas recebeu %v", secondErr)
+	}
+	if _, err := movieService.RestoreMovie(ctx, created.ID); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Sem a exclusão lógica, o filme não deveria poder ser restaurado, mas recebeu %v", err)
+	}
+}
+
+// TestSoftDelete_RestoreAndPurge testa o ciclo da exclusão lógica: o filme excluído some das
+// consultas, pode ser restaurado e só é apagado de vez depois da retenção.
+func TestSoftDelete_RestoreAndPurge(t *testing.T) {
+	// Arrange: um relógio controlado pelo teste e uma retenção de um dia.
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{},
+		service.WithSoftDelete(24*time.Hour), service.WithClock(clock))
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{Title: "The Matrix", Year: 1999})
+
+	// Act & Assert: a exclusão esconde o filme.
+	if err := movieService.DeleteMovie(ctx, created.ID); err != nil {
+		t.Fatalf("Erro inesperado ao excluir o filme: %v", err)
+	}
+	if _, err := movieService.GetMovie(ctx, created.ID); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound para o filme excluído, mas recebeu %v", err)
+	}
+
+	// A restauração devolve o filme com os mesmos dados.
+	restored, err := movieService.RestoreMovie(ctx, created.ID)
+	if err != nil || restored.Title != "The Matrix" {
+		t.Fatalf("Esperava restaurar 'The Matrix', mas recebeu %+v (erro: %v)", restored, err)
+	}
+	if _, err := movieService.GetMovie(ctx, created.ID); err != nil {
+		t.Errorf("Erro inesperado ao buscar o filme restaurado: %v", err)
+	}
+
+	// Dentro da retenção, a limpeza não apaga nada.
+	if err := movieService.DeleteMovie(ctx, created.ID); err != nil {
+		t.Fatalf("Erro inesperado ao excluir o filme: %v", err)
+	}
+	now = now.Add(23 * time.Hour)
+	if purged, err := movieService.PurgeDeletedMovies(ctx, 0); err != nil || purged != 0 {
+		t.Errorf("Esperava que nada fosse apagado dentro da retenção, mas apagou %d (erro: %v)", purged, err)
+	}
+
+	// Depois da retenção, o filme é apagado e não pode mais ser restaurado.
+	now = now.Add(2 * time.Hour)
+	if purged, err := movieService.PurgeDeletedMovies(ctx, 0); err != nil || purged != 1 {
+		t.Errorf("Esperava apagar 1 filme depois da retenção, mas apagou %d (erro: %v)", purged, err)
+	}
+	if _, err := movieService.RestoreMovie(ctx, created.ID); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao restaurar um filme apagado, mas recebeu %v", err)
+	}
+}
+
+// TestBatchCreateMovies_PerItemResults testa se um filme inválido é rejeitado sozinho,
+// sem impedir a criação dos outros, e se os resultados mantêm a ordem da entrada.
+func TestBatchCreateMovies_PerItemResults(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+
+	// Act
+	results, err := movieService.BatchCreateMovies(context.Background(), []*service.Movie{
+		{Title: "Alien", Year: 1979},
+		{Title: ""},
+		{Title: "Aliens", Year: 1986},
+	})
+
+	// Assert
+	if err != nil || len(results) != 3 {
+		t.Fatalf("Esperava 3 resultados, mas recebeu %d (erro: %v)", len(results), err)
+	}
+	if results[0].Err != nil || results[0].Movie == nil || results[0].Movie.Title != "Alien" {
+		t.Errorf("Esperava 'Alien' criado na posição 0, mas recebeu %+v", results[0])
+	}
+	if !errors.Is(results[1].Err, service.ErrEmptyTitle) || results[1].Movie != nil {
+		t.Errorf("Esperava ErrEmptyTitle na posição 1, mas recebeu %+v", results[1])
+	}
+	if results[2].Err != nil || results[2].ID == "" || results[2].ID == results[0].ID {
+		t.Errorf("Esperava 'Aliens' criado com um ID próprio na posição 2, mas recebeu %+v", results[2])
+	}
+	if count := countMovies(t, repo); count != 2 {
+		t.Errorf("Esperava 2 filmes salvos, mas encontrou %d", count)
+	}
+}
+
+// countingPeople conta as consultas em lote ao repositório de pessoas envolvido.
+type countingPeople struct {
+	service.PersonRepository
+	findByIDs int
+}
+
+func (r *countingPeople) FindByIDs(ctx context.Context, ids []string) ([]*service.Person, error) {
+	r.findByIDs++
+	return r.PersonRepository.FindByIDs(ctx, ids)
+}
+
+// countingIDAllocator conta as reservas de IDs feitas no fakeIDAllocator.
+type countingIDAllocator struct {
+	fakeIDAllocator
+	nextID, nextIDs int
+}
+
+func (a *countingIDAllocator) NextID(ctx context.Context) (string, error) {
+	a.nextID++
+	return a.fakeIDAllocator.NextID(ctx)
+}
+
+func (a *countingIDAllocator) NextIDs(ctx context.Context, n int) ([]string, error) {
+	a.nextIDs++
+	return a.fakeIDAllocator.NextIDs(ctx, n)
+}
+
+// TestBatchCreateMovies_FixedRoundTrips testa se o lote confere as pessoas e reserva os IDs
+// uma única vez, qualquer que seja o tamanho, e se uma pessoa inexistente rejeita só o
+// filme que a cita.
+func TestBatchCreateMovies_FixedRoundTrips(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	people := &countingPeople{PersonRepository: memory.NewPersonRepository()}
+	if err := people.Save(ctx, &service.Person{ID: "p1", Name: "Ridley Scott"}); err != nil {
+		t.Fatalf("Erro inesperado ao criar a pessoa: %v", err)
+	}
+	ids := &countingIDAllocator{}
+	movieService := service.NewMovieService(newMovieRepository(), ids, service.WithPeople(people))
+	directed := []service.Credit{{PersonID: "p1", Role: service.RoleDirector}}
+	movies := []*service.Movie{
+		{Title: "Alien", Year: 1979, Credits: directed},
+		{Title: "Fantasma", Credits: []service.Credit{{PersonID: "p9", Role: service.RoleActor}}},
+	}
+	for i := 0; i < 50; i++ {
+		movies = append(movies, &service.Movie{Title: "Filme " + strconv.Itoa(i), Credits: directed})
+	}
+
+	// Act
+	results, err := movieService.BatchCreateMovies(ctx, movies)
+
+	// Assert
+	if err != nil || len(results) != len(movies) {
+		t.Fatalf("Esperava %d resultados, mas recebeu %d (erro: %v)", len(movies), len(results), err)
+	}
+	if !errors.Is(results[1].Err, service.ErrNotFound) || results[1].ID != "" {
+		t.Errorf("Esperava ErrNotFound só no filme com a pessoa inexistente, mas recebeu %+v", results[1])
+	}
+	if results[0].Err != nil || results[0].ID != "1" || results[2].ID != "2" || results[len(results)-1].ID != "51" {
+		t.Errorf("Esperava os IDs 1..51 na ordem da entrada, mas recebeu %q, %q e %q",
+			results[0].ID, results[2].ID, results[len(results)-1].ID)
+	}
+	if people.findByIDs != 1 || ids.nextIDs != 1 || ids.nextID != 0 {
+		t.Errorf("Esperava 1 consulta de pessoas e 1 reserva de IDs, mas obteve %d consultas, %d reservas em lote e %d individuais",
+			people.findByIDs, ids.nextIDs, ids.nextID)
+	}
+}
+
+// TestBatchGetAndDeleteMovies testa a busca e a exclusão em lote, com IDs inexistentes e repetidos.
+func TestBatchGetAndDeleteMovies(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{}, service.WithMaxBatchSize(4))
+	ctx := context.Background()
+	for _, title := range []string{"Alien", "Aliens", "Alien 3"} {
+		if _, err := movieService.CreateMovie(ctx, &service.Movie{Title: title}); err != nil {
+			t.Fatalf("Erro inesperado ao criar filme: %v", err)
+		}
+	}
+
+	// Act & Assert: busca com um ID inexistente e um vazio.
+	results, err := movieService.BatchGetMovies(ctx, []string{"3", "42", "1", ""})
+	if err != nil || len(results) != 4 {
+		t.Fatalf("Esperava 4 resultados, mas recebeu %d (erro: %v)", len(results), err)
+	}
+	if results[0].Movie == nil || results[0].Movie.Title != "Alien 3" || results[2].Movie == nil || results[2].Movie.Title != "Alien" {
+		t.Errorf("Esperava 'Alien 3' e 'Alien' nas posições 0 e 2, mas recebeu %+v e %+v", results[0], results[2])
+	}
+	if !errors.Is(results[1].Err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound para o ID '42', mas recebeu %v", results[1].Err)
+	}
+	if !errors.Is(results[3].Err, service.ErrInvalidArgument) {
+		t.Errorf("Esperava ErrInvalidArgument para o ID vazio, mas recebeu %v", results[3].Err)
+	}
+
+	// Exclusão com um ID repetido e um inexistente.
+	results, err = movieService.BatchDeleteMovies(ctx, []string{"1", "1", "42"})
+	if err != nil || len(results) != 3 {
+		t.Fatalf("Esperava 3 resultados, mas recebeu %d (erro: %v)", len(results), err)
+	}
+	if results[0].Err != nil || results[1].Err != nil {
+		t.Errorf("Esperava sucesso nas duas posições do ID '1', mas recebeu %v e %v", results[0].Err, results[1].Err)
+	}
+	if !errors.Is(results[2].Err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound para o ID '42', mas recebeu %v", results[2].Err)
+	}
+	if count := countMovies(t, repo); count != 2 {
+		t.Errorf("Esperava que restassem 2 filmes, mas encontrou %d", count)
+	}
+
+	// O tamanho máximo configurado vale para todas as operações em lote.
+	if _, err := movieService.BatchDeleteMovies(ctx, []string{"1", "2", "3", "4", "5"}); !errors.Is(err, service.ErrBatchTooLarge) {
+		t.Errorf("Esperava ErrBatchTooLarge para 5 IDs com o máximo de 4, mas recebeu %v", err)
+	}
+}
+
+// TestCreateMovie_ConflictOnDuplicateID testa se um ID repetido vira um erro da categoria ErrConflict.
+func TestCreateMovie_ConflictOnDuplicateID(t *testing.T) {
+	// Arrange: um gerador que sempre devolve o mesmo ID.
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, constantIDAllocator("7"))
+	ctx := context.Background()
+	if _, err := movieService.CreateMovie(ctx, &service.Movie{Title: "Primeiro"}); err != nil {
+		t.Fatalf("Erro inesperado ao criar filme: %v", err)
+	}
+
+	// Act
+	_, err := movieService.CreateMovie(ctx, &service.Movie{Title: "Segundo"})
+
+	// Assert
+	if !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict, mas recebeu %v", err)
+	}
+}
+
+// TestUpdateMovie_FailsOnEmptyTitle testa se a atualização aplica a mesma validação da criação.
+func TestUpdateMovie_FailsOnEmptyTitle(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+
+	// Act
+	_, err := movieService.UpdateMovie(context.Background(), &service.Movie{ID: "1", Title: ""})
+
+	// Assert
+	if !errors.Is(err, service.ErrEmptyTitle) {
+		t.Errorf("Esperava ErrEmptyTitle, mas recebeu %v", err)
+	}
+}
+
+// TestCreateMovie_ValidatesEveryField testa as regras dos campos opcionais do filme e se
+// todos os campos inválidos são informados de uma vez.
+func TestCreateMovie_ValidatesEveryField(t *testing.T) {
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+	ctx := context.Background()
+	valid := func() *service.Movie {
+		return &service.Movie{
+			Title:            "Matrix",
+			Genres:           []string{"Ação", "Ficção Científica"},
+			RuntimeMinutes:   136,
+			Cast:             []service.CastMember{{Name: "Keanu Reeves", Role: "Neo"}},
+			OriginalLanguage: "en",
+			AgeRating:        "R",
+			ExternalIDs:      service.ExternalIDs{IMDbID: "tt0133093", TMDBID: 603},
+		}
+	}
+	if _, err := movieService.CreateMovie(ctx, valid()); err != nil {
+		t.Fatalf("Erro inesperado ao criar um filme válido: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		edit  func(m *service.Movie)
+		field string
+		want  error
+	}{
+		{"gênero vazio", func(m *service.Movie) { m.Genres = []string{"Ação", " "} }, "genres[1]", service.ErrEmptyGenre},
+		{"gênero repetido", func(m *service.Movie) { m.Genres = []string{"Ação", "ação"} }, "genres[1]", service.ErrDuplicateGenre},
+		{"duração negativa", func(m *service.Movie) { m.RuntimeMinutes = -1 }, "runtime_minutes", service.ErrInvalidRuntime},
+		{"sinopse longa demais", func(m *service.Movie) { m.Synopsis = strings.Repeat("a", service.MaxSynopsisLength+1) }, "synopsis", service.ErrSynopsisTooLong},
+		{"elenco sem nome", func(m *service.Movie) { m.Cast = []service.CastMember{{Role: "Trinity"}} }, "cast[0].name", service.ErrEmptyCastName},
+		{"idioma inválido", func(m *service.Movie) { m.OriginalLanguage = "EN" }, "original_language", service.ErrInvalidLanguage},
+		{"classificação inválida", func(m *service.Movie) { m.AgeRating = "18+" }, "age_rating", service.ErrInvalidAgeRating},
+		{"ID do IMDb inválido", func(m *service.Movie) { m.ExternalIDs.IMDbID = "0133093" }, "external_ids.imdb_id", service.ErrInvalidIMDbID},
+		{"ID do TMDB negativo", func(m *service.Movie) { m.ExternalIDs.TMDBID = -603 }, "external_ids.tmdb_id", service.ErrInvalidTMDBID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movie := valid()
+			tt.edit(movie)
+
+			_, err := movieService.CreateMovie(ctx, movie)
+
+			var validationErr *service.ValidationError
+			if !errors.Is(err, tt.want) || !errors.As(err, &validationErr) {
+				t.Fatalf("Esperava %v, mas recebeu %v", tt.want, err)
+			}
+			if len(validationErr.Violations) != 1 || validationErr.Violations[0].Field != tt.field {
+				t.Errorf("Esperava uma violação no campo '%s', mas recebeu %+v", tt.field, validationErr.Violations)
+			}
+		})
+	}
+
+	// Vários campos inválidos geram uma violação para cada um.
+	_, err := movieService.CreateMovie(ctx, &service.Movie{RuntimeMinutes: -1, AgeRating: "X"})
+	var validationErr *service.ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Violations) != 3 {
+		t.Fatalf("Esperava violações em title, runtime_minutes e age_rating, mas recebeu %v", err)
+	}
+}
+
+// TestPatchMovie_ExternalIDsOneAtATime testa se a máscara aceita um único ID externo
+// sem apagar o outro.
+func TestPatchMovie_ExternalIDsOneAtATime(t *testing.T) {
+	// Arrange
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{
+		Title:       "Matrix",
+		ExternalIDs: service.ExternalIDs{IMDbID: "tt0133093"},
+	})
+
+	// Act
+	patch := &service.Movie{ExternalIDs: service.ExternalIDs{TMDBID: 603}}
+	patched, err := movieService.PatchMovie(ctx, created.ID, patch, []string{"external_ids.tmdb_id"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erro inesperado ao atualizar filme: %v", err)
+	}
+	want := service.ExternalIDs{IMDbID: "tt0133093", TMDBID: 603}
+	if patched.ExternalIDs != want {
+		t.Errorf("Esperava os IDs externos %+v, mas recebeu %+v", want, patched.ExternalIDs)
+	}
+}
+
+// TestPatchMovie_OnlyChangesMaskedFields testa se os campos fora da máscara são preservados.
+func TestPatchMovie_OnlyChangesMaskedFields(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{Title: "Interestelar", Director: "Christopher Nolan", Year: 2013})
+
+	// Act: envia um título vazio, mas apenas "year" está na máscara.
+	patched, err := movieService.PatchMovie(ctx, created.ID, &service.Movie{Year: 2014}, []string{"year"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erro inesperado ao atualizar filme: %v", err)
+	}
+	if patched.Year != 2014 {
+		t.Errorf("Esperava o ano 2014, mas recebeu %d", patched.Year)
+	}
+	if patched.Title != "Interestelar" || patched.Director != "Christopher Nolan" {
+		t.Errorf("Campos fora da máscara foram alterados: %+v", patched)
+	}
+}
+
+// patchDuringRead aplica uma outra atualização parcial logo depois de o serviço ler o
+// filme, como uma requisição concorrente que chega entre a leitura e a gravação.
+type patchDuringRead struct {
+	service.MovieRepository
+	concurrent func()
+}
+
+func (r *patchDuringRead) FindByID(ctx context.Context, id string) (*service.Movie, error) {
+	movie, err := r.MovieRepository.FindByID(ctx, id)
+	if r.concurrent != nil {
+		concurrent := r.concurrent
+		r.concurrent = nil
+		concurrent()
+	}
+	return movie, err
+}
+
+// TestPatchMovie_ConcurrentPatchesKeepBothFields testa se duas atualizações parciais de
+// campos diferentes, feitas ao mesmo tempo, não se sobrescrevem.
+func TestPatchMovie_ConcurrentPatchesKeepBothFields(t *testing.T) {
+	// Arrange
+	repo := &patchDuringRead{MovieRepository: newMovieRepository()}
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{Title: "Interestelar", Year: 2013})
+	repo.concurrent = func() {
+		if _, err := movieService.PatchMovie(ctx, created.ID, &service.Movie{Year: 2014}, []string{"year"}); err != nil {
+			t.Errorf("Erro inesperado na atualização concorrente: %v", err)
+		}
+	}
+
+	// Act: o título é gravado depois que o ano já mudou.
+	patched, err := movieService.PatchMovie(ctx, created.ID, &service.Movie{Title: "Interstellar"}, []string{"title"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erro inesperado ao atualizar filme: %v", err)
+	}
+	stored, _ := repo.FindByID(ctx, created.ID)
+	for _, movie := range []*service.Movie{patched, stored} {
+		if movie.Title != "Interstellar" || movie.Year != 2014 {
+			t.Errorf("Esperava o título e o ano novos, mas obteve %+v", movie)
+		}
+	}
+}
+
+// TestPatchMovie_FailsOnUnknownField testa se um campo desconhecido na máscara é rejeitado.
+func TestPatchMovie_FailsOnUnknownField(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	created, _ := movieService.CreateMovie(ctx, &service.Movie{Title: "Interestelar"})
+
+	// Act
+	_, err := movieService.PatchMovie(ctx, created.ID, &service.Movie{ID: "99"}, []string{"id"})
+
+	// Assert
+	if !errors.Is(err, service.ErrUnknownField) {
+		t.Errorf("Esperava ErrUnknownField, mas recebeu %v", err)
+	}
+}
+
+// TestListMovies_Pagination testa se percorrer as páginas retorna cada filme exatamente uma vez.
+func TestListMovies_Pagination(t *testing.T) {
+	// Arrange: 5 filmes, páginas de 2.
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		movieService.CreateMovie(ctx, &service.Movie{Title: "Filme " + strconv.Itoa(i)})
+	}
+
+	// Act
+	seen := map[string]bool{}
+	pages := 0
+	token := ""
+	for {
+		page, err := movieService.ListMovies(ctx, service.ListOptions{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("Erro inesperado ao listar filmes: %v", err)
+		}
+		if page.TotalSize != 5 {
+			t.Errorf("Esperava total 5, mas recebeu %d", page.TotalSize)
+		}
+		for _, movie := range page.Movies {
+			if seen[movie.ID] {
+				t.Errorf("Filme '%s' apareceu em mais de uma página", movie.ID)
+			}
+			seen[movie.ID] = true
+		}
+		pages++
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+
+	// Assert
+	if pages != 3 {
+		t.Errorf("Esperava 3 páginas, mas recebeu %d", pages)
+	}
+	if len(seen) != 5 {
+		t.Errorf("Esperava 5 filmes no total, mas recebeu %d", len(seen))
+	}
+}
+
+// TestListMovies_FailsOnInvalidPageToken testa se um token que não foi gerado pelo serviço é rejeitado.
+func TestListMovies_FailsOnInvalidPageToken(t *testing.T) {
+	// Arrange
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+
+	// Act
+	_, err := movieService.ListMovies(context.Background(), service.ListOptions{PageToken: "não-é-um-token"})
+
+	// Assert
+	if !errors.Is(err, service.ErrInvalidPageToken) {
+		t.Errorf("Esperava ErrInvalidPageToken, mas recebeu %v", err)
+	}
+}
+
+// TestListMovies_FilterAndOrder testa o filtro por década e a ordenação por ano (decrescente) e título,
+// percorrendo as páginas para garantir que a ordem se mantém entre elas.
+func TestListMovies_FilterAndOrder(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	for _, movie := range []*service.Movie{
+		{Title: "Pulp Fiction", Year: 1994},
+		{Title: "The Matrix", Year: 1999},
+		{Title: "Fight Club", Year: 1999},
+		{Title: "Interestelar", Year: 2014},
+		{Title: "Forrest Gump", Year: 1994},
+		{Title: "Psicose", Year: 1960},
+	} {
+		movieService.CreateMovie(ctx, movie)
+	}
+	opts := service.ListOptions{
+		PageSize: 2,
+		Filter:   service.MovieFilter{YearMin: 1990, YearMax: 1999},
+		OrderBy:  "year desc, title",
+	}
+
+	// Act
+	var titles []string
+	for {
+		page, err := movieService.ListMovies(ctx, opts)
+		if err != nil {
+			t.Fatalf("Erro inesperado ao listar filmes: %v", err)
+		}
+		if page.TotalSize != 4 {
+			t.Errorf("Esperava total 4, mas recebeu %d", page.TotalSize)
+		}
+		for _, movie := range page.Movies {
+			titles = append(titles, movie.Title)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		opts.PageToken = page.NextPageToken
+	}
+
+	// Assert
+	expected := []string{"Fight Club", "The Matrix", "Forrest Gump", "Pulp Fiction"}
+	if strings.Join(titles, "|") != strings.Join(expected, "|") {
+		t.Errorf("Esperava %v, mas recebeu %v", expected, titles)
+	}
+}
+
+// TestListMovies_FailsOnUnknownSortField testa se um campo de ordenação desconhecido é rejeitado.
+func TestListMovies_FailsOnUnknownSortField(t *testing.T) {
+	// Arrange
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+
+	// Act
+	_, err := movieService.ListMovies(context.Background(), service.ListOptions{OrderBy: "rating desc"})
+
+	// Assert
+	if !errors.Is(err, service.ErrInvalidOrderBy) {
+		t.Errorf("Esperava ErrInvalidOrderBy, mas recebeu %v", err)
+	}
+}
+
+// TestSearchMovies_RankedAndPaginated testa se a busca retorna os resultados mais relevantes primeiro
+// e se a paginação percorre todos eles.
+func TestSearchMovies_RankedAndPaginated(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	for _, title := range []string{"Star Wars", "Star Trek", "The Star Wars Holiday Special", "Alien"} {
+		movieService.CreateMovie(ctx, &service.Movie{Title: title})
+	}
+
+	// Act
+	first, err := movieService.SearchMovies(ctx, service.SearchOptions{Query: "star wars", PageSize: 2})
+	if err != nil {
+		t.Fatalf("Erro inesperado na busca: %v", err)
+	}
+	second, err := movieService.SearchMovies(ctx, service.SearchOptions{Query: "star wars", PageSize: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatalf("Erro inesperado na segunda página: %v", err)
+	}
+
+	// Assert
+	if first.TotalSize != 3 {
+		t.Errorf("Esperava 3 resultados, mas recebeu %d", first.TotalSize)
+	}
+	if first.Results[0].Score < first.Results[1].Score || first.Results[1].Score < second.Results[0].Score {
+		t.Errorf("Resultados fora da ordem de relevância")
+	}
+	if len(second.Results) != 1 || second.Results[0].Movie.Title != "Star Trek" || second.NextPageToken != "" {
+		t.Errorf("Esperava apenas 'Star Trek' na última página, mas recebeu %+v", second.Results)
+	}
+}
+
+// TestSearchMovies_FailsOnEmptyQuery testa se uma busca sem termos é rejeitada.
+func TestSearchMovies_FailsOnEmptyQuery(t *testing.T) {
+	// Arrange
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{})
+
+	// Act
+	_, err := movieService.SearchMovies(context.Background(), service.SearchOptions{Query: "  "})
+
+	// Assert
+	if !errors.Is(err, service.ErrEmptySearchQuery) {
+		t.Errorf("Esperava ErrEmptySearchQuery, mas recebeu %v", err)
+	}
+}
+
+// TestStreamMovies_StopsOnCallbackError testa se o streaming entrega os filmes filtrados
+// e se para assim que o consumidor retorna um erro.
+func TestStreamMovies_StopsOnCallbackError(t *testing.T) {
+	// Arrange
+	repo := newMovieRepository()
+	movieService := service.NewMovieService(repo, &fakeIDAllocator{})
+	ctx := context.Background()
+	for _, year := range []int32{1994, 1999, 2014, 1995} {
+		movieService.CreateMovie(ctx, &service.Movie{Title: "Filme", Year: year})
+	}
+	stop := errors.New("parar")
+
+	// Act
+	var received []int32
+	err := movieService.StreamMovies(ctx, service.MovieFilter{YearMax: 1999}, func(movie *service.Movie) error {
+		received = append(received, movie.Year)
+		if len(received) == 2 {
+			return stop
+		}
+		return nil
+	})
+
+	// Assert
+	if !errors.Is(err, stop) {
+		t.Errorf("Esperava o erro do consumidor, mas recebeu %v", err)
+	}
+	if len(received) != 2 || received[0] != 1994 || received[1] != 1999 {
+		t.Errorf("Esperava os anos [1994 1999], mas recebeu %v", received)
+	}
+}
+
+// TestMovieService_WorksWithEveryIDStrategy testa se criar, buscar e deletar funcionam
+// com os IDs de todas as estratégias, e não apenas com IDs numéricos.
+func TestMovieService_WorksWithEveryIDStrategy(t *testing.T) {
+	for _, strategy := range []string{idgen.StrategySequence, idgen.StrategyUUIDv7, idgen.StrategyULID} {
+		t.Run(strategy, func(t *testing.T) {
+			// Arrange
+			repo := newMovieRepository()
+			allocator, err := idgen.New(strategy, &fakeIDAllocator{})
+			if err != nil {
+				t.Fatalf("Erro inesperado ao criar o gerador: %v", err)
+			}
+			movieService := service.NewMovieService(repo, allocator)
+			ctx := context.Background()
+
+			// Act
+			created, err := movieService.CreateMovie(ctx, &service.Movie{Title: "The Matrix"})
+			if err != nil {
+				t.Fatalf("Erro inesperado ao criar filme: %v", err)
+			}
+			found, _ := movieService.GetMovie(ctx, created.ID)
+			deleteErr := movieService.DeleteMovie(ctx, created.ID)
+			afterDelete, _ := movieService.GetMovie(ctx, created.ID)
+
+			// Assert
+			if found == nil || found.ID != created.ID {
+				t.Errorf("Esperava encontrar o filme '%s', mas recebeu %+v", created.ID, found)
+			}
+			if deleteErr != nil || afterDelete != nil {
+				t.Errorf("Esperava que o filme '%s' fosse deletado (erro: %v)", created.ID, deleteErr)
+			}
+		})
+	}
+}
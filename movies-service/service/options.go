@@ -0,0 +1,71 @@
+// Local: movies-service/service/options.go
+
+package service
+
+import "time"
+
+// Option altera uma configuração do serviço na sua criação (ver NewMovieService).
+type Option func(*movieService)
+
+// WithMaxBatchSize muda a quantidade máxima de itens aceita pelas operações em lote
+// (BatchCreateMovies, BatchGetMovies e BatchDeleteMovies). Valores menores que 1 são ignorados.
+func WithMaxBatchSize(size int) Option {
+	return func(s *movieService) {
+		if size > 0 {
+			s.maxBatchSize = size
+		}
+	}
+}
+
+// WithSoftDelete ativa a exclusão lógica: DeleteMovie apenas esconde o filme, que pode ser
+// restaurado com RestoreMovie até ser apagado por PurgeDeletedMovies. A retenção é o tempo
+// mínimo que um filme excluído é mantido; zero mantém DefaultRetention.
+func WithSoftDelete(retention time.Duration) Option {
+	return func(s *movieService) {
+		s.softDelete = true
+		if retention > 0 {
+			s.retention = retention
+		}
+	}
+}
+
+// WithCollections faz com que os filmes apagados de vez (por DeleteMovie, BatchDeleteMovies
+// ou PurgeDeletedMovies) sejam retirados das coleções. Um filme com exclusão lógica continua
+// nas suas coleções, só não aparece nelas até ser restaurado.
+func WithCollections(collections CollectionRepository) Option {
+	return func(s *movieService) {
+		s.collections = collections
+	}
+}
+
+// WithClock troca o relógio do serviço. É útil nos testes, para controlar as datas de exclusão.
+func WithClock(now func() time.Time) Option {
+	return func(s *movieService) {
+		s.now = now
+	}
+}
+
+// WithPeople faz com que os créditos de um filme só sejam aceitos se todas as pessoas
+// citadas existirem. Sem esta opção, os IDs das pessoas não são conferidos.
+func WithPeople(people PersonRepository) Option {
+	return func(s *movieService) {
+		s.people = people
+	}
+}
+
+// WithRatings faz com que GetMovie traga a média e a quantidade das avaliações do filme.
+// Sem esta opção, o campo Rating fica sempre vazio.
+func WithRatings(ratings RatingSource) Option {
+	return func(s *movieService) {
+		s.ratings = ratings
+	}
+}
+
+// WithWatchlists faz com que os filmes apagados de vez saiam também das listas de interesse
+// dos usuários. Como nas coleções, um filme com exclusão lógica continua nas listas, só não
+// aparece nelas até ser restaurado.
+func WithWatchlists(watchlists WatchlistRepository) Option {
+	return func(s *movieService) {
+		s.watchlists = watchlists
+	}
+}
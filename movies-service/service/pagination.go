@@ -0,0 +1,121 @@
+// Local: movies-service/service/pagination.go
+
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// Limites de paginação da listagem de filmes.
+// Um page_size igual a zero usa o padrão; valores acima do máximo são reduzidos ao máximo.
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+)
+
+// ErrInvalidPageSize é retornado quando o tamanho de página pedido é negativo.
+var ErrInvalidPageSize = errors.New("o tamanho da página não pode ser negativo")
+
+// ErrInvalidPageToken é retornado quando o token de página não foi gerado por este serviço.
+var ErrInvalidPageToken = errors.New("token de página inválido")
+
+// ListOptions são os parâmetros de paginação, filtro e ordenação recebidos pelo serviço.
+// OrderBy segue o formato "campo [asc|desc], ..." (ex: "year desc, title").
+type ListOptions struct {
+	PageSize  int
+	PageToken string
+	Filter    MovieFilter
+	OrderBy   string
+}
+
+// MoviePage é uma página de resultados da listagem de filmes.
+// NextPageToken fica vazio quando não há mais páginas.
+type MoviePage struct {
+	Movies        []*Movie
+	NextPageToken string
+	TotalSize     int64
+}
+
+// MovieQuery descreve uma consulta paginada ao repositório (paginação por keyset).
+// O repositório devolve no máximo Limit filmes que satisfazem Filter, na ordem de OrderBy
+// (que sempre termina em "id", para que a ordem seja total), e que vêm depois de After.
+// After é nil na primeira página; nas outras, só os campos de OrderBy estão preenchidos.
+type MovieQuery struct {
+	Filter  MovieFilter
+	OrderBy []SortField
+	After   *Movie
+	Limit   int
+}
+
+// pageToken é o conteúdo do token opaco entregue ao cliente.
+// Ele guarda a ordenação usada e os valores de ordenação do último filme da página,
+// para que a próxima página comece logo depois dele.
+type pageToken struct {
+	OrderBy  string `json:"o"`
+	ID       string `json:"id"`
+	Title    string `json:"t,omitempty"`
+	Director string `json:"d,omitempty"`
+	Year     int32  `json:"y,omitempty"`
+}
+
+// newPageToken guarda apenas os campos usados na ordenação do último filme.
+func newPageToken(order []SortField, last *Movie) pageToken {
+	token := pageToken{OrderBy: formatOrderBy(order), ID: last.ID}
+	for _, field := range order {
+		switch field.Field {
+		case "title":
+			token.Title = last.Title
+		case "director":
+			token.Director = last.Director
+		case "year":
+			token.Year = last.Year
+		}
+	}
+	return token
+}
+
+// after converte o token de volta para o cursor usado pelo repositório.
+func (t pageToken) after() *Movie {
+	if t.ID == "" {
+		return nil
+	}
+	return &Movie{ID: t.ID, Title: t.Title, Director: t.Director, Year: t.Year}
+}
+
+// encodeToken transforma o conteúdo de um token (pageToken, searchToken) em uma
+// string opaca para o cliente: JSON codificado em base64.
+func encodeToken(token interface{}) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeToken faz o caminho inverso de encodeToken.
+// Um token vazio significa "primeira página" e deixa 'token' com o valor zero.
+func decodeToken(raw string, token interface{}) error {
+	if raw == "" {
+		return nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(data, token); err != nil {
+		return ErrInvalidPageToken
+	}
+	return nil
+}
+
+// pageSize aplica o padrão e o máximo ao tamanho de página pedido pelo cliente.
+func pageSize(requested int) (int, error) {
+	switch {
+	case requested < 0:
+		return 0, ErrInvalidPageSize
+	case requested == 0:
+		return DefaultPageSize, nil
+	case requested > MaxPageSize:
+		return MaxPageSize, nil
+	}
+	return requested, nil
+}
@@ -0,0 +1,270 @@
+// Local: movies-service/service/people.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Person é uma pessoa que participa dos filmes: diretores, atores, roteiristas...
+// Os filmes se referem a ela pelo ID (ver Credit), então "Nolan" e "Christopher Nolan"
+// só são a mesma pessoa se os créditos apontarem para o mesmo ID.
+type Person struct {
+	ID   string `json:"id" bson:"id"`
+	Name string `json:"name" bson:"name"`
+}
+
+// Credit é a participação de uma pessoa em um filme, com a função dela (Role).
+// Character é o personagem interpretado e só vale para os atores.
+type Credit struct {
+	PersonID  string `json:"person_id" bson:"person_id"`
+	Role      string `json:"role" bson:"role"`
+	Character string `json:"character,omitempty" bson:"character,omitempty"`
+}
+
+// Funções aceitas nos créditos de um filme.
+const (
+	RoleDirector = "director"
+	RoleWriter   = "writer"
+	RoleProducer = "producer"
+	RoleComposer = "composer"
+	RoleActor    = "actor"
+)
+
+// CreditRoles são todas as funções aceitas, na ordem em que aparecem nas mensagens de erro.
+var CreditRoles = []string{RoleDirector, RoleWriter, RoleProducer, RoleComposer, RoleActor}
+
+// PersonRepository é a porta de saída para persistir as pessoas.
+type PersonRepository interface {
+	// Save insere uma pessoa nova. Um ID repetido é um erro da categoria ErrConflict.
+	Save(ctx context.Context, person *Person) error
+	// FindByID retorna (nil, nil) se a pessoa não existir.
+	FindByID(ctx context.Context, id string) (*Person, error)
+	// FindByIDs retorna as pessoas encontradas entre os IDs pedidos, ordenadas por ID.
+	// IDs inexistentes são simplesmente ignorados.
+	FindByIDs(ctx context.Context, ids []string) ([]*Person, error)
+	// FindByName retorna a pessoa de menor ID com exatamente esse nome, ou (nil, nil).
+	FindByName(ctx context.Context, name string) (*Person, error)
+	// FindOrCreateByName retorna a pessoa de menor ID com o nome de person ou, se não houver
+	// nenhuma, insere person. Chamadas simultâneas com o mesmo nome criam uma única pessoa.
+	// O bool informa se person foi inserida.
+	FindOrCreateByName(ctx context.Context, person *Person) (*Person, bool, error)
+	// FindAll retorna todas as pessoas, ordenadas por nome e, no mesmo nome, por ID.
+	FindAll(ctx context.Context) ([]*Person, error)
+	// Update substitui os dados da pessoa. Retorna (nil, nil) se ela não existir.
+	Update(ctx context.Context, person *Person) (*Person, error)
+	// DeleteByID retorna false se a pessoa não existir.
+	DeleteByID(ctx context.Context, id string) (bool, error)
+}
+
+// PeopleService é a porta de entrada das pessoas.
+type PeopleService interface {
+	CreatePerson(ctx context.Context, person *Person) (*Person, error)
+	GetPerson(ctx context.Context, id string) (*Person, error)
+	ListPeople(ctx context.Context) ([]*Person, error)
+	UpdatePerson(ctx context.Context, person *Person) (*Person, error)
+	DeletePerson(ctx context.Context, id string) error
+	ListPersonMovies(ctx context.Context, personID string) ([]*Movie, error)
+}
+
+// Limites das pessoas e dos créditos.
+const (
+	MaxPersonNameLength = 200
+	MaxCredits          = 500
+)
+
+// Erros específicos das pessoas e dos créditos. Os de validação pertencem à categoria
+// ErrInvalidArgument; ErrPersonHasCredits pertence à categoria ErrConflict.
+var (
+	ErrEmptyPersonID     = errors.New("o ID da pessoa não pode ser vazio")
+	ErrEmptyPersonName   = errors.New("o nome da pessoa não pode ser vazio")
+	ErrPersonNameTooLong = fmt.Errorf("o nome da pessoa pode ter no máximo %d caracteres", MaxPersonNameLength)
+	ErrTooManyCredits    = fmt.Errorf("um filme pode ter no máximo %d créditos", MaxCredits)
+	ErrInvalidCreditRole = fmt.Errorf("a função deve ser uma de: %s", strings.Join(CreditRoles, ", "))
+	ErrDuplicateCredit   = errors.New("a pessoa já tem um crédito com essa função no filme")
+	ErrCharacterNotActor = errors.New("apenas os créditos de ator têm personagem")
+	ErrPersonHasCredits  = fmt.Errorf("%w: a pessoa ainda aparece nos créditos de algum filme", ErrConflict)
+)
+
+// filmographyOrder é a ordem dos filmes de uma pessoa: do mais antigo para o mais novo.
+var filmographyOrder = []SortField{{Field: "year"}, {Field: "title"}, {Field: "id"}}
+
+type peopleService struct {
+	people PersonRepository // Porta de saída das pessoas.
+	movies MovieRepository  // Usada para montar a filmografia e proteger os créditos.
+	ids    IDAllocator      // Gera os IDs das pessoas novas.
+}
+
+// NewPeopleService cria o serviço de pessoas. Ele recebe também o repositório de filmes,
+// que guarda os créditos de cada pessoa.
+func NewPeopleService(people PersonRepository, movies MovieRepository, ids IDAllocator) PeopleService {
+	return &peopleService{people: people, movies: movies, ids: ids}
+}
+
+// CreatePerson cria uma pessoa. O nome é guardado sem espaços repetidos ou nas pontas.
+func (s *peopleService) CreatePerson(ctx context.Context, person *Person) (*Person, error) {
+	person.Name = normalizeName(person.Name)
+	if err := validatePerson(person); err != nil {
+		return nil, err
+	}
+	newID, err := s.ids.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	person.ID = newID
+	if err := s.people.Save(ctx, person); err != nil {
+		return nil, err
+	}
+	return person, nil
+}
+
+// GetPerson busca uma pessoa. Retorna um erro da categoria ErrNotFound se ela não existir.
+func (s *peopleService) GetPerson(ctx context.Context, id string) (*Person, error) {
+	if id == "" {
+		return nil, invalidArgument("id", ErrEmptyPersonID)
+	}
+	person, err := s.people.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if person == nil {
+		return nil, personNotFound(id)
+	}
+	return person, nil
+}
+
+// ListPeople retorna todas as pessoas, ordenadas por nome.
+func (s *peopleService) ListPeople(ctx context.Context) ([]*Person, error) {
+	return s.people.FindAll(ctx)
+}
+
+// UpdatePerson altera o nome de uma pessoa. Os créditos continuam apontando para ela.
+func (s *peopleService) UpdatePerson(ctx context.Context, person *Person) (*Person, error) {
+	if person.ID == "" {
+		return nil, invalidArgument("id", ErrEmptyPersonID)
+	}
+	person.Name = normalizeName(person.Name)
+	if err := validatePerson(person); err != nil {
+		return nil, err
+	}
+	updated, err := s.people.Update(ctx, person)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, personNotFound(person.ID)
+	}
+	return updated, nil
+}
+
+// DeletePerson apaga uma pessoa que não aparece nos créditos de nenhum filme. Se ela ainda
+// aparecer, o erro é ErrPersonHasCredits: os créditos precisam ser tirados antes. Os filmes
+// com exclusão lógica também contam, já que podem ser restaurados com os seus créditos.
+func (s *peopleService) DeletePerson(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidArgument("id", ErrEmptyPersonID)
+	}
+	credited, err := s.movies.Count(ctx, MovieFilter{PersonID: id, IncludeDeleted: true})
+	if err != nil {
+		return err
+	}
+	if credited > 0 {
+		return fmt.Errorf("%w (%d filmes)", ErrPersonHasCredits, credited)
+	}
+	deleted, err := s.people.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return personNotFound(id)
+	}
+	return nil
+}
+
+// ListPersonMovies retorna a filmografia da pessoa: os filmes em que ela tem algum crédito,
+// do mais antigo para o mais novo. Filmes com exclusão lógica ficam de fora.
+func (s *peopleService) ListPersonMovies(ctx context.Context, personID string) ([]*Movie, error) {
+	if personID == "" {
+		return nil, invalidArgument("person_id", ErrEmptyPersonID)
+	}
+	if _, err := s.GetPerson(ctx, personID); err != nil {
+		return nil, err
+	}
+	movies := make([]*Movie, 0)
+	err := s.movies.Stream(ctx, MovieFilter{PersonID: personID}, func(movie *Movie) error {
+		movies = append(movies, movie)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(movies, func(i, j int) bool {
+		return CompareMovies(movies[i], movies[j], filmographyOrder) < 0
+	})
+	return movies, nil
+}
+
+// validatePerson verifica o nome de uma pessoa.
+func validatePerson(person *Person) error {
+	switch {
+	case person.Name == "":
+		return invalidArgument("name", ErrEmptyPersonName)
+	case utf8.RuneCountInString(person.Name) > MaxPersonNameLength:
+		return invalidArgument("name", ErrPersonNameTooLong)
+	}
+	return nil
+}
+
+// validateCredits verifica os créditos de um filme, registrando as violações em v.
+// A existência das pessoas é conferida à parte (ver movieService.checkPeopleExist).
+func validateCredits(v *violations, credits []Credit) {
+	if len(credits) > MaxCredits {
+		v.add("credits", ErrTooManyCredits)
+	}
+	seen := make(map[Credit]bool, len(credits))
+	for i, credit := range credits {
+		field := fmt.Sprintf("credits[%d]", i)
+		if credit.PersonID == "" {
+			v.add(field+".person_id", ErrEmptyPersonID)
+		}
+		if !isCreditRole(credit.Role) {
+			v.add(field+".role", ErrInvalidCreditRole)
+		}
+		switch {
+		case credit.Character != "" && credit.Role != RoleActor:
+			v.add(field+".character", ErrCharacterNotActor)
+		case utf8.RuneCountInString(credit.Character) > MaxTextFieldLength:
+			v.add(field+".character", ErrTextTooLong)
+		}
+		// Um ator pode ter dois personagens, mas ninguém dirige o mesmo filme duas vezes.
+		key := Credit{PersonID: credit.PersonID, Role: credit.Role, Character: credit.Character}
+		if seen[key] {
+			v.add(field, ErrDuplicateCredit)
+		}
+		seen[key] = true
+	}
+}
+
+func isCreditRole(role string) bool {
+	for _, valid := range CreditRoles {
+		if role == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeName tira os espaços das pontas e junta os espaços repetidos do meio.
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// personNotFound é o erro retornado quando uma pessoa não existe.
+func personNotFound(id string) error {
+	return &NotFoundError{Resource: "pessoa", ID: id}
+}
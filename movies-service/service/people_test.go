@@ -0,0 +1,238 @@
+// Local: movies-service/service/people_test.go
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// TestPeople_Filmography testa se a filmografia traz os filmes com qualquer crédito da
+// pessoa, do mais antigo para o mais novo, e se uma pessoa com créditos não pode ser apagada.
+func TestPeople_Filmography(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	people := memory.NewPersonRepository()
+	peopleService := service.NewPeopleService(people, movies, &fakeIDAllocator{last: 100})
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{}, service.WithPeople(people))
+	scott, err := peopleService.CreatePerson(ctx, &service.Person{Name: "  Ridley   Scott "})
+	if err != nil || scott.Name != "Ridley Scott" {
+		t.Fatalf("Esperava o nome sem espaços sobrando, mas obteve (%+v, %v)", scott, err)
+	}
+	directed := []service.Credit{{PersonID: scott.ID, Role: service.RoleDirector}}
+	for _, movie := range []*service.Movie{
+		{Title: "Gladiator", Year: 2000, Credits: directed},
+		{Title: "Alien", Year: 1979, Credits: directed},
+		{Title: "Aliens", Year: 1986},
+		{Title: "Prometheus", Year: 2012, Credits: []service.Credit{{PersonID: scott.ID, Role: service.RoleProducer}}},
+	} {
+		if _, err := movieService.CreateMovie(ctx, movie); err != nil {
+			t.Fatalf("Erro inesperado ao criar '%s': %v", movie.Title, err)
+		}
+	}
+
+	// Act
+	filmography, err := peopleService.ListPersonMovies(ctx, scott.ID)
+
+	// Assert
+	titles := make([]string, 0, len(filmography))
+	for _, movie := range filmography {
+		titles = append(titles, movie.Title)
+	}
+	if err != nil || fmt.Sprint(titles) != "[Alien Gladiator Prometheus]" {
+		t.Errorf("Esperava [Alien Gladiator Prometheus], mas obteve %v (erro: %v)", titles, err)
+	}
+	if err := peopleService.DeletePerson(ctx, scott.ID); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict ao apagar uma pessoa com créditos, mas recebeu %v", err)
+	}
+	if _, err := peopleService.ListPersonMovies(ctx, "999"); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound para uma pessoa inexistente, mas recebeu %v", err)
+	}
+}
+
+// TestDeletePerson_CreditedOnSoftDeletedMovie testa se os créditos dos filmes na lixeira
+// também impedem apagar a pessoa, para que o filme possa ser restaurado depois.
+func TestDeletePerson_CreditedOnSoftDeletedMovie(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	people := memory.NewPersonRepository()
+	peopleService := service.NewPeopleService(people, movies, &fakeIDAllocator{last: 100})
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{}, service.WithPeople(people), service.WithSoftDelete(time.Hour))
+	weaver, err := peopleService.CreatePerson(ctx, &service.Person{Name: "Sigourney Weaver"})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao criar a pessoa: %v", err)
+	}
+	movie, err := movieService.CreateMovie(ctx, &service.Movie{Title: "Alien", Year: 1979,
+		Credits: []service.Credit{{PersonID: weaver.ID, Role: service.RoleActor, Character: "Ripley"}}})
+	if err != nil {
+		t.Fatalf("Erro inesperado ao criar o filme: %v", err)
+	}
+	if err := movieService.DeleteMovie(ctx, movie.ID); err != nil {
+		t.Fatalf("Erro inesperado ao excluir o filme: %v", err)
+	}
+
+	// Act
+	err = peopleService.DeletePerson(ctx, weaver.ID)
+
+	// Assert
+	if !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict ao apagar uma pessoa com créditos em um filme excluído, mas recebeu %v", err)
+	}
+	if restored, err := movieService.RestoreMovie(ctx, movie.ID); err != nil || len(restored.Credits) != 1 {
+		t.Errorf("Esperava restaurar o filme com o crédito, mas obteve (%+v, %v)", restored, err)
+	}
+}
+
+// TestCreateMovie_ValidatesCredits testa as regras dos créditos e a conferência das pessoas.
+func TestCreateMovie_ValidatesCredits(t *testing.T) {
+	ctx := context.Background()
+	people := memory.NewPersonRepository()
+	people.Save(ctx, &service.Person{ID: "p1", Name: "Sigourney Weaver"})
+	movieService := service.NewMovieService(newMovieRepository(), &fakeIDAllocator{}, service.WithPeople(people))
+
+	// Erros de formato trazem uma violação por campo.
+	_, err := movieService.CreateMovie(ctx, &service.Movie{Title: "Alien", Credits: []service.Credit{
+		{PersonID: "p1", Role: "star"},
+		{PersonID: "", Role: service.RoleActor},
+		{PersonID: "p1", Role: service.RoleDirector, Character: "Ripley"},
+		{PersonID: "p1", Role: service.RoleActor, Character: "Ripley"},
+		{PersonID: "p1", Role: service.RoleActor, Character: "Ripley"},
+	}})
+	var validationErr *service.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Esperava um ValidationError, mas recebeu %v", err)
+	}
+	var fields []string
+	for _, violation := range validationErr.Violations {
+		fields = append(fields, violation.Field)
+	}
+	if want := "[credits[0].role credits[1].person_id credits[2].character credits[4]]"; fmt.Sprint(fields) != want {
+		t.Errorf("Esperava as violações %s, mas obteve %v", want, fields)
+	}
+
+	// Uma pessoa inexistente é um erro da categoria ErrNotFound.
+	_, err = movieService.CreateMovie(ctx, &service.Movie{Title: "Alien", Credits: []service.Credit{{PersonID: "p2", Role: service.RoleDirector}}})
+	if !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound para uma pessoa inexistente, mas recebeu %v", err)
+	}
+}
+
+// TestMigrateDirectors testa se os nomes dos diretores viram pessoas (reaproveitando as que
+// já existem) e se a migração registrada não roda de novo.
+func TestMigrateDirectors(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	people := memory.NewPersonRepository()
+	migrations := memory.NewMigrationRepository()
+	people.Save(ctx, &service.Person{ID: "p1", Name: "Ridley Scott"})
+	alreadyLinked := []service.Credit{{PersonID: "p1", Role: service.RoleDirector}}
+	for _, movie := range []*service.Movie{
+		{ID: "1", Title: "Alien", Director: "Ridley Scott"},
+		{ID: "2", Title: "Aliens", Director: "James  Cameron"},
+		{ID: "3", Title: "The Terminator", Director: "James Cameron "},
+		{ID: "4", Title: "Gladiator", Director: "Ridley Scott", Credits: alreadyLinked},
+		{ID: "5", Title: "Sem diretor"},
+	} {
+		movies.Save(ctx, movie)
+	}
+
+	// Act
+	first, err := service.MigrateDirectors(ctx, movies, people, &fakeIDAllocator{last: 100}, migrations)
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	second, err := service.MigrateDirectors(ctx, movies, people, &fakeIDAllocator{last: 200}, migrations)
+
+	// Assert
+	if first != (service.DirectorMigration{PeopleCreated: 1, MoviesLinked: 3}) {
+		t.Errorf("Esperava 1 pessoa criada e 3 filmes ligados, mas obteve %+v", first)
+	}
+	if err != nil || second != (service.DirectorMigration{AlreadyApplied: true}) {
+		t.Errorf("Esperava que a segunda execução não fizesse nada, mas obteve %+v (erro: %v)", second, err)
+	}
+	for id, want := range map[string]string{"1": "p1", "2": "101", "3": "101", "4": "p1"} {
+		movie, _ := movies.FindByID(ctx, id)
+		if len(movie.Credits) != 1 || movie.Credits[0] != (service.Credit{PersonID: want, Role: service.RoleDirector}) {
+			t.Errorf("Filme %s: esperava um crédito de direção para %s, mas obteve %+v", id, want, movie.Credits)
+		}
+	}
+}
+
+// TestMigrateDirectors_RemovedCreditStaysRemoved testa se um crédito de direção removido
+// depois da migração não volta quando o serviço reinicia.
+func TestMigrateDirectors_RemovedCreditStaysRemoved(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	people := memory.NewPersonRepository()
+	migrations := memory.NewMigrationRepository()
+	movies.Save(ctx, &service.Movie{ID: "1", Title: "Alien", Director: "Ridley Scott"})
+	if _, err := service.MigrateDirectors(ctx, movies, people, &fakeIDAllocator{last: 100}, migrations); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{})
+	if _, err := movieService.PatchMovie(ctx, "1", &service.Movie{}, []string{"credits"}); err != nil {
+		t.Fatalf("Erro inesperado ao remover os créditos: %v", err)
+	}
+
+	// Act: uma nova inicialização roda a migração de novo.
+	result, err := service.MigrateDirectors(ctx, movies, people, &fakeIDAllocator{last: 200}, migrations)
+
+	// Assert
+	movie, _ := movies.FindByID(ctx, "1")
+	if err != nil || !result.AlreadyApplied || len(movie.Credits) != 0 {
+		t.Errorf("Esperava o filme sem créditos, mas obteve %+v (resultado: %+v, erro: %v)", movie.Credits, result, err)
+	}
+}
+
+// editDuringStream altera o filme 1 logo depois do streaming da migração, como uma
+// requisição que chega enquanto ela ainda está ligando os diretores.
+type editDuringStream struct {
+	service.MovieRepository
+}
+
+func (r editDuringStream) Stream(ctx context.Context, filter service.MovieFilter, fn func(*service.Movie) error) error {
+	if err := r.MovieRepository.Stream(ctx, filter, fn); err != nil {
+		return err
+	}
+	edited := &service.Movie{ID: "1", Title: "Alien: O Oitavo Passageiro", Director: "Ridley Scott",
+		Credits: []service.Credit{{PersonID: "p9", Role: service.RoleWriter}}}
+	_, err := r.MovieRepository.Update(ctx, edited)
+	return err
+}
+
+// TestMigrateDirectors_KeepsConcurrentEdits testa se a migração não sobrescreve um filme
+// alterado entre a leitura e a gravação: só os créditos são gravados, e só se não mudaram.
+func TestMigrateDirectors_KeepsConcurrentEdits(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	movies.Save(ctx, &service.Movie{ID: "1", Title: "Alien", Director: "Ridley Scott"})
+	movies.Save(ctx, &service.Movie{ID: "2", Title: "Gladiator", Director: "Ridley Scott"})
+
+	// Act
+	result, err := service.MigrateDirectors(ctx, editDuringStream{movies}, memory.NewPersonRepository(),
+		&fakeIDAllocator{last: 100}, memory.NewMigrationRepository())
+
+	// Assert
+	if err != nil || result.MoviesLinked != 1 {
+		t.Fatalf("Esperava só o filme 2 ligado, mas obteve %+v (erro: %v)", result, err)
+	}
+	edited, _ := movies.FindByID(ctx, "1")
+	if edited.Title != "Alien: O Oitavo Passageiro" || len(edited.Credits) != 1 || edited.Credits[0].Role != service.RoleWriter {
+		t.Errorf("Esperava a edição concorrente intacta, mas obteve %+v", edited)
+	}
+	linked, _ := movies.FindByID(ctx, "2")
+	if len(linked.Credits) != 1 || linked.Credits[0] != (service.Credit{PersonID: "101", Role: service.RoleDirector}) {
+		t.Errorf("Esperava o crédito de direção no filme 2, mas obteve %+v", linked.Credits)
+	}
+}
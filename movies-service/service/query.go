@@ -0,0 +1,180 @@
+// Local: movies-service/service/query.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidFilter é retornado quando os critérios de filtro são incoerentes.
+var ErrInvalidFilter = errors.New("filtro inválido")
+
+// ErrInvalidOrderBy é retornado quando a ordenação cita um campo desconhecido ou tem formato inválido.
+var ErrInvalidOrderBy = errors.New("ordenação inválida")
+
+// MovieFilter são os critérios de filtro da listagem. Campos com valor zero são ignorados.
+// TitleContains não diferencia maiúsculas de minúsculas; Director precisa ser igual.
+// PersonID seleciona os filmes em que a pessoa tem algum crédito, com qualquer função.
+// IncludeDeleted também conta os filmes com exclusão lógica; é usado só internamente (ex:
+// para saber se uma pessoa ainda tem créditos) e nunca vem da API.
+type MovieFilter struct {
+	YearMin        int32
+	YearMax        int32
+	TitleContains  string
+	Director       string
+	PersonID       string
+	IncludeDeleted bool
+}
+
+// SortField é um campo da ordenação, em ordem crescente ou decrescente (Desc).
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// sortableFields são os campos pelos quais a listagem pode ser ordenada.
+var sortableFields = map[string]bool{
+	"id":       true,
+	"title":    true,
+	"director": true,
+	"year":     true,
+}
+
+// Validate verifica se o filtro é coerente (ex: ano mínimo maior que o máximo).
+// O erro é um *ValidationError que aponta o campo do filtro com problema.
+func (f MovieFilter) Validate() error {
+	if f.YearMin < 0 {
+		return invalidArgument("filter.year_min", fmt.Errorf("%w: o ano não pode ser negativo", ErrInvalidFilter))
+	}
+	if f.YearMax < 0 {
+		return invalidArgument("filter.year_max", fmt.Errorf("%w: o ano não pode ser negativo", ErrInvalidFilter))
+	}
+	if f.YearMin != 0 && f.YearMax != 0 && f.YearMin > f.YearMax {
+		return invalidArgument("filter.year_min",
+			fmt.Errorf("%w: o ano mínimo (%d) é maior que o máximo (%d)", ErrInvalidFilter, f.YearMin, f.YearMax))
+	}
+	return nil
+}
+
+// Matches indica se o filme satisfaz o filtro.
+// É a referência de comportamento para adaptadores que filtram em memória.
+func (f MovieFilter) Matches(movie *Movie) bool {
+	if f.YearMin != 0 && movie.Year < f.YearMin {
+		return false
+	}
+	if f.YearMax != 0 && movie.Year > f.YearMax {
+		return false
+	}
+	if f.TitleContains != "" && !strings.Contains(strings.ToLower(movie.Title), strings.ToLower(f.TitleContains)) {
+		return false
+	}
+	if f.Director != "" && movie.Director != f.Director {
+		return false
+	}
+	if f.PersonID != "" && !hasCredit(movie, f.PersonID) {
+		return false
+	}
+	return true
+}
+
+// CompareMovies compara dois filmes segundo a ordenação dada e retorna -1, 0 ou 1.
+// Strings são comparadas byte a byte, da mesma forma que o MongoDB faz sem collation.
+func CompareMovies(a, b *Movie, order []SortField) int {
+	for _, field := range order {
+		var c int
+		switch field.Field {
+		case "id":
+			c = strings.Compare(a.ID, b.ID)
+		case "title":
+			c = strings.Compare(a.Title, b.Title)
+		case "director":
+			c = strings.Compare(a.Director, b.Director)
+		case "year":
+			c = compareInt32(a.Year, b.Year)
+		}
+		if field.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareInt32(a, b int32) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// parseOrderBy interpreta o formato "campo [asc|desc], ...".
+// O "id" é sempre acrescentado ao final (se ainda não estiver presente) como critério de
+// desempate, para que a ordem seja total e a paginação por keyset seja estável.
+func parseOrderBy(raw string) ([]SortField, error) {
+	var order []SortField
+	seen := map[string]bool{}
+
+	if strings.TrimSpace(raw) != "" {
+		for _, part := range strings.Split(raw, ",") {
+			words := strings.Fields(part)
+			if len(words) == 0 || len(words) > 2 {
+				return nil, fmt.Errorf("%w: '%s'", ErrInvalidOrderBy, strings.TrimSpace(part))
+			}
+
+			field := SortField{Field: words[0]}
+			if !sortableFields[field.Field] {
+				return nil, fmt.Errorf("%w: campo desconhecido '%s'", ErrInvalidOrderBy, field.Field)
+			}
+			if seen[field.Field] {
+				return nil, fmt.Errorf("%w: campo repetido '%s'", ErrInvalidOrderBy, field.Field)
+			}
+			if len(words) == 2 {
+				switch words[1] {
+				case "asc":
+				case "desc":
+					field.Desc = true
+				default:
+					return nil, fmt.Errorf("%w: direção desconhecida '%s'", ErrInvalidOrderBy, words[1])
+				}
+			}
+
+			seen[field.Field] = true
+			order = append(order, field)
+		}
+	}
+
+	if !seen["id"] {
+		order = append(order, SortField{Field: "id"})
+	}
+	return order, nil
+}
+
+// formatOrderBy é o inverso de parseOrderBy, usado para guardar a ordenação no token.
+func formatOrderBy(order []SortField) string {
+	parts := make([]string, 0, len(order))
+	for _, field := range order {
+		if field.Desc {
+			parts = append(parts, field.Field+" desc")
+		} else {
+			parts = append(parts, field.Field)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// hasCredit indica se a pessoa aparece nos créditos do filme.
+func hasCredit(movie *Movie, personID string) bool {
+	for _, credit := range movie.Credits {
+		if credit.PersonID == personID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+// Local: movies-service/service/ratings.go
+
+package service
+
+import "context"
+
+// RatingSummary é a média e a quantidade das notas que os usuários deram a um filme.
+type RatingSummary struct {
+	Average float64 `json:"average"`
+	Count   int64   `json:"count"`
+}
+
+// RatingSource é a porta de saída que consulta as avaliações dos filmes, guardadas pelo
+// reviews-service. O movies-service só lê o resumo: as avaliações em si não passam por ele.
+type RatingSource interface {
+	// RatingSummary retorna o resumo das notas do filme. Um filme sem avaliações tem um
+	// resumo zerado, e não nil.
+	RatingSummary(ctx context.Context, movieID string) (*RatingSummary, error)
+}
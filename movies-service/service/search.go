@@ -0,0 +1,84 @@
+// Local: movies-service/service/search.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrEmptySearchQuery é retornado quando a busca é feita sem nenhum termo.
+var ErrEmptySearchQuery = errors.New("o termo de busca não pode ser vazio")
+
+// SearchOptions são os parâmetros da busca textual recebidos pelo serviço.
+type SearchOptions struct {
+	Query     string
+	PageSize  int
+	PageToken string
+}
+
+// SearchResult é um filme encontrado pela busca, com a sua pontuação de relevância.
+type SearchResult struct {
+	Movie *Movie
+	Score float64
+}
+
+// SearchPage é uma página de resultados da busca, do mais relevante para o menos relevante.
+type SearchPage struct {
+	Results       []*SearchResult
+	NextPageToken string
+	TotalSize     int64
+}
+
+// SearchQuery descreve uma busca ao repositório.
+// Os resultados vêm ordenados por pontuação (decrescente) e, em caso de empate, por ID.
+// Como a pontuação não pode ser usada como cursor, a paginação aqui é por deslocamento (Offset).
+type SearchQuery struct {
+	Text   string
+	Offset int
+	Limit  int
+}
+
+// searchToken é o conteúdo do token de página da busca.
+// Guardamos o termo para que o token não seja usado com outra busca.
+type searchToken struct {
+	Query  string `json:"q"`
+	Offset int    `json:"n"`
+}
+
+// SearchMovies busca filmes pelo título e retorna uma página ordenada por relevância.
+func (s *movieService) SearchMovies(ctx context.Context, opts SearchOptions) (*SearchPage, error) {
+	text := strings.TrimSpace(opts.Query)
+	if text == "" {
+		return nil, invalidArgument("query", ErrEmptySearchQuery)
+	}
+	size, err := pageSize(opts.PageSize)
+	if err != nil {
+		return nil, invalidArgument("page_size", err)
+	}
+	var token searchToken
+	if err := decodeToken(opts.PageToken, &token); err != nil {
+		return nil, invalidArgument("page_token", err)
+	}
+	if opts.PageToken != "" && (token.Query != text || token.Offset <= 0) {
+		return nil, invalidArgument("page_token", ErrInvalidPageToken)
+	}
+
+	// Pedimos um resultado a mais para saber se existe uma próxima página.
+	results, err := s.repo.Search(ctx, SearchQuery{Text: text, Offset: token.Offset, Limit: size + 1})
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repo.CountSearch(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SearchPage{Results: results, TotalSize: total}
+	if len(results) > size {
+		page.Results = results[:size]
+		page.NextPageToken = encodeToken(searchToken{Query: text, Offset: token.Offset + size})
+	}
+	return page, nil
+}
@@ -0,0 +1,151 @@
+// Local: movies-service/service/validate.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limites dos campos de um filme.
+const (
+	MaxGenres          = 10
+	MaxCastMembers     = 200
+	MaxRuntimeMinutes  = 24 * 60
+	MaxSynopsisLength  = 5000 // Em caracteres, não em bytes.
+	MaxTextFieldLength = 200  // Vale para gêneros e para nomes e papéis do elenco.
+)
+
+// Erros de validação dos campos de um filme. Todos pertencem à categoria ErrInvalidArgument
+// (ver errors.go) e cada um aparece na violação do campo correspondente.
+var (
+	// ErrEmptyTitle é retornado quando um filme é criado ou atualizado sem título.
+	ErrEmptyTitle       = errors.New("o título do filme não pode ser vazio")
+	ErrEmptyGenre       = errors.New("o gênero não pode ser vazio")
+	ErrDuplicateGenre   = errors.New("gênero repetido")
+	ErrTooManyGenres    = fmt.Errorf("um filme pode ter no máximo %d gêneros", MaxGenres)
+	ErrInvalidRuntime   = fmt.Errorf("a duração deve estar entre 0 e %d minutos", MaxRuntimeMinutes)
+	ErrSynopsisTooLong  = fmt.Errorf("a sinopse pode ter no máximo %d caracteres", MaxSynopsisLength)
+	ErrTooManyCast      = fmt.Errorf("o elenco pode ter no máximo %d pessoas", MaxCastMembers)
+	ErrEmptyCastName    = errors.New("o nome de quem está no elenco não pode ser vazio")
+	ErrTextTooLong      = fmt.Errorf("o texto pode ter no máximo %d caracteres", MaxTextFieldLength)
+	ErrInvalidLanguage  = errors.New("o idioma deve ser um código ISO 639-1 em minúsculas (ex: \"en\")")
+	ErrInvalidAgeRating = fmt.Errorf("a classificação deve ser uma de: %s", strings.Join(AgeRatings, ", "))
+	ErrInvalidIMDbID    = errors.New("o ID do IMDb deve ter o formato \"tt\" seguido de 7 ou mais dígitos")
+	ErrInvalidTMDBID    = errors.New("o ID do TMDB não pode ser negativo")
+)
+
+// AgeRatings são as classificações indicativas aceitas (as da MPAA), mais NR para
+// filmes não classificados.
+var AgeRatings = []string{"G", "PG", "PG-13", "R", "NC-17", "NR"}
+
+var (
+	languagePattern = regexp.MustCompile(`^[a-z]{2}$`)
+	imdbIDPattern   = regexp.MustCompile(`^tt[0-9]{7,}$`)
+)
+
+// validateMovie concentra as regras de validação usadas na criação e na atualização.
+// Todos os campos são verificados, e o erro traz uma violação para cada campo inválido,
+// então o cliente consegue corrigir tudo de uma vez. Só o título é obrigatório.
+func validateMovie(movie *Movie) error {
+	v := &violations{}
+
+	if movie.Title == "" {
+		v.add("title", ErrEmptyTitle)
+	}
+
+	// 1. Gêneros: sem vazios e sem repetições (ignorando maiúsculas e minúsculas).
+	if len(movie.Genres) > MaxGenres {
+		v.add("genres", ErrTooManyGenres)
+	}
+	seen := make(map[string]bool, len(movie.Genres))
+	for i, genre := range movie.Genres {
+		field := fmt.Sprintf("genres[%d]", i)
+		key := strings.ToLower(strings.TrimSpace(genre))
+		switch {
+		case key == "":
+			v.add(field, ErrEmptyGenre)
+		case utf8.RuneCountInString(genre) > MaxTextFieldLength:
+			v.add(field, ErrTextTooLong)
+		case seen[key]:
+			v.add(field, ErrDuplicateGenre)
+		}
+		seen[key] = true
+	}
+
+	// 2. Duração e sinopse. Duração 0 significa "desconhecida".
+	if movie.RuntimeMinutes < 0 || movie.RuntimeMinutes > MaxRuntimeMinutes {
+		v.add("runtime_minutes", ErrInvalidRuntime)
+	}
+	if utf8.RuneCountInString(movie.Synopsis) > MaxSynopsisLength {
+		v.add("synopsis", ErrSynopsisTooLong)
+	}
+
+	// 3. Elenco: o nome é obrigatório e o papel é opcional.
+	if len(movie.Cast) > MaxCastMembers {
+		v.add("cast", ErrTooManyCast)
+	}
+	for i, member := range movie.Cast {
+		if strings.TrimSpace(member.Name) == "" {
+			v.add(fmt.Sprintf("cast[%d].name", i), ErrEmptyCastName)
+		} else if utf8.RuneCountInString(member.Name) > MaxTextFieldLength {
+			v.add(fmt.Sprintf("cast[%d].name", i), ErrTextTooLong)
+		}
+		if utf8.RuneCountInString(member.Role) > MaxTextFieldLength {
+			v.add(fmt.Sprintf("cast[%d].role", i), ErrTextTooLong)
+		}
+	}
+
+	// 4. Códigos: idioma, classificação e IDs externos. Vazio significa "não informado".
+	if movie.OriginalLanguage != "" && !languagePattern.MatchString(movie.OriginalLanguage) {
+		v.add("original_language", ErrInvalidLanguage)
+	}
+	if movie.AgeRating != "" && !isAgeRating(movie.AgeRating) {
+		v.add("age_rating", ErrInvalidAgeRating)
+	}
+	if id := movie.ExternalIDs.IMDbID; id != "" && !imdbIDPattern.MatchString(id) {
+		v.add("external_ids.imdb_id", ErrInvalidIMDbID)
+	}
+	if movie.ExternalIDs.TMDBID < 0 {
+		v.add("external_ids.tmdb_id", ErrInvalidTMDBID)
+	}
+
+	// 5. Créditos: as regras ficam junto das pessoas (ver people.go).
+	validateCredits(v, movie.Credits)
+
+	return v.err()
+}
+
+func isAgeRating(rating string) bool {
+	for _, valid := range AgeRatings {
+		if rating == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// violations acumula as violações de uma validação que verifica vários campos.
+type violations struct {
+	first error
+	list  []FieldViolation
+}
+
+func (v *violations) add(field string, err error) {
+	if v.first == nil {
+		v.first = err
+	}
+	v.list = append(v.list, FieldViolation{Field: field, Description: err.Error()})
+}
+
+// err retorna nil se nenhuma violação foi registrada. Senão, retorna um ValidationError
+// cujo Err é o erro da primeira violação.
+func (v *violations) err() error {
+	if v.first == nil {
+		return nil
+	}
+	return &ValidationError{Err: v.first, Violations: v.list}
+}
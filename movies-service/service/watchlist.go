@@ -0,0 +1,198 @@
+// Local: movies-service/service/watchlist.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// WatchlistEntry é um filme que o usuário marcou para assistir depois.
+// O usuário é identificado só pelo ID, que vem da autenticação feita no gateway.
+type WatchlistEntry struct {
+	UserID  string    `json:"user_id" bson:"user_id"`
+	MovieID string    `json:"movie_id" bson:"movie_id"`
+	AddedAt time.Time `json:"added_at" bson:"added_at"`
+}
+
+// WatchlistQuery descreve uma página da lista de um usuário (paginação por keyset).
+// As entradas vêm da mais recente para a mais antiga: em ordem decrescente de AddedAt e,
+// na mesma data, de MovieID. After é a última entrada da página anterior, ou nil na primeira.
+type WatchlistQuery struct {
+	UserID string
+	After  *WatchlistEntry
+	Limit  int
+}
+
+// WatchlistRepository é a porta de saída para persistir as listas de interesse.
+type WatchlistRepository interface {
+	// Add inclui o filme na lista do usuário e retorna a entrada gravada. Se o filme já
+	// estiver na lista, nada muda e a entrada existente (com a data original) é retornada.
+	Add(ctx context.Context, entry *WatchlistEntry) (*WatchlistEntry, error)
+	// Remove tira o filme da lista do usuário. Retorna false se ele não estava na lista.
+	Remove(ctx context.Context, userID, movieID string) (bool, error)
+	// List retorna no máximo query.Limit entradas da lista do usuário, na ordem de WatchlistQuery.
+	List(ctx context.Context, query WatchlistQuery) ([]*WatchlistEntry, error)
+	// RemoveMovies tira os filmes das listas de todos os usuários.
+	RemoveMovies(ctx context.Context, movieIDs []string) error
+}
+
+// WatchlistItem é um filme da lista, já com todos os dados, e a data em que entrou nela.
+type WatchlistItem struct {
+	Movie   *Movie
+	AddedAt time.Time
+}
+
+// WatchlistPage é uma página da lista de um usuário. NextPageToken fica vazio quando não
+// há mais páginas.
+type WatchlistPage struct {
+	Items         []WatchlistItem
+	NextPageToken string
+}
+
+// WatchlistService é a porta de entrada das listas de interesse.
+type WatchlistService interface {
+	AddToWatchlist(ctx context.Context, userID, movieID string) (*WatchlistItem, error)
+	RemoveFromWatchlist(ctx context.Context, userID, movieID string) error
+	ListWatchlist(ctx context.Context, userID string, pageSize int, pageToken string) (*WatchlistPage, error)
+}
+
+// ErrEmptyUserID é retornado quando uma operação da lista de interesse não sabe de qual
+// usuário ela é. Como o ID vem da autenticação, isso indica um problema no gateway.
+var ErrEmptyUserID = errors.New("o ID do usuário não pode ser vazio")
+
+type watchlistService struct {
+	watchlists WatchlistRepository // Porta de saída das listas.
+	movies     MovieRepository     // Usada para conferir e buscar os filmes das listas.
+	now        func() time.Time    // Relógio usado na data de inclusão.
+}
+
+// NewWatchlistService cria o serviço das listas de interesse. Ele recebe também o
+// repositório de filmes, de onde vêm os dados dos filmes listados.
+func NewWatchlistService(watchlists WatchlistRepository, movies MovieRepository) WatchlistService {
+	return &watchlistService{watchlists: watchlists, movies: movies, now: time.Now}
+}
+
+// AddToWatchlist inclui um filme existente na lista do usuário. Incluir de novo um filme
+// que já está na lista não muda nada (nem a data em que ele entrou).
+func (s *watchlistService) AddToWatchlist(ctx context.Context, userID, movieID string) (*WatchlistItem, error) {
+	if err := validateWatchlistIDs(userID, movieID); err != nil {
+		return nil, err
+	}
+	movie, err := s.movies.FindByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+	if movie == nil {
+		return nil, movieNotFound(movieID)
+	}
+	entry, err := s.watchlists.Add(ctx, &WatchlistEntry{
+		UserID:  userID,
+		MovieID: movieID,
+		AddedAt: s.now().UTC().Truncate(time.Millisecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WatchlistItem{Movie: movie, AddedAt: entry.AddedAt}, nil
+}
+
+// RemoveFromWatchlist tira um filme da lista do usuário. Retorna um erro da categoria
+// ErrNotFound se o filme não estava na lista.
+func (s *watchlistService) RemoveFromWatchlist(ctx context.Context, userID, movieID string) error {
+	if err := validateWatchlistIDs(userID, movieID); err != nil {
+		return err
+	}
+	removed, err := s.watchlists.Remove(ctx, userID, movieID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return &NotFoundError{Resource: "filme na lista", ID: movieID}
+	}
+	return nil
+}
+
+// ListWatchlist retorna uma página da lista do usuário, do filme incluído mais recentemente
+// para o mais antigo. Os filmes da página são buscados com uma única consulta ao repositório
+// de filmes; os que estão com exclusão lógica ficam de fora, então uma página pode vir com
+// menos itens que o pedido mesmo quando existe uma próxima.
+func (s *watchlistService) ListWatchlist(ctx context.Context, userID string, requestedSize int, pageToken string) (*WatchlistPage, error) {
+	var v violations
+	if userID == "" {
+		v.add("user_id", ErrEmptyUserID)
+	}
+	size, sizeErr := pageSize(requestedSize)
+	if sizeErr != nil {
+		v.add("page_size", sizeErr)
+	}
+	var token watchlistToken
+	if err := decodeToken(pageToken, &token); err != nil {
+		v.add("page_token", err)
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	// Um item a mais indica se existe uma próxima página, sem precisar de outra consulta.
+	entries, err := s.watchlists.List(ctx, WatchlistQuery{UserID: userID, After: token.after(), Limit: size + 1})
+	if err != nil {
+		return nil, err
+	}
+	page := &WatchlistPage{Items: make([]WatchlistItem, 0, len(entries))}
+	if len(entries) > size {
+		entries = entries[:size]
+		last := entries[size-1]
+		page.NextPageToken = encodeToken(watchlistToken{AddedAt: last.AddedAt.UnixMilli(), MovieID: last.MovieID})
+	}
+	if len(entries) == 0 {
+		return page, nil
+	}
+
+	movieIDs := make([]string, len(entries))
+	for i, entry := range entries {
+		movieIDs[i] = entry.MovieID
+	}
+	found, err := s.movies.FindByIDs(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Movie, len(found))
+	for _, movie := range found {
+		byID[movie.ID] = movie
+	}
+	for _, entry := range entries {
+		if movie, ok := byID[entry.MovieID]; ok {
+			page.Items = append(page.Items, WatchlistItem{Movie: movie, AddedAt: entry.AddedAt})
+		}
+	}
+	return page, nil
+}
+
+// validateWatchlistIDs confere o usuário e o filme de uma operação sobre a lista.
+func validateWatchlistIDs(userID, movieID string) error {
+	var v violations
+	if userID == "" {
+		v.add("user_id", ErrEmptyUserID)
+	}
+	if movieID == "" {
+		v.add("movie_id", ErrEmptyID)
+	}
+	return v.err()
+}
+
+// watchlistToken é o conteúdo do token opaco da lista: a data (em milissegundos) e o
+// filme da última entrada da página.
+type watchlistToken struct {
+	AddedAt int64  `json:"t"`
+	MovieID string `json:"m"`
+}
+
+// after converte o token de volta para o cursor usado pelo repositório.
+func (t watchlistToken) after() *WatchlistEntry {
+	if t.MovieID == "" {
+		return nil
+	}
+	return &WatchlistEntry{AddedAt: time.UnixMilli(t.AddedAt).UTC(), MovieID: t.MovieID}
+}
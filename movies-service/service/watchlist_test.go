@@ -0,0 +1,139 @@
+// Local: movies-service/service/watchlist_test.go
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/movies-service/database/memory"
+	"github.com/alenrique/Movies-microservices/movies-service/service"
+)
+
+// countingMovieRepository conta as buscas por ID feitas no repositório de filmes, para
+// conferir que a listagem não faz uma consulta por filme.
+type countingMovieRepository struct {
+	service.MovieRepository
+	findByID, findByIDs int
+}
+
+func (r *countingMovieRepository) FindByID(ctx context.Context, id string) (*service.Movie, error) {
+	r.findByID++
+	return r.MovieRepository.FindByID(ctx, id)
+}
+
+func (r *countingMovieRepository) FindByIDs(ctx context.Context, ids []string) ([]*service.Movie, error) {
+	r.findByIDs++
+	return r.MovieRepository.FindByIDs(ctx, ids)
+}
+
+// TestListWatchlist_Pagination testa se a lista vem do filme incluído mais recentemente para
+// o mais antigo, com os filmes completos buscados em uma única consulta por página.
+func TestListWatchlist_Pagination(t *testing.T) {
+	// Arrange: a ana inclui 3 filmes, um por minuto, e a bia inclui um.
+	ctx := context.Background()
+	movies := &countingMovieRepository{MovieRepository: newMovieRepository()}
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{})
+	watchlistService := service.NewWatchlistService(memory.NewWatchlistRepository(), movies)
+	ids := createMovies(t, movieService, "Alien", "Aliens", "Alien 3")
+	for _, id := range ids {
+		if _, err := watchlistService.AddToWatchlist(ctx, "ana", id); err != nil {
+			t.Fatalf("Erro inesperado ao incluir o filme '%s': %v", id, err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	watchlistService.AddToWatchlist(ctx, "bia", ids[0])
+	movies.findByIDs = 0
+
+	// Act: percorre as páginas de 2 em 2.
+	var pages []string
+	token := ""
+	for {
+		page, err := watchlistService.ListWatchlist(ctx, "ana", 2, token)
+		if err != nil {
+			t.Fatalf("Erro inesperado ao listar: %v", err)
+		}
+		var titles []string
+		for _, item := range page.Items {
+			titles = append(titles, item.Movie.Title)
+		}
+		pages = append(pages, fmt.Sprint(titles))
+		if token = page.NextPageToken; token == "" {
+			break
+		}
+	}
+
+	// Assert
+	if want := "[[Alien 3 Aliens] [Alien]]"; fmt.Sprint(pages) != want {
+		t.Errorf("Esperava as páginas %s, mas obteve %v", want, pages)
+	}
+	if movies.findByIDs != 2 {
+		t.Errorf("Esperava uma busca em lote por página (2), mas obteve %d", movies.findByIDs)
+	}
+	if _, err := watchlistService.ListWatchlist(ctx, "ana", 0, "zz"); !errors.Is(err, service.ErrInvalidPageToken) {
+		t.Errorf("Esperava ErrInvalidPageToken, mas recebeu %v", err)
+	}
+}
+
+// TestWatchlist_AddAndRemove testa a validação, a inclusão repetida e a remoção.
+func TestWatchlist_AddAndRemove(t *testing.T) {
+	ctx := context.Background()
+	movies := newMovieRepository()
+	movieService := service.NewMovieService(movies, &fakeIDAllocator{})
+	watchlistService := service.NewWatchlistService(memory.NewWatchlistRepository(), movies)
+	ids := createMovies(t, movieService, "Alien")
+
+	if _, err := watchlistService.AddToWatchlist(ctx, "", ""); !errors.Is(err, service.ErrInvalidArgument) {
+		t.Errorf("Esperava ErrInvalidArgument sem usuário e filme, mas recebeu %v", err)
+	}
+	if _, err := watchlistService.AddToWatchlist(ctx, "ana", "999"); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound para um filme inexistente, mas recebeu %v", err)
+	}
+	first, err := watchlistService.AddToWatchlist(ctx, "ana", ids[0])
+	if err != nil || first.Movie.Title != "Alien" || first.AddedAt.IsZero() {
+		t.Fatalf("Esperava o item com o filme e a data, mas obteve (%+v, %v)", first, err)
+	}
+	again, err := watchlistService.AddToWatchlist(ctx, "ana", ids[0])
+	if err != nil || !again.AddedAt.Equal(first.AddedAt) {
+		t.Errorf("Esperava a data original ao incluir de novo, mas obteve (%+v, %v)", again, err)
+	}
+	if err := watchlistService.RemoveFromWatchlist(ctx, "ana", ids[0]); err != nil {
+		t.Errorf("Erro inesperado ao remover: %v", err)
+	}
+	if err := watchlistService.RemoveFromWatchlist(ctx, "ana", ids[0]); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao remover de novo, mas recebeu %v", err)
+	}
+}
+
+// TestDeleteMovie_RemovesFromWatchlists testa se um filme apagado de vez sai das listas,
+// enquanto um filme com exclusão lógica apenas deixa de aparecer nelas.
+func TestDeleteMovie_RemovesFromWatchlists(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	movies := newMovieRepository()
+	watchlists := memory.NewWatchlistRepository()
+	softService := service.NewMovieService(movies, &fakeIDAllocator{}, service.WithWatchlists(watchlists), service.WithSoftDelete(0))
+	hardService := service.NewMovieService(movies, &fakeIDAllocator{last: 10}, service.WithWatchlists(watchlists))
+	watchlistService := service.NewWatchlistService(watchlists, movies)
+	ids := createMovies(t, softService, "Alien", "Aliens")
+	for _, id := range ids {
+		watchlistService.AddToWatchlist(ctx, "ana", id)
+	}
+
+	// Act
+	softService.DeleteMovie(ctx, ids[0])
+	hardService.DeleteMovie(ctx, ids[1])
+
+	// Assert: nenhum aparece na listagem, mas o excluído logicamente continua guardado.
+	page, err := watchlistService.ListWatchlist(ctx, "ana", 0, "")
+	if err != nil || len(page.Items) != 0 {
+		t.Errorf("Esperava a lista vazia, mas obteve (%+v, %v)", page, err)
+	}
+	entries, _ := watchlists.List(ctx, service.WatchlistQuery{UserID: "ana", Limit: 10})
+	if len(entries) != 1 || entries[0].MovieID != ids[0] {
+		t.Errorf("Esperava guardado apenas o filme '%s', mas obteve %v", ids[0], entries)
+	}
+}
@@ -26,10 +26,29 @@ type MovieServiceClient interface {
 	CreateMovie(ctx context.Context, in *CreateMovieRequest, opts ...grpc.CallOption) (*Movie, error)
 	// Método para buscar um filme pelo ID. Recebe um ID e retorna o filme correspondente.
 	GetMovie(ctx context.Context, in *GetMovieRequest, opts ...grpc.CallOption) (*Movie, error)
-	// Método para listar todos os filmes. Não recebe parâmetros e retorna uma lista de filmes.
+	// Método para listar os filmes. Recebe os parâmetros de paginação e retorna uma página de filmes.
 	ListMovies(ctx context.Context, in *ListMoviesRequest, opts ...grpc.CallOption) (*ListMoviesResponse, error)
+	// Método para consumidores em massa: envia os filmes um a um, em um stream, em vez de
+	// montar uma única resposta com todos eles (que pode passar do limite de tamanho do gRPC).
+	StreamMovies(ctx context.Context, in *StreamMoviesRequest, opts ...grpc.CallOption) (MovieService_StreamMoviesClient, error)
+	// Método para buscar filmes pelo título. Retorna os resultados ordenados por relevância.
+	SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error)
+	// Método para atualizar um filme. Recebe o ID e os novos dados e retorna o filme atualizado.
+	UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*Movie, error)
+	// Método para atualizar parcialmente um filme. Altera apenas os campos do 'update_mask'.
+	PatchMovie(ctx context.Context, in *PatchMovieRequest, opts ...grpc.CallOption) (*Movie, error)
 	// Método para deletar um filme. Recebe um ID e retorna uma resposta vazia de sucesso.
+	// Com a exclusão lógica ativada, o filme apenas some das consultas e pode ser restaurado.
 	DeleteMovie(ctx context.Context, in *DeleteMovieRequest, opts ...grpc.CallOption) (*DeleteMovieResponse, error)
+	// Métodos em lote: criam, buscam ou deletam vários filmes com uma única chamada. Um item
+	// com problema não impede os outros; o erro de cada um vem no seu resultado.
+	BatchCreateMovies(ctx context.Context, in *BatchCreateMoviesRequest, opts ...grpc.CallOption) (*BatchMoviesResponse, error)
+	BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...grpc.CallOption) (*BatchMoviesResponse, error)
+	BatchDeleteMovies(ctx context.Context, in *BatchDeleteMoviesRequest, opts ...grpc.CallOption) (*BatchMoviesResponse, error)
+	// Método para desfazer a exclusão lógica de um filme. Retorna o filme restaurado.
+	RestoreMovie(ctx context.Context, in *RestoreMovieRequest, opts ...grpc.CallOption) (*Movie, error)
+	// Método para apagar definitivamente os filmes excluídos há mais tempo que a retenção.
+	PurgeDeletedMovies(ctx context.Context, in *PurgeDeletedMoviesRequest, opts ...grpc.CallOption) (*PurgeDeletedMoviesResponse, error)
 }
 
 type movieServiceClient struct {
@@ -67,6 +86,65 @@ func (c *movieServiceClient) ListMovies(ctx context.Context, in *ListMoviesReque
 	return out, nil
 }
 
+func (c *movieServiceClient) StreamMovies(ctx context.Context, in *StreamMoviesRequest, opts ...grpc.CallOption) (MovieService_StreamMoviesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[0], "/movies.MovieService/StreamMovies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &movieServiceStreamMoviesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MovieService_StreamMoviesClient interface {
+	Recv() (*Movie, error)
+	grpc.ClientStream
+}
+
+type movieServiceStreamMoviesClient struct {
+	grpc.ClientStream
+}
+
+func (x *movieServiceStreamMoviesClient) Recv() (*Movie, error) {
+	m := new(Movie)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *movieServiceClient) SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error) {
+	out := new(SearchMoviesResponse)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/SearchMovies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/UpdateMovie", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) PatchMovie(ctx context.Context, in *PatchMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/PatchMovie", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieServiceClient) DeleteMovie(ctx context.Context, in *DeleteMovieRequest, opts ...grpc.CallOption) (*DeleteMovieResponse, error) {
 	out := new(DeleteMovieResponse)
 	err := c.cc.Invoke(ctx, "/movies.MovieService/DeleteMovie", in, out, opts...)
@@ -76,6 +154,51 @@ func (c *movieServiceClient) DeleteMovie(ctx context.Context, in *DeleteMovieReq
 	return out, nil
 }
 
+func (c *movieServiceClient) BatchCreateMovies(ctx context.Context, in *BatchCreateMoviesRequest, opts ...grpc.CallOption) (*BatchMoviesResponse, error) {
+	out := new(BatchMoviesResponse)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/BatchCreateMovies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...grpc.CallOption) (*BatchMoviesResponse, error) {
+	out := new(BatchMoviesResponse)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/BatchGetMovies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) BatchDeleteMovies(ctx context.Context, in *BatchDeleteMoviesRequest, opts ...grpc.CallOption) (*BatchMoviesResponse, error) {
+	out := new(BatchMoviesResponse)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/BatchDeleteMovies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) RestoreMovie(ctx context.Context, in *RestoreMovieRequest, opts ...grpc.CallOption) (*Movie, error) {
+	out := new(Movie)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/RestoreMovie", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) PurgeDeletedMovies(ctx context.Context, in *PurgeDeletedMoviesRequest, opts ...grpc.CallOption) (*PurgeDeletedMoviesResponse, error) {
+	out := new(PurgeDeletedMoviesResponse)
+	err := c.cc.Invoke(ctx, "/movies.MovieService/PurgeDeletedMovies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MovieServiceServer is the server API for MovieService service.
 // All implementations must embed UnimplementedMovieServiceServer
 // for forward compatibility
@@ -84,10 +207,29 @@ type MovieServiceServer interface {
 	CreateMovie(context.Context, *CreateMovieRequest) (*Movie, error)
 	// Método para buscar um filme pelo ID. Recebe um ID e retorna o filme correspondente.
 	GetMovie(context.Context, *GetMovieRequest) (*Movie, error)
-	// Método para listar todos os filmes. Não recebe parâmetros e retorna uma lista de filmes.
+	// Método para listar os filmes. Recebe os parâmetros de paginação e retorna uma página de filmes.
 	ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesResponse, error)
+	// Método para consumidores em massa: envia os filmes um a um, em um stream, em vez de
+	// montar uma única resposta com todos eles (que pode passar do limite de tamanho do gRPC).
+	StreamMovies(*StreamMoviesRequest, MovieService_StreamMoviesServer) error
+	// Método para buscar filmes pelo título. Retorna os resultados ordenados por relevância.
+	SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error)
+	// Método para atualizar um filme. Recebe o ID e os novos dados e retorna o filme atualizado.
+	UpdateMovie(context.Context, *UpdateMovieRequest) (*Movie, error)
+	// Método para atualizar parcialmente um filme. Altera apenas os campos do 'update_mask'.
+	PatchMovie(context.Context, *PatchMovieRequest) (*Movie, error)
 	// Método para deletar um filme. Recebe um ID e retorna uma resposta vazia de sucesso.
+	// Com a exclusão lógica ativada, o filme apenas some das consultas e pode ser restaurado.
 	DeleteMovie(context.Context, *DeleteMovieRequest) (*DeleteMovieResponse, error)
+	// Métodos em lote: criam, buscam ou deletam vários filmes com uma única chamada. Um item
+	// com problema não impede os outros; o erro de cada um vem no seu resultado.
+	BatchCreateMovies(context.Context, *BatchCreateMoviesRequest) (*BatchMoviesResponse, error)
+	BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchMoviesResponse, error)
+	BatchDeleteMovies(context.Context, *BatchDeleteMoviesRequest) (*BatchMoviesResponse, error)
+	// Método para desfazer a exclusão lógica de um filme. Retorna o filme restaurado.
+	RestoreMovie(context.Context, *RestoreMovieRequest) (*Movie, error)
+	// Método para apagar definitivamente os filmes excluídos há mais tempo que a retenção.
+	PurgeDeletedMovies(context.Context, *PurgeDeletedMoviesRequest) (*PurgeDeletedMoviesResponse, error)
 	mustEmbedUnimplementedMovieServiceServer()
 }
 
@@ -104,9 +246,36 @@ func (UnimplementedMovieServiceServer) GetMovie(context.Context, *GetMovieReques
 func (UnimplementedMovieServiceServer) ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMovies not implemented")
 }
+func (UnimplementedMovieServiceServer) StreamMovies(*StreamMoviesRequest, MovieService_StreamMoviesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) UpdateMovie(context.Context, *UpdateMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) PatchMovie(context.Context, *PatchMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchMovie not implemented")
+}
 func (UnimplementedMovieServiceServer) DeleteMovie(context.Context, *DeleteMovieRequest) (*DeleteMovieResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
 }
+func (UnimplementedMovieServiceServer) BatchCreateMovies(context.Context, *BatchCreateMoviesRequest) (*BatchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) BatchDeleteMovies(context.Context, *BatchDeleteMoviesRequest) (*BatchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) RestoreMovie(context.Context, *RestoreMovieRequest) (*Movie, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) PurgeDeletedMovies(context.Context, *PurgeDeletedMoviesRequest) (*PurgeDeletedMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletedMovies not implemented")
+}
 func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
 
 // UnsafeMovieServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +343,81 @@ func _MovieService_ListMovies_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_StreamMovies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMoviesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MovieServiceServer).StreamMovies(m, &movieServiceStreamMoviesServer{stream})
+}
+
+type MovieService_StreamMoviesServer interface {
+	Send(*Movie) error
+	grpc.ServerStream
+}
+
+type movieServiceStreamMoviesServer struct {
+	grpc.ServerStream
+}
+
+func (x *movieServiceStreamMoviesServer) Send(m *Movie) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MovieService_SearchMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SearchMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/SearchMovies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SearchMovies(ctx, req.(*SearchMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_UpdateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UpdateMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/UpdateMovie",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UpdateMovie(ctx, req.(*UpdateMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_PatchMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).PatchMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/PatchMovie",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).PatchMovie(ctx, req.(*PatchMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MovieService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteMovieRequest)
 	if err := dec(in); err != nil {
@@ -192,6 +436,96 @@ func _MovieService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_BatchCreateMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchCreateMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/BatchCreateMovies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchCreateMovies(ctx, req.(*BatchCreateMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_BatchGetMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchGetMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/BatchGetMovies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchGetMovies(ctx, req.(*BatchGetMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_BatchDeleteMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchDeleteMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/BatchDeleteMovies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchDeleteMovies(ctx, req.(*BatchDeleteMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_RestoreMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).RestoreMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/RestoreMovie",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).RestoreMovie(ctx, req.(*RestoreMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_PurgeDeletedMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).PurgeDeletedMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.MovieService/PurgeDeletedMovies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).PurgeDeletedMovies(ctx, req.(*PurgeDeletedMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MovieService_ServiceDesc is the grpc.ServiceDesc for MovieService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -211,11 +545,49 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMovies",
 			Handler:    _MovieService_ListMovies_Handler,
 		},
+		{
+			MethodName: "SearchMovies",
+			Handler:    _MovieService_SearchMovies_Handler,
+		},
+		{
+			MethodName: "UpdateMovie",
+			Handler:    _MovieService_UpdateMovie_Handler,
+		},
+		{
+			MethodName: "PatchMovie",
+			Handler:    _MovieService_PatchMovie_Handler,
+		},
 		{
 			MethodName: "DeleteMovie",
 			Handler:    _MovieService_DeleteMovie_Handler,
 		},
+		{
+			MethodName: "BatchCreateMovies",
+			Handler:    _MovieService_BatchCreateMovies_Handler,
+		},
+		{
+			MethodName: "BatchGetMovies",
+			Handler:    _MovieService_BatchGetMovies_Handler,
+		},
+		{
+			MethodName: "BatchDeleteMovies",
+			Handler:    _MovieService_BatchDeleteMovies_Handler,
+		},
+		{
+			MethodName: "RestoreMovie",
+			Handler:    _MovieService_RestoreMovie_Handler,
+		},
+		{
+			MethodName: "PurgeDeletedMovies",
+			Handler:    _MovieService_PurgeDeletedMovies_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMovies",
+			Handler:       _MovieService_StreamMovies_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "movies.proto",
 }
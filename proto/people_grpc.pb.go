@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.27.2
+// source: people.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PeopleServiceClient is the client API for PeopleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PeopleServiceClient interface {
+	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*Person, error)
+	GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*Person, error)
+	ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...grpc.CallOption) (*ListPeopleResponse, error)
+	UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*Person, error)
+	// Uma pessoa que ainda aparece nos créditos de algum filme não pode ser apagada.
+	DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*DeletePersonResponse, error)
+	// Retorna os filmes em que a pessoa tem algum crédito.
+	ListPersonMovies(ctx context.Context, in *ListPersonMoviesRequest, opts ...grpc.CallOption) (*ListPersonMoviesResponse, error)
+}
+
+type peopleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeopleServiceClient(cc grpc.ClientConnInterface) PeopleServiceClient {
+	return &peopleServiceClient{cc}
+}
+
+func (c *peopleServiceClient) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, "/movies.PeopleService/CreatePerson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, "/movies.PeopleService/GetPerson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) ListPeople(ctx context.Context, in *ListPeopleRequest, opts ...grpc.CallOption) (*ListPeopleResponse, error) {
+	out := new(ListPeopleResponse)
+	err := c.cc.Invoke(ctx, "/movies.PeopleService/ListPeople", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, "/movies.PeopleService/UpdatePerson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*DeletePersonResponse, error) {
+	out := new(DeletePersonResponse)
+	err := c.cc.Invoke(ctx, "/movies.PeopleService/DeletePerson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) ListPersonMovies(ctx context.Context, in *ListPersonMoviesRequest, opts ...grpc.CallOption) (*ListPersonMoviesResponse, error) {
+	out := new(ListPersonMoviesResponse)
+	err := c.cc.Invoke(ctx, "/movies.PeopleService/ListPersonMovies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeopleServiceServer is the server API for PeopleService service.
+// All implementations must embed UnimplementedPeopleServiceServer
+// for forward compatibility
+type PeopleServiceServer interface {
+	CreatePerson(context.Context, *CreatePersonRequest) (*Person, error)
+	GetPerson(context.Context, *GetPersonRequest) (*Person, error)
+	ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error)
+	UpdatePerson(context.Context, *UpdatePersonRequest) (*Person, error)
+	// Uma pessoa que ainda aparece nos créditos de algum filme não pode ser apagada.
+	DeletePerson(context.Context, *DeletePersonRequest) (*DeletePersonResponse, error)
+	// Retorna os filmes em que a pessoa tem algum crédito.
+	ListPersonMovies(context.Context, *ListPersonMoviesRequest) (*ListPersonMoviesResponse, error)
+	mustEmbedUnimplementedPeopleServiceServer()
+}
+
+// UnimplementedPeopleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPeopleServiceServer struct {
+}
+
+func (UnimplementedPeopleServiceServer) CreatePerson(context.Context, *CreatePersonRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) GetPerson(context.Context, *GetPersonRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) ListPeople(context.Context, *ListPeopleRequest) (*ListPeopleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeople not implemented")
+}
+func (UnimplementedPeopleServiceServer) UpdatePerson(context.Context, *UpdatePersonRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) DeletePerson(context.Context, *DeletePersonRequest) (*DeletePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) ListPersonMovies(context.Context, *ListPersonMoviesRequest) (*ListPersonMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonMovies not implemented")
+}
+func (UnimplementedPeopleServiceServer) mustEmbedUnimplementedPeopleServiceServer() {}
+
+// UnsafePeopleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeopleServiceServer will
+// result in compilation errors.
+type UnsafePeopleServiceServer interface {
+	mustEmbedUnimplementedPeopleServiceServer()
+}
+
+func RegisterPeopleServiceServer(s grpc.ServiceRegistrar, srv PeopleServiceServer) {
+	s.RegisterService(&PeopleService_ServiceDesc, srv)
+}
+
+func _PeopleService_CreatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).CreatePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.PeopleService/CreatePerson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).CreatePerson(ctx, req.(*CreatePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).GetPerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.PeopleService/GetPerson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).GetPerson(ctx, req.(*GetPersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_ListPeople_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeopleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).ListPeople(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.PeopleService/ListPeople",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).ListPeople(ctx, req.(*ListPeopleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_UpdatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).UpdatePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.PeopleService/UpdatePerson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).UpdatePerson(ctx, req.(*UpdatePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_DeletePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).DeletePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.PeopleService/DeletePerson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).DeletePerson(ctx, req.(*DeletePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_ListPersonMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).ListPersonMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.PeopleService/ListPersonMovies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).ListPersonMovies(ctx, req.(*ListPersonMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PeopleService_ServiceDesc is the grpc.ServiceDesc for PeopleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PeopleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "movies.PeopleService",
+	HandlerType: (*PeopleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePerson",
+			Handler:    _PeopleService_CreatePerson_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _PeopleService_GetPerson_Handler,
+		},
+		{
+			MethodName: "ListPeople",
+			Handler:    _PeopleService_ListPeople_Handler,
+		},
+		{
+			MethodName: "UpdatePerson",
+			Handler:    _PeopleService_UpdatePerson_Handler,
+		},
+		{
+			MethodName: "DeletePerson",
+			Handler:    _PeopleService_DeletePerson_Handler,
+		},
+		{
+			MethodName: "ListPersonMovies",
+			Handler:    _PeopleService_ListPersonMovies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "people.proto",
+}
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.27.2
+// source: watchlist.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WatchlistServiceClient is the client API for WatchlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatchlistServiceClient interface {
+	AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistItem, error)
+	RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*RemoveFromWatchlistResponse, error)
+	// Retorna os filmes completos de uma página com uma única consulta ao banco.
+	ListWatchlist(ctx context.Context, in *ListWatchlistRequest, opts ...grpc.CallOption) (*ListWatchlistResponse, error)
+}
+
+type watchlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchlistServiceClient(cc grpc.ClientConnInterface) WatchlistServiceClient {
+	return &watchlistServiceClient{cc}
+}
+
+func (c *watchlistServiceClient) AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistItem, error) {
+	out := new(WatchlistItem)
+	err := c.cc.Invoke(ctx, "/movies.WatchlistService/AddToWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*RemoveFromWatchlistResponse, error) {
+	out := new(RemoveFromWatchlistResponse)
+	err := c.cc.Invoke(ctx, "/movies.WatchlistService/RemoveFromWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) ListWatchlist(ctx context.Context, in *ListWatchlistRequest, opts ...grpc.CallOption) (*ListWatchlistResponse, error) {
+	out := new(ListWatchlistResponse)
+	err := c.cc.Invoke(ctx, "/movies.WatchlistService/ListWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatchlistServiceServer is the server API for WatchlistService service.
+// All implementations must embed UnimplementedWatchlistServiceServer
+// for forward compatibility
+type WatchlistServiceServer interface {
+	AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistItem, error)
+	RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*RemoveFromWatchlistResponse, error)
+	// Retorna os filmes completos de uma página com uma única consulta ao banco.
+	ListWatchlist(context.Context, *ListWatchlistRequest) (*ListWatchlistResponse, error)
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+// UnimplementedWatchlistServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWatchlistServiceServer struct {
+}
+
+func (UnimplementedWatchlistServiceServer) AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*RemoveFromWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) ListWatchlist(context.Context, *ListWatchlistRequest) (*ListWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) mustEmbedUnimplementedWatchlistServiceServer() {}
+
+// UnsafeWatchlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchlistServiceServer will
+// result in compilation errors.
+type UnsafeWatchlistServiceServer interface {
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+func RegisterWatchlistServiceServer(s grpc.ServiceRegistrar, srv WatchlistServiceServer) {
+	s.RegisterService(&WatchlistService_ServiceDesc, srv)
+}
+
+func _WatchlistService_AddToWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).AddToWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.WatchlistService/AddToWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).AddToWatchlist(ctx, req.(*AddToWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_RemoveFromWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).RemoveFromWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.WatchlistService/RemoveFromWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).RemoveFromWatchlist(ctx, req.(*RemoveFromWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_ListWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ListWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/movies.WatchlistService/ListWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ListWatchlist(ctx, req.(*ListWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WatchlistService_ServiceDesc is the grpc.ServiceDesc for WatchlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "movies.WatchlistService",
+	HandlerType: (*WatchlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddToWatchlist",
+			Handler:    _WatchlistService_AddToWatchlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWatchlist",
+			Handler:    _WatchlistService_RemoveFromWatchlist_Handler,
+		},
+		{
+			MethodName: "ListWatchlist",
+			Handler:    _WatchlistService_ListWatchlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "watchlist.proto",
+}
@@ -0,0 +1,124 @@
+// Local: reviews-service/database/memory/memory.go
+
+// Package memory implementa o repositório de avaliações em memória, sem nenhuma dependência
+// externa. Os dados se perdem quando o processo termina: serve para desenvolvimento local e testes.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// reviewRepository guarda as avaliações em um mapa protegido por um RWMutex, trabalhando
+// sempre com cópias, como os repositórios em memória do movies-service.
+type reviewRepository struct {
+	mu      sync.RWMutex
+	reviews map[string]service.Review
+}
+
+// NewReviewRepository cria um repositório de avaliações em memória vazio.
+func NewReviewRepository() service.ReviewRepository {
+	return &reviewRepository{reviews: make(map[string]service.Review)}
+}
+
+// Save insere uma avaliação nova, recusando IDs repetidos e uma segunda avaliação do
+// mesmo usuário para o mesmo filme.
+func (r *reviewRepository) Save(ctx context.Context, review *service.Review) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.reviews[review.ID]; exists {
+		return fmt.Errorf("%w: já existe uma avaliação com o ID '%s'", service.ErrConflict, review.ID)
+	}
+	for _, existing := range r.reviews {
+		if existing.MovieID == review.MovieID && existing.UserID == review.UserID {
+			return duplicateReview(review)
+		}
+	}
+	r.reviews[review.ID] = *review
+	return nil
+}
+
+// FindByID retorna uma cópia da avaliação, ou (nil, nil) se ela não existir.
+func (r *reviewRepository) FindByID(ctx context.Context, id string) (*service.Review, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	review, ok := r.reviews[id]
+	if !ok {
+		return nil, nil
+	}
+	return &review, nil
+}
+
+// FindByMovie retorna cópias das avaliações do filme, em ordem decrescente de ID.
+func (r *reviewRepository) FindByMovie(ctx context.Context, query service.ReviewQuery) ([]*service.Review, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reviews := make([]*service.Review, 0)
+	for _, review := range r.reviews {
+		if review.MovieID == query.MovieID && (query.After == "" || review.ID < query.After) {
+			review := review
+			reviews = append(reviews, &review)
+		}
+	}
+	sort.Slice(reviews, func(i, j int) bool { return reviews[i].ID > reviews[j].ID })
+	if query.Limit > 0 && len(reviews) > query.Limit {
+		reviews = reviews[:query.Limit]
+	}
+	return reviews, nil
+}
+
+// DeleteByID remove uma avaliação. Retorna false se ela não existir.
+func (r *reviewRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.reviews[id]
+	delete(r.reviews, id)
+	return ok, nil
+}
+
+// Summarize calcula a média e a quantidade das notas do filme.
+func (r *reviewRepository) Summarize(ctx context.Context, movieID string) (service.RatingSummary, error) {
+	if err := ctx.Err(); err != nil {
+		return service.RatingSummary{}, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var summary service.RatingSummary
+	var total int64
+	for _, review := range r.reviews {
+		if review.MovieID == movieID {
+			summary.Count++
+			total += int64(review.Rating)
+		}
+	}
+	if summary.Count > 0 {
+		summary.Average = float64(total) / float64(summary.Count)
+	}
+	return summary, nil
+}
+
+// duplicateReview é o erro de uma segunda avaliação do mesmo usuário para o mesmo filme.
+func duplicateReview(review *service.Review) error {
+	return fmt.Errorf("%w: o usuário '%s' já avaliou o filme '%s'", service.ErrConflict, review.UserID, review.MovieID)
+}
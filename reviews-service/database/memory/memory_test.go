@@ -0,0 +1,18 @@
+// Local: reviews-service/database/memory/memory_test.go
+
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/database/memory"
+	"github.com/alenrique/Movies-microservices/reviews-service/database/repotest"
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// TestReviewRepository_Conformance roda o contrato das avaliações contra o adaptador em memória.
+func TestReviewRepository_Conformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) service.ReviewRepository {
+		return memory.NewReviewRepository()
+	})
+}
@@ -0,0 +1,118 @@
+// Local: reviews-service/database/mongo-repository.go
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// mongoReviewRepository guarda cada avaliação como um documento da collection "reviews".
+type mongoReviewRepository struct {
+	documents *mongo.Collection
+}
+
+// NewMongoReviewRepository cria o repositório de avaliações e os seus índices.
+func NewMongoReviewRepository(ctx context.Context, db *mongo.Database) (service.ReviewRepository, error) {
+	repo := &mongoReviewRepository{documents: db.Collection("reviews")}
+	_, err := repo.documents.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Garante uma avaliação por usuário e filme, mesmo com duas criações simultâneas.
+		{Keys: bson.D{{Key: "movie_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Usado pela listagem (da mais nova para a mais antiga) e pelo cálculo da média.
+		{Keys: bson.D{{Key: "movie_id", Value: 1}, {Key: "id", Value: -1}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// Save insere uma avaliação nova. Os índices únicos rejeitam IDs repetidos e uma segunda
+// avaliação do mesmo usuário para o mesmo filme.
+func (r *mongoReviewRepository) Save(ctx context.Context, review *service.Review) error {
+	_, err := r.documents.InsertOne(ctx, review)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: o usuário '%s' já avaliou o filme '%s'", service.ErrConflict, review.UserID, review.MovieID)
+	}
+	return err
+}
+
+// FindByID retorna a avaliação, ou (nil, nil) se ela não existir.
+func (r *mongoReviewRepository) FindByID(ctx context.Context, id string) (*service.Review, error) {
+	var review service.Review
+	err := r.documents.FindOne(ctx, bson.M{"id": id}).Decode(&review)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// FindByMovie retorna as avaliações do filme em ordem decrescente de ID, começando depois
+// de query.After.
+func (r *mongoReviewRepository) FindByMovie(ctx context.Context, query service.ReviewQuery) ([]*service.Review, error) {
+	filter := bson.M{"movie_id": query.MovieID}
+	if query.After != "" {
+		filter["id"] = bson.M{"$lt": query.After}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "id", Value: -1}})
+	if query.Limit > 0 {
+		opts.SetLimit(int64(query.Limit))
+	}
+	cursor, err := r.documents.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]*service.Review, 0)
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// DeleteByID apaga a avaliação. Retorna false se ela não existir.
+func (r *mongoReviewRepository) DeleteByID(ctx context.Context, id string) (bool, error) {
+	result, err := r.documents.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// Summarize calcula a média e a quantidade das notas no próprio MongoDB, com uma agregação,
+// sem trazer as avaliações para o serviço.
+func (r *mongoReviewRepository) Summarize(ctx context.Context, movieID string) (service.RatingSummary, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"movie_id": movieID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"average": bson.M{"$avg": "$rating"},
+			"count":   bson.M{"$sum": 1},
+		}}},
+	}
+	cursor, err := r.documents.Aggregate(ctx, pipeline)
+	if err != nil {
+		return service.RatingSummary{}, err
+	}
+	var results []struct {
+		Average float64 `bson:"average"`
+		Count   int64   `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return service.RatingSummary{}, err
+	}
+	// Sem nenhuma avaliação, o $group não produz nenhum documento.
+	if len(results) == 0 {
+		return service.RatingSummary{}, nil
+	}
+	return service.RatingSummary{Average: results[0].Average, Count: results[0].Count}, nil
+}
@@ -0,0 +1,55 @@
+//go:build integration
+
+// Local: reviews-service/database/mongo-repository_test.go
+
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/database"
+	"github.com/alenrique/Movies-microservices/reviews-service/database/repotest"
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// TestMongoReviewRepository_Conformance roda o contrato das avaliações contra o MongoDB,
+// com um banco temporário para cada teste da suíte.
+func TestMongoReviewRepository_Conformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) service.ReviewRepository {
+		repo, err := database.NewMongoReviewRepository(context.Background(), newTestDatabase(t))
+		if err != nil {
+			t.Fatalf("Falha ao criar o repositório: %v", err)
+		}
+		return repo
+	})
+}
+
+// newTestDatabase conecta ao MONGO_URI e cria um banco exclusivo para o teste,
+// que é apagado ao final.
+func newTestDatabase(t *testing.T) *mongo.Database {
+	t.Helper()
+	uri := os.Getenv("MONGO_URI")
+	if uri == "" {
+		t.Skip("MONGO_URI não definido; pulando teste de integração com o MongoDB")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("Falha ao conectar com o MongoDB: %v", err)
+	}
+	db := client.Database(fmt.Sprintf("reviewdb_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		client.Disconnect(context.Background())
+	})
+	return db
+}
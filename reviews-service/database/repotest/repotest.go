@@ -0,0 +1,180 @@
+// Local: reviews-service/database/repotest/repotest.go
+
+// Package repotest reúne o contrato que todo adaptador de service.ReviewRepository precisa
+// cumprir, como o pacote de mesmo nome do movies-service. Os testes de cada adaptador chamam
+// Run com uma fábrica de repositórios vazios.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// Factory cria um repositório de avaliações novo e vazio para um teste.
+type Factory func(t *testing.T) service.ReviewRepository
+
+// Run executa o contrato de service.ReviewRepository, cada teste com um repositório novo.
+func Run(t *testing.T, newRepo Factory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo service.ReviewRepository)
+	}{
+		{"SaveAndFind", testSaveAndFind},
+		{"SaveRejectsDuplicates", testSaveRejectsDuplicates},
+		{"FindByMovieNewestFirst", testFindByMovieNewestFirst},
+		{"Delete", testDelete},
+		{"Summarize", testSummarize},
+		{"CanceledContext", testCanceledContext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepo(t))
+		})
+	}
+}
+
+func saveReviews(t *testing.T, repo service.ReviewRepository, reviews ...*service.Review) {
+	t.Helper()
+	for _, review := range reviews {
+		if err := repo.Save(context.Background(), review); err != nil {
+			t.Fatalf("Erro inesperado ao salvar a avaliação '%s': %v", review.ID, err)
+		}
+	}
+}
+
+// reviewIDs retorna os IDs das avaliações, na ordem recebida.
+func reviewIDs(reviews []*service.Review) string {
+	ids := make([]string, len(reviews))
+	for i, review := range reviews {
+		ids[i] = review.ID
+	}
+	return fmt.Sprint(ids)
+}
+
+// testSaveAndFind: a avaliação salva é encontrada com todos os campos; um ID inexistente
+// resulta em (nil, nil).
+func testSaveAndFind(t *testing.T, repo service.ReviewRepository) {
+	ctx := context.Background()
+	want := service.Review{
+		ID: "r1", MovieID: "10", UserID: "ana", Rating: 4, Comment: "Muito bom",
+		CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+	}
+	saveReviews(t, repo, &want)
+
+	found, err := repo.FindByID(ctx, "r1")
+	if err != nil || found == nil || !found.CreatedAt.Equal(want.CreatedAt) {
+		t.Fatalf("Esperava encontrar a avaliação r1, mas obteve (%+v, %v)", found, err)
+	}
+	found.CreatedAt = want.CreatedAt
+	if *found != want {
+		t.Errorf("Esperava %+v, mas obteve %+v", want, *found)
+	}
+	if missing, err := repo.FindByID(ctx, "r9"); missing != nil || err != nil {
+		t.Errorf("Esperava (nil, nil) para uma avaliação inexistente, mas obteve (%+v, %v)", missing, err)
+	}
+}
+
+// testSaveRejectsDuplicates: um ID repetido ou uma segunda avaliação do mesmo usuário para o
+// mesmo filme são erros da categoria ErrConflict; o mesmo usuário pode avaliar outro filme.
+func testSaveRejectsDuplicates(t *testing.T, repo service.ReviewRepository) {
+	ctx := context.Background()
+	saveReviews(t, repo, &service.Review{ID: "r1", MovieID: "10", UserID: "ana", Rating: 4})
+
+	if err := repo.Save(ctx, &service.Review{ID: "r1", MovieID: "20", UserID: "bia", Rating: 3}); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("ID repetido: esperava ErrConflict, mas recebeu %v", err)
+	}
+	if err := repo.Save(ctx, &service.Review{ID: "r2", MovieID: "10", UserID: "ana", Rating: 1}); !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Segunda avaliação: esperava ErrConflict, mas recebeu %v", err)
+	}
+	if err := repo.Save(ctx, &service.Review{ID: "r3", MovieID: "20", UserID: "ana", Rating: 5}); err != nil {
+		t.Errorf("Esperava aceitar a avaliação de outro filme, mas recebeu %v", err)
+	}
+}
+
+// testFindByMovieNewestFirst: só as avaliações do filme, em ordem decrescente de ID,
+// respeitando o cursor (After) e o limite.
+func testFindByMovieNewestFirst(t *testing.T, repo service.ReviewRepository) {
+	ctx := context.Background()
+	saveReviews(t, repo,
+		&service.Review{ID: "r2", MovieID: "10", UserID: "bia", Rating: 3},
+		&service.Review{ID: "r4", MovieID: "10", UserID: "caio", Rating: 5},
+		&service.Review{ID: "r1", MovieID: "10", UserID: "ana", Rating: 4},
+		&service.Review{ID: "r3", MovieID: "20", UserID: "ana", Rating: 2},
+	)
+
+	all, err := repo.FindByMovie(ctx, service.ReviewQuery{MovieID: "10"})
+	if err != nil || reviewIDs(all) != "[r4 r2 r1]" {
+		t.Errorf("Esperava [r4 r2 r1], mas obteve %s (erro: %v)", reviewIDs(all), err)
+	}
+	page, err := repo.FindByMovie(ctx, service.ReviewQuery{MovieID: "10", After: "r4", Limit: 1})
+	if err != nil || reviewIDs(page) != "[r2]" {
+		t.Errorf("Esperava [r2] depois de r4, mas obteve %s (erro: %v)", reviewIDs(page), err)
+	}
+	none, err := repo.FindByMovie(ctx, service.ReviewQuery{MovieID: "99"})
+	if err != nil || none == nil || len(none) != 0 {
+		t.Errorf("Esperava uma lista vazia (e não nil), mas obteve (%v, %v)", none, err)
+	}
+}
+
+// testDelete: DeleteByID informa quando a avaliação não existe, e a avaliação apagada sai da média.
+func testDelete(t *testing.T, repo service.ReviewRepository) {
+	ctx := context.Background()
+	saveReviews(t, repo, &service.Review{ID: "r1", MovieID: "10", UserID: "ana", Rating: 4})
+
+	if deleted, err := repo.DeleteByID(ctx, "r1"); err != nil || !deleted {
+		t.Errorf("Esperava apagar a avaliação, mas obteve (%v, %v)", deleted, err)
+	}
+	if deleted, err := repo.DeleteByID(ctx, "r1"); err != nil || deleted {
+		t.Errorf("Esperava false ao apagar de novo, mas obteve (%v, %v)", deleted, err)
+	}
+	if summary, err := repo.Summarize(ctx, "10"); err != nil || summary != (service.RatingSummary{}) {
+		t.Errorf("Esperava a média zerada depois de apagar, mas obteve (%+v, %v)", summary, err)
+	}
+}
+
+// testSummarize: a média considera só as avaliações do filme pedido.
+func testSummarize(t *testing.T, repo service.ReviewRepository) {
+	ctx := context.Background()
+	saveReviews(t, repo,
+		&service.Review{ID: "r1", MovieID: "10", UserID: "ana", Rating: 4},
+		&service.Review{ID: "r2", MovieID: "10", UserID: "bia", Rating: 5},
+		&service.Review{ID: "r3", MovieID: "10", UserID: "caio", Rating: 5},
+		&service.Review{ID: "r4", MovieID: "20", UserID: "ana", Rating: 1},
+	)
+
+	summary, err := repo.Summarize(ctx, "10")
+	if err != nil || summary.Count != 3 || fmt.Sprintf("%.4f", summary.Average) != "4.6667" {
+		t.Errorf("Esperava média 4.6667 com 3 avaliações, mas obteve (%+v, %v)", summary, err)
+	}
+	if empty, err := repo.Summarize(ctx, "99"); err != nil || empty != (service.RatingSummary{}) {
+		t.Errorf("Esperava a média zerada para um filme sem avaliações, mas obteve (%+v, %v)", empty, err)
+	}
+}
+
+// testCanceledContext: todas as operações respeitam o contexto cancelado.
+func testCanceledContext(t *testing.T, repo service.ReviewRepository) {
+	saveReviews(t, repo, &service.Review{ID: "r1", MovieID: "10", UserID: "ana", Rating: 4})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.Save(ctx, &service.Review{ID: "r2", MovieID: "10", UserID: "bia", Rating: 3}); err == nil {
+		t.Error("Save: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByID(ctx, "r1"); err == nil {
+		t.Error("FindByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.FindByMovie(ctx, service.ReviewQuery{MovieID: "10"}); err == nil {
+		t.Error("FindByMovie: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.DeleteByID(ctx, "r1"); err == nil {
+		t.Error("DeleteByID: esperava um erro com o contexto cancelado")
+	}
+	if _, err := repo.Summarize(ctx, "10"); err == nil {
+		t.Error("Summarize: esperava um erro com o contexto cancelado")
+	}
+}
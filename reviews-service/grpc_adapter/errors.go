@@ -0,0 +1,71 @@
+// Local: reviews-service/grpc_adapter/errors.go
+
+package grpc_adapter
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// toStatusError traduz um erro do serviço para um erro gRPC com o código adequado, com a
+// mesma correspondência do movies-service, para que o gateway trate os dois serviços igual:
+//
+//	service.ErrInvalidArgument  -> InvalidArgument (com os campos em errdetails.BadRequest)
+//	service.ErrNotFound         -> NotFound
+//	service.ErrConflict         -> AlreadyExists
+//	service.ErrPermissionDenied -> PermissionDenied
+//	cancelamento / timeout      -> Canceled / DeadlineExceeded
+//	qualquer outro erro         -> Internal (os detalhes ficam só no log)
+//
+// ctx é o contexto da chamada: o log do erro interno leva o request_id dela.
+func toStatusError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationErr *service.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return badRequest(validationErr)
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+
+	// Falhas de infraestrutura (banco fora do ar etc.) não devem vazar para o cliente.
+	slog.ErrorContext(ctx, "erro interno", "error", err)
+	return status.Error(codes.Internal, "erro interno no reviews-service")
+}
+
+// badRequest monta o status InvalidArgument com a lista de campos inválidos nos detalhes.
+func badRequest(err *service.ValidationError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	details := &errdetails.BadRequest{}
+	for _, violation := range err.Violations {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	if withDetails, detailsErr := st.WithDetails(details); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
@@ -0,0 +1,86 @@
+// Local: reviews-service/grpc_adapter/server.go
+
+package grpc_adapter
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/alenrique/Movies-microservices/proto"
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// GrpcReviewServer é a implementação gRPC do serviço de avaliações.
+// Os erros do domínio são traduzidos por toStatusError (ver errors.go).
+type GrpcReviewServer struct {
+	pb.UnimplementedReviewServiceServer
+	service service.ReviewService
+}
+
+// NewGrpcReviewServer é o construtor do servidor gRPC das avaliações.
+func NewGrpcReviewServer(svc service.ReviewService) *GrpcReviewServer {
+	return &GrpcReviewServer{service: svc}
+}
+
+// CreateReview implementa o método gRPC para criar uma avaliação.
+func (s *GrpcReviewServer) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.Review, error) {
+	created, err := s.service.CreateReview(ctx, &service.Review{
+		MovieID: req.GetMovieId(),
+		UserID:  req.GetUserId(),
+		Rating:  req.GetRating(),
+		Comment: req.GetComment(),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return toProtoReview(created), nil
+}
+
+// ListReviewsForMovie implementa o método gRPC que lista as avaliações de um filme, paginadas.
+func (s *GrpcReviewServer) ListReviewsForMovie(ctx context.Context, req *pb.ListReviewsForMovieRequest) (*pb.ListReviewsForMovieResponse, error) {
+	page, err := s.service.ListReviewsForMovie(ctx, req.GetMovieId(), service.ListOptions{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	response := &pb.ListReviewsForMovieResponse{
+		Reviews:       make([]*pb.Review, 0, len(page.Reviews)),
+		NextPageToken: page.NextPageToken,
+	}
+	for _, review := range page.Reviews {
+		response.Reviews = append(response.Reviews, toProtoReview(review))
+	}
+	return response, nil
+}
+
+// DeleteReview implementa o método gRPC para apagar uma avaliação de um filme.
+func (s *GrpcReviewServer) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*pb.DeleteReviewResponse, error) {
+	if err := s.service.DeleteReview(ctx, req.GetMovieId(), req.GetId(), req.GetUserId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return &pb.DeleteReviewResponse{}, nil
+}
+
+// GetRatingSummary implementa o método gRPC que retorna a média das notas de um filme.
+func (s *GrpcReviewServer) GetRatingSummary(ctx context.Context, req *pb.GetRatingSummaryRequest) (*pb.RatingSummary, error) {
+	summary, err := s.service.GetRatingSummary(ctx, req.GetMovieId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	return &pb.RatingSummary{Average: summary.Average, Count: summary.Count}, nil
+}
+
+// toProtoReview traduz uma avaliação do domínio para a mensagem gRPC.
+func toProtoReview(review *service.Review) *pb.Review {
+	return &pb.Review{
+		Id:         review.ID,
+		MovieId:    review.MovieID,
+		UserId:     review.UserID,
+		Rating:     review.Rating,
+		Comment:    review.Comment,
+		CreateTime: timestamppb.New(review.CreatedAt),
+	}
+}
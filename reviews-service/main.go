@@ -0,0 +1,123 @@
+// Local: reviews-service/main.go
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+
+	"github.com/alenrique/Movies-microservices/config"
+	"github.com/alenrique/Movies-microservices/logging"
+	pb "github.com/alenrique/Movies-microservices/proto"
+	"github.com/alenrique/Movies-microservices/reviews-service/database"
+	"github.com/alenrique/Movies-microservices/reviews-service/database/memory"
+	"github.com/alenrique/Movies-microservices/reviews-service/grpc_adapter"
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+func main() {
+	// --- Configuração ---
+	// Valores padrão, arquivo YAML, variáveis de ambiente e flags, nessa ordem (ver o pacote config).
+	cfg := config.DefaultReviews()
+	config.MustLoad("reviews-service", cfg)
+
+	// --- Logs (log/slog) ---
+	// Como no movies-service: JSON a partir de logging.level (LOG_LEVEL), com o request_id
+	// que veio nos metadados gRPC.
+	logging.Setup("reviews-service", cfg.Logging)
+
+	// --- Conexão com o Banco de Dados ---
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Como no movies-service, database.driver (DB_DRIVER) escolhe o adaptador: "mongo" (padrão) ou "memory".
+	repo, closeDB := openRepository(ctx, cfg.Database)
+	defer closeDB()
+
+	// --- Injeção de Dependências ---
+	reviewService := service.NewReviewService(repo, ulidAllocator{})
+	reviewServer := grpc_adapter.NewGrpcReviewServer(reviewService)
+
+	// --- Configuração e Desligamento Gracioso do Servidor gRPC ---
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		logging.Fatal("falha ao escutar a rede", "addr", cfg.GRPCAddr, "error", err)
+	}
+
+	// O pacote logging guarda o request_id no contexto e escreve uma linha de log por chamada.
+	grpcServer := grpc.NewServer(logging.ServerOptions()...)
+	pb.RegisterReviewServiceServer(grpcServer, reviewServer)
+
+	go func() {
+		slog.Info("servidor gRPC escutando", "addr", lis.Addr().String())
+		if err := grpcServer.Serve(lis); err != nil {
+			logging.Fatal("falha ao iniciar o servidor gRPC", "error", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	slog.Info("sinal de desligamento recebido, parando o servidor gRPC")
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(cfg.ShutdownTimeout):
+		slog.Warn("prazo de desligamento esgotado, interrompendo as chamadas em andamento", "timeout", cfg.ShutdownTimeout)
+		grpcServer.Stop()
+	}
+	slog.Info("servidor gRPC parado")
+}
+
+// openRepository cria o adaptador do banco escolhido e a função que fecha a conexão.
+func openRepository(ctx context.Context, dbConfig config.ReviewsDatabase) (service.ReviewRepository, func()) {
+	switch dbConfig.Driver {
+	case "memory":
+		slog.Info("usando o repositório em memória (os dados não serão persistidos)")
+		return memory.NewReviewRepository(), func() {}
+
+	case "mongo":
+		slog.Info("conectando ao MongoDB")
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(dbConfig.Mongo.URI))
+		if err != nil {
+			logging.Fatal("falha ao conectar com o MongoDB", "error", err)
+		}
+		if err := client.Ping(ctx, nil); err != nil {
+			logging.Fatal("falha ao pingar o MongoDB", "error", err)
+		}
+		slog.Info("conectado ao MongoDB", "database", dbConfig.Mongo.Database)
+
+		// O banco (reviewdb, por padrão) é separado do moviedb: cada serviço é dono dos seus dados.
+		repo, err := database.NewMongoReviewRepository(ctx, client.Database(dbConfig.Mongo.Database))
+		if err != nil {
+			logging.Fatal("falha ao criar os índices do MongoDB", "error", err)
+		}
+		return repo, func() { client.Disconnect(context.Background()) }
+	}
+
+	logging.Fatal("DB_DRIVER desconhecido (use mongo ou memory)", "driver", dbConfig.Driver)
+	return nil, nil
+}
+
+// ulidAllocator gera os IDs das avaliações. Os ULIDs crescem com o tempo, o que mantém a
+// listagem (em ordem decrescente de ID) da avaliação mais nova para a mais antiga.
+// ulid.Make é seguro para uso concorrente.
+type ulidAllocator struct{}
+
+func (ulidAllocator) NextID(ctx context.Context) (string, error) {
+	return ulid.Make().String(), nil
+}
@@ -0,0 +1,91 @@
+// Local: reviews-service/service/errors.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Categorias de erro do domínio, as mesmas do movies-service. Os adaptadores de entrada
+// usam errors.Is com elas para escolher o código de status.
+var (
+	// ErrNotFound indica que o recurso pedido não existe.
+	ErrNotFound = errors.New("recurso não encontrado")
+	// ErrInvalidArgument indica que a requisição tem dados inválidos.
+	ErrInvalidArgument = errors.New("argumento inválido")
+	// ErrConflict indica que a operação conflita com o estado atual (ex: uma segunda
+	// avaliação do mesmo usuário). Os repositórios embrulham os seus erros de chave duplicada com ele.
+	ErrConflict = errors.New("conflito com o estado atual")
+	// ErrPermissionDenied indica que o usuário não pode alterar o recurso (ex: apagar a
+	// avaliação de outro usuário).
+	ErrPermissionDenied = errors.New("permissão negada")
+)
+
+// FieldViolation descreve o problema de um campo da requisição.
+// Field usa o nome do campo no .proto (ex: "rating").
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError é um erro da categoria ErrInvalidArgument com a lista de campos inválidos.
+// Err é o primeiro erro específico: errors.Is funciona tanto com ele quanto com ErrInvalidArgument.
+type ValidationError struct {
+	Err        error
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// Is faz com que errors.Is(err, ErrInvalidArgument) seja verdadeiro.
+func (e *ValidationError) Is(target error) bool { return target == ErrInvalidArgument }
+
+// NotFoundError é um erro da categoria ErrNotFound que informa qual recurso faltou.
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s com o ID '%s' não encontrada", e.Resource, e.ID)
+}
+
+// Is faz com que errors.Is(err, ErrNotFound) seja verdadeiro.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// violations acumula as violações de uma validação, para que o cliente receba todos os
+// campos inválidos de uma vez.
+type violations struct {
+	first error
+	list  []FieldViolation
+}
+
+func (v *violations) add(field string, err error) {
+	if v.first == nil {
+		v.first = err
+	}
+	v.list = append(v.list, FieldViolation{Field: field, Description: err.Error()})
+}
+
+// err retorna nil se nenhuma violação foi registrada.
+func (v *violations) err() error {
+	if v.first == nil {
+		return nil
+	}
+	return &ValidationError{Err: v.first, Violations: v.list}
+}
+
+// invalidArgument cria um ValidationError com uma única violação no campo dado.
+func invalidArgument(field string, err error) error {
+	var v violations
+	v.add(field, err)
+	return v.err()
+}
+
+// reviewNotFound é o erro retornado quando uma avaliação não existe.
+func reviewNotFound(id string) error {
+	return &NotFoundError{Resource: "avaliação", ID: id}
+}
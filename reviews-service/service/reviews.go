@@ -0,0 +1,264 @@
+// Local: reviews-service/service/reviews.go
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Review é a avaliação de um filme feita por um usuário.
+// O filme é referenciado só pelo ID: este serviço não conhece o catálogo.
+type Review struct {
+	ID        string    `json:"id" bson:"id"`
+	MovieID   string    `json:"movie_id" bson:"movie_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	Rating    int32     `json:"rating" bson:"rating"`
+	Comment   string    `json:"comment,omitempty" bson:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// RatingSummary é a média e a quantidade das notas de um filme.
+type RatingSummary struct {
+	Average float64 `json:"average"`
+	Count   int64   `json:"count"`
+}
+
+// ReviewQuery descreve uma página de avaliações de um filme (paginação por keyset).
+// As avaliações vêm da mais nova para a mais antiga, ou seja, em ordem decrescente de ID
+// (os IDs são ULIDs, que seguem a ordem de criação). After é o ID da última avaliação da
+// página anterior, ou vazio na primeira página.
+type ReviewQuery struct {
+	MovieID string
+	After   string
+	Limit   int
+}
+
+// ReviewRepository é a porta de saída para persistir as avaliações.
+type ReviewRepository interface {
+	// Save insere uma avaliação nova. Um ID repetido, ou uma segunda avaliação do mesmo
+	// usuário para o mesmo filme, é um erro da categoria ErrConflict.
+	Save(ctx context.Context, review *Review) error
+	// FindByID retorna (nil, nil) se a avaliação não existir.
+	FindByID(ctx context.Context, id string) (*Review, error)
+	// FindByMovie retorna no máximo query.Limit avaliações do filme, em ordem decrescente de ID.
+	FindByMovie(ctx context.Context, query ReviewQuery) ([]*Review, error)
+	// DeleteByID retorna false se a avaliação não existir.
+	DeleteByID(ctx context.Context, id string) (bool, error)
+	// Summarize calcula a média e a quantidade das notas do filme. Um filme sem avaliações
+	// resulta em um RatingSummary zerado.
+	Summarize(ctx context.Context, movieID string) (RatingSummary, error)
+}
+
+// IDAllocator é a porta de saída que gera os IDs das avaliações novas. Os IDs precisam
+// crescer com o tempo, pois a listagem usa a ordem deles (ver ReviewQuery).
+type IDAllocator interface {
+	NextID(ctx context.Context) (string, error)
+}
+
+// ReviewService é a porta de entrada das avaliações.
+type ReviewService interface {
+	CreateReview(ctx context.Context, review *Review) (*Review, error)
+	ListReviewsForMovie(ctx context.Context, movieID string, opts ListOptions) (*ReviewPage, error)
+	DeleteReview(ctx context.Context, movieID, id, userID string) error
+	GetRatingSummary(ctx context.Context, movieID string) (RatingSummary, error)
+}
+
+// ListOptions são os parâmetros de paginação da listagem de avaliações.
+type ListOptions struct {
+	PageSize  int
+	PageToken string
+}
+
+// ReviewPage é uma página de avaliações. NextPageToken fica vazio quando não há mais páginas.
+type ReviewPage struct {
+	Reviews       []*Review
+	NextPageToken string
+}
+
+// Limites das avaliações. Um page_size igual a zero usa o padrão; valores acima do máximo
+// são reduzidos ao máximo.
+const (
+	MinRating        = 1
+	MaxRating        = 5
+	MaxCommentLength = 2000
+	DefaultPageSize  = 20
+	MaxPageSize      = 100
+)
+
+// Erros de validação das avaliações, todos da categoria ErrInvalidArgument.
+var (
+	ErrEmptyMovieID     = errors.New("o ID do filme não pode ser vazio")
+	ErrEmptyUserID      = errors.New("o ID do usuário não pode ser vazio")
+	ErrEmptyReviewID    = errors.New("o ID da avaliação não pode ser vazio")
+	ErrInvalidRating    = fmt.Errorf("a nota deve estar entre %d e %d", MinRating, MaxRating)
+	ErrCommentTooLong   = fmt.Errorf("o comentário pode ter no máximo %d caracteres", MaxCommentLength)
+	ErrInvalidPageSize  = errors.New("o tamanho da página não pode ser negativo")
+	ErrInvalidPageToken = errors.New("token de página inválido")
+)
+
+type reviewService struct {
+	repo ReviewRepository // Porta de saída das avaliações.
+	ids  IDAllocator      // Gera os IDs das avaliações novas.
+	now  func() time.Time // Relógio usado na data de criação.
+}
+
+// NewReviewService cria o serviço de avaliações.
+func NewReviewService(repo ReviewRepository, ids IDAllocator) ReviewService {
+	return &reviewService{repo: repo, ids: ids, now: time.Now}
+}
+
+// CreateReview valida e grava uma avaliação. O comentário é guardado sem os espaços das pontas.
+// A existência do filme não é conferida aqui: isso cabe a quem conhece o catálogo (o gateway).
+func (s *reviewService) CreateReview(ctx context.Context, review *Review) (*Review, error) {
+	review.Comment = strings.TrimSpace(review.Comment)
+	if err := validateReview(review); err != nil {
+		return nil, err
+	}
+	newID, err := s.ids.NextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	review.ID = newID
+	review.CreatedAt = s.now().UTC().Truncate(time.Millisecond)
+	if err := s.repo.Save(ctx, review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// ListReviewsForMovie retorna uma página das avaliações do filme, da mais nova para a mais antiga.
+// Um filme sem avaliações (ou que nem existe) resulta em uma página vazia.
+func (s *reviewService) ListReviewsForMovie(ctx context.Context, movieID string, opts ListOptions) (*ReviewPage, error) {
+	var v violations
+	if movieID == "" {
+		v.add("movie_id", ErrEmptyMovieID)
+	}
+	if opts.PageSize < 0 {
+		v.add("page_size", ErrInvalidPageSize)
+	}
+	after, tokenErr := decodePageToken(opts.PageToken)
+	if tokenErr != nil {
+		v.add("page_token", tokenErr)
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	size := opts.PageSize
+	switch {
+	case size == 0:
+		size = DefaultPageSize
+	case size > MaxPageSize:
+		size = MaxPageSize
+	}
+
+	// Um item a mais indica se existe uma próxima página, sem precisar de outra consulta.
+	reviews, err := s.repo.FindByMovie(ctx, ReviewQuery{MovieID: movieID, After: after, Limit: size + 1})
+	if err != nil {
+		return nil, err
+	}
+	page := &ReviewPage{Reviews: reviews}
+	if len(reviews) > size {
+		page.Reviews = reviews[:size]
+		page.NextPageToken = encodePageToken(page.Reviews[size-1].ID)
+	}
+	return page, nil
+}
+
+// DeleteReview apaga uma avaliação do filme feita pelo usuário userID. Uma avaliação de outro
+// filme é tratada como inexistente, para que a URL /movies/{id}/reviews/{reviewId} não apague
+// a avaliação errada; a avaliação de outro usuário retorna um erro da categoria ErrPermissionDenied.
+func (s *reviewService) DeleteReview(ctx context.Context, movieID, id, userID string) error {
+	var v violations
+	if movieID == "" {
+		v.add("movie_id", ErrEmptyMovieID)
+	}
+	if id == "" {
+		v.add("id", ErrEmptyReviewID)
+	}
+	if userID == "" {
+		v.add("user_id", ErrEmptyUserID)
+	}
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	review, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if review == nil || review.MovieID != movieID {
+		return reviewNotFound(id)
+	}
+	if review.UserID != userID {
+		return fmt.Errorf("%w: a avaliação '%s' é de outro usuário", ErrPermissionDenied, id)
+	}
+	deleted, err := s.repo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return reviewNotFound(id)
+	}
+	return nil
+}
+
+// GetRatingSummary retorna a média e a quantidade das notas do filme.
+func (s *reviewService) GetRatingSummary(ctx context.Context, movieID string) (RatingSummary, error) {
+	if movieID == "" {
+		return RatingSummary{}, invalidArgument("movie_id", ErrEmptyMovieID)
+	}
+	return s.repo.Summarize(ctx, movieID)
+}
+
+// validateReview verifica os campos preenchidos pelo cliente, registrando todas as violações.
+func validateReview(review *Review) error {
+	var v violations
+	if review.MovieID == "" {
+		v.add("movie_id", ErrEmptyMovieID)
+	}
+	if review.UserID == "" {
+		v.add("user_id", ErrEmptyUserID)
+	}
+	if review.Rating < MinRating || review.Rating > MaxRating {
+		v.add("rating", ErrInvalidRating)
+	}
+	if utf8.RuneCountInString(review.Comment) > MaxCommentLength {
+		v.add("comment", ErrCommentTooLong)
+	}
+	return v.err()
+}
+
+// pageToken é o conteúdo do token opaco entregue ao cliente: o ID da última avaliação da página.
+type pageToken struct {
+	After string `json:"a"`
+}
+
+// encodePageToken gera o token da página seguinte à avaliação lastID.
+func encodePageToken(lastID string) string {
+	raw, _ := json.Marshal(pageToken{After: lastID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken devolve o ID guardado no token. Um token vazio é a primeira página.
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", ErrInvalidPageToken
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(raw, &decoded); err != nil || decoded.After == "" {
+		return "", ErrInvalidPageToken
+	}
+	return decoded.After, nil
+}
@@ -0,0 +1,132 @@
+// Local: reviews-service/service/reviews_test.go
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alenrique/Movies-microservices/reviews-service/database/memory"
+	"github.com/alenrique/Movies-microservices/reviews-service/service"
+)
+
+// fakeIDAllocator gera IDs crescentes com largura fixa, que seguem a ordem de criação
+// também quando comparados como texto (como os ULIDs).
+type fakeIDAllocator struct {
+	last int64
+}
+
+func (a *fakeIDAllocator) NextID(ctx context.Context) (string, error) {
+	return fmt.Sprintf("r%03d", atomic.AddInt64(&a.last, 1)), nil
+}
+
+// TestCreateReview_Validation testa se todos os campos inválidos são informados de uma vez
+// e se um usuário não pode avaliar o mesmo filme duas vezes.
+func TestCreateReview_Validation(t *testing.T) {
+	ctx := context.Background()
+	reviewService := service.NewReviewService(memory.NewReviewRepository(), &fakeIDAllocator{})
+
+	_, err := reviewService.CreateReview(ctx, &service.Review{Rating: 6})
+	var validationErr *service.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Esperava um ValidationError, mas recebeu %v", err)
+	}
+	var fields []string
+	for _, violation := range validationErr.Violations {
+		fields = append(fields, violation.Field)
+	}
+	if want := "[movie_id user_id rating]"; fmt.Sprint(fields) != want {
+		t.Errorf("Esperava as violações %s, mas obteve %v", want, fields)
+	}
+
+	created, err := reviewService.CreateReview(ctx, &service.Review{MovieID: "10", UserID: "ana", Rating: 5, Comment: "  Ótimo!  "})
+	if err != nil || created.ID != "r001" || created.Comment != "Ótimo!" || created.CreatedAt.IsZero() {
+		t.Fatalf("Esperava a avaliação criada com ID, data e comentário aparado, mas obteve (%+v, %v)", created, err)
+	}
+	_, err = reviewService.CreateReview(ctx, &service.Review{MovieID: "10", UserID: "ana", Rating: 1})
+	if !errors.Is(err, service.ErrConflict) {
+		t.Errorf("Esperava ErrConflict na segunda avaliação do mesmo usuário, mas recebeu %v", err)
+	}
+}
+
+// TestListReviewsForMovie_Pagination testa se as páginas vêm da avaliação mais nova para a
+// mais antiga, sem repetir nem pular nenhuma, e se a média considera todas.
+func TestListReviewsForMovie_Pagination(t *testing.T) {
+	// Arrange: 5 avaliações do filme 10 e uma do filme 20.
+	ctx := context.Background()
+	reviewService := service.NewReviewService(memory.NewReviewRepository(), &fakeIDAllocator{})
+	for i, rating := range []int32{5, 4, 3, 4, 5} {
+		review := &service.Review{MovieID: "10", UserID: fmt.Sprintf("user-%d", i), Rating: rating}
+		if _, err := reviewService.CreateReview(ctx, review); err != nil {
+			t.Fatalf("Erro inesperado ao criar a avaliação: %v", err)
+		}
+	}
+	reviewService.CreateReview(ctx, &service.Review{MovieID: "20", UserID: "user-0", Rating: 1})
+
+	// Act: percorre as páginas de 2 em 2.
+	var pages []string
+	token := ""
+	for {
+		page, err := reviewService.ListReviewsForMovie(ctx, "10", service.ListOptions{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("Erro inesperado ao listar: %v", err)
+		}
+		var ids []string
+		for _, review := range page.Reviews {
+			ids = append(ids, review.ID)
+		}
+		pages = append(pages, fmt.Sprint(ids))
+		if token = page.NextPageToken; token == "" {
+			break
+		}
+	}
+
+	// Assert
+	if want := "[[r005 r004] [r003 r002] [r001]]"; fmt.Sprint(pages) != want {
+		t.Errorf("Esperava as páginas %s, mas obteve %v", want, pages)
+	}
+	if _, err := reviewService.ListReviewsForMovie(ctx, "10", service.ListOptions{PageToken: "zz"}); !errors.Is(err, service.ErrInvalidPageToken) {
+		t.Errorf("Esperava ErrInvalidPageToken, mas recebeu %v", err)
+	}
+	summary, err := reviewService.GetRatingSummary(ctx, "10")
+	if err != nil || summary != (service.RatingSummary{Average: 4.2, Count: 5}) {
+		t.Errorf("Esperava média 4.2 com 5 avaliações, mas obteve (%+v, %v)", summary, err)
+	}
+}
+
+// TestDeleteReview_ChecksMovie testa se uma avaliação só é apagada pela URL do próprio filme.
+func TestDeleteReview_ChecksMovie(t *testing.T) {
+	ctx := context.Background()
+	reviewService := service.NewReviewService(memory.NewReviewRepository(), &fakeIDAllocator{})
+	review, _ := reviewService.CreateReview(ctx, &service.Review{MovieID: "10", UserID: "ana", Rating: 4})
+
+	if err := reviewService.DeleteReview(ctx, "20", review.ID, "ana"); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao apagar pela URL de outro filme, mas recebeu %v", err)
+	}
+	if err := reviewService.DeleteReview(ctx, "10", review.ID, "ana"); err != nil {
+		t.Errorf("Erro inesperado ao apagar a avaliação: %v", err)
+	}
+	if err := reviewService.DeleteReview(ctx, "10", review.ID, "ana"); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound ao apagar de novo, mas recebeu %v", err)
+	}
+}
+
+// TestDeleteReview_ChecksOwner testa se um usuário não consegue apagar a avaliação de outro.
+func TestDeleteReview_ChecksOwner(t *testing.T) {
+	ctx := context.Background()
+	reviewService := service.NewReviewService(memory.NewReviewRepository(), &fakeIDAllocator{})
+	review, _ := reviewService.CreateReview(ctx, &service.Review{MovieID: "10", UserID: "ana", Rating: 4})
+
+	if err := reviewService.DeleteReview(ctx, "10", review.ID, "bia"); !errors.Is(err, service.ErrPermissionDenied) {
+		t.Errorf("Esperava ErrPermissionDenied ao apagar a avaliação de outro usuário, mas recebeu %v", err)
+	}
+	if err := reviewService.DeleteReview(ctx, "10", review.ID, ""); !errors.Is(err, service.ErrInvalidArgument) {
+		t.Errorf("Esperava ErrInvalidArgument sem o usuário, mas recebeu %v", err)
+	}
+	if err := reviewService.DeleteReview(ctx, "10", review.ID, "ana"); err != nil {
+		t.Errorf("Erro inesperado ao apagar a própria avaliação: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Local: tracing/tracing.go
+
+// Package tracing configura o rastreamento distribuído (OpenTelemetry) dos serviços. Uma
+// requisição ao gateway vira um trace com um span HTTP, um span de cliente e um de servidor
+// para cada chamada gRPC e um span para cada chamada a um repositório, o que mostra onde o
+// tempo foi gasto.
+//
+// O contexto do trace passa de um serviço para o outro no cabeçalho traceparent do W3C
+// Trace Context, enviado nos metadados gRPC.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"google.golang.org/grpc"
+
+	"github.com/alenrique/Movies-microservices/config"
+)
+
+// Setup registra o TracerProvider global do serviço, com o exportador escolhido em cfg, e
+// o propagador do W3C Trace Context. A função retornada envia os spans pendentes e deve ser
+// chamada no desligamento.
+//
+// Com o exportador "none", nenhum span é gravado, mas o traceparent recebido continua sendo
+// repassado nas chamadas seguintes, para não quebrar o trace dos outros serviços.
+func Setup(ctx context.Context, serviceName string, cfg config.Tracing) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var output io.Closer
+	switch cfg.Exporter {
+	case "stdout":
+		var w io.Writer = os.Stdout
+		if cfg.File != "" {
+			file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return nil, fmt.Errorf("falha ao abrir o arquivo dos spans: %w", err)
+			}
+			w, output = file, file
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(w))
+	case "otlp":
+		// A conexão com o coletor é preguiçosa: o serviço sobe mesmo que ele ainda não esteja no ar.
+		exporter, err = otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint), otlptracegrpc.WithInsecure())
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao criar o exportador %s: %w", cfg.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if output != nil {
+			output.Close()
+		}
+		return err
+	}, nil
+}
+
+// As chamadas do grpc.health.v1 ficam de fora: as sondas rodam a cada poucos segundos e
+// encheriam o rastreamento sem trazer informação.
+var notHealthCheck = otelgrpc.WithFilter(filters.Not(filters.HealthCheck()))
+
+// ServerOptions retorna as opções que criam um span de servidor para cada chamada gRPC
+// recebida, filho do span do cliente que veio no traceparent. Use em grpc.NewServer.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{grpc.StatsHandler(otelgrpc.NewServerHandler(notHealthCheck))}
+}
+
+// DialOptions retorna as opções que criam um span de cliente para cada chamada gRPC feita e
+// enviam o traceparent nos metadados. Use em grpc.NewClient.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithStatsHandler(otelgrpc.NewClientHandler(notHealthCheck))}
+}